@@ -0,0 +1,20 @@
+# Export all aliases
+data "opnsense_firewall_alias_bundle" "all" {}
+
+# Back up the exported aliases to a file
+resource "local_file" "alias_backup" {
+  filename = "aliases.json"
+  content  = data.opnsense_firewall_alias_bundle.all.export
+}
+
+# Copy a subset of aliases to another firewall
+data "opnsense_firewall_alias_bundle" "office" {
+  names = ["office_hosts", "office_networks"]
+}
+
+resource "opnsense_firewall_alias_bundle" "copy" {
+  provider = opnsense.secondary
+
+  name    = "office"
+  aliases = data.opnsense_firewall_alias_bundle.office.aliases
+}
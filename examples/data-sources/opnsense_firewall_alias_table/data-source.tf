@@ -0,0 +1,12 @@
+# Get the resolved addresses of an alias
+data "opnsense_firewall_alias_table" "example" {
+  name = "alias_name"
+}
+
+# Assert that a URL table alias resolved to at least one address
+check "url_table_resolved" {
+  assert {
+    condition     = data.opnsense_firewall_alias_table.example.entry_count > 0
+    error_message = "Alias table is empty."
+  }
+}
@@ -0,0 +1,13 @@
+# Get all states on the firewall
+data "opnsense_firewall_states" "all" {}
+
+# Get states from a network on a specific interface
+data "opnsense_firewall_states" "lan_clients" {
+  interface = "vtnet1"
+  source    = "192.168.1.0/24"
+}
+
+# Get states created by an automation filter rule
+data "opnsense_firewall_states" "rule" {
+  rule_label = opnsense_firewall_automation_filter.example.id
+}
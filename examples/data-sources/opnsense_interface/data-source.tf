@@ -0,0 +1,11 @@
+# Get the LAN interface
+data "opnsense_interface" "lan" {
+  identifier = "lan"
+}
+
+# Allow the LAN subnet without hardcoding the network
+resource "opnsense_firewall_alias" "lan_clients" {
+  name    = "lan_clients"
+  type    = "network"
+  content = [data.opnsense_interface.lan.ipv4_subnet]
+}
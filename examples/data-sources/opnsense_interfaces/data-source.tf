@@ -0,0 +1,7 @@
+# Get all assigned interfaces
+data "opnsense_interfaces" "all" {}
+
+# Map interface identifiers to their IPv4 subnet
+output "subnets" {
+  value = { for iface in data.opnsense_interfaces.all.interfaces : iface.identifier => iface.ipv4_subnet }
+}
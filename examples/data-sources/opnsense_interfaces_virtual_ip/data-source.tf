@@ -0,0 +1,9 @@
+# Read a virtual IP via its address
+data "opnsense_interfaces_virtual_ip" "web" {
+  address = "203.0.113.10"
+}
+
+# Read a virtual IP via its id
+data "opnsense_interfaces_virtual_ip" "lan_carp" {
+  id = "a8bc41ff-7a64-4d1b-9a0e-7c2f5c3d9e10"
+}
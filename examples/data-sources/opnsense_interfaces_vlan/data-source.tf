@@ -0,0 +1,9 @@
+# Read a VLAN via its device name
+data "opnsense_interfaces_vlan" "guests" {
+  device = "vlan01"
+}
+
+# Read a VLAN via its id
+data "opnsense_interfaces_vlan" "voip" {
+  id = "cb2c5bbe-1b49-4a3f-9a50-3b4d1c1e0e4f"
+}
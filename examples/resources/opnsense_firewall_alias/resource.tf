@@ -9,3 +9,19 @@ resource "opnsense_firewall_alias" "resource_example" {
     "opnsense.org",
   ]
 }
+
+# Example URL table alias, downloaded again whenever the list version changes
+resource "opnsense_firewall_alias" "resource_example_urltable" {
+  name = "spamhaus_drop"
+  type = "urltable"
+  updatefreq = {
+    days  = 1
+    hours = 0
+  }
+  content = [
+    "https://www.spamhaus.org/drop/drop.txt",
+  ]
+  refresh_triggers = {
+    version = var.blocklist_version
+  }
+}
@@ -0,0 +1,2 @@
+# Alias bundles can be imported using the bundle name and a comma separated list of alias names
+terraform import opnsense_firewall_alias_bundle.example office:office_hosts,office_networks,office_ports,blocked_countries
@@ -0,0 +1,37 @@
+# Manage a set of aliases, imported & applied in a single request
+resource "opnsense_firewall_alias_bundle" "example" {
+  name = "office"
+
+  aliases = {
+    office_hosts = {
+      type        = "host"
+      description = "Office hosts"
+      content = [
+        "10.0.0.10",
+        "10.0.0.11",
+      ]
+    }
+
+    office_networks = {
+      type    = "network"
+      content = ["10.0.0.0/24"]
+    }
+
+    office_ports = {
+      type    = "port"
+      content = ["80", "443"]
+    }
+
+    blocked_countries = {
+      type    = "geoip"
+      proto   = ["ipv4", "ipv6"]
+      content = ["KP"]
+    }
+  }
+}
+
+# Manage the aliases of an OPNsense alias export document, e.g downloaded from Firewall > Aliases
+resource "opnsense_firewall_alias_bundle" "exported" {
+  name        = "exported"
+  export_json = file("${path.module}/aliases.json")
+}
@@ -0,0 +1,2 @@
+# Alias entries can be imported using the alias name and address separated by a slash
+terraform import opnsense_firewall_alias_entry.example siem_blocklist/203.0.113.10
@@ -0,0 +1,18 @@
+# Shared blocklist, its pf table is managed outside of the alias configuration
+resource "opnsense_firewall_alias" "blocklist" {
+  name        = "siem_blocklist"
+  type        = "external"
+  description = "Addresses blocked by the SIEM"
+}
+
+# Add a single address to the blocklist
+resource "opnsense_firewall_alias_entry" "host" {
+  alias   = opnsense_firewall_alias.blocklist.name
+  address = "203.0.113.10"
+}
+
+# Add a network to the blocklist
+resource "opnsense_firewall_alias_entry" "network" {
+  alias   = opnsense_firewall_alias.blocklist.name
+  address = "198.51.100.0/24"
+}
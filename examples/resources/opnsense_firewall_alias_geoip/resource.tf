@@ -2,3 +2,14 @@
 resource "opnsense_firewall_alias_geoip" "resource_example" {
   url = "https://download.maxmind.com/app/geoip_download?edition_id=GeoLite2-Country-CSV&license_key=<your-license-key>&suffix=zip"
 }
+
+# Example geoip configuration using MaxMind credentials, which are never stored in the state.
+# Requires Terraform 1.11+ for write-only attributes.
+resource "opnsense_firewall_alias_geoip" "maxmind_example" {
+  maxmind_account_id          = var.maxmind_account_id
+  maxmind_license_key         = var.maxmind_license_key
+  maxmind_credentials_version = 1
+
+  # Fail the apply if the database cannot be downloaded
+  download_database = true
+}
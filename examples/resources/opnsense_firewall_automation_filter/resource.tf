@@ -23,4 +23,5 @@ resource "opnsense_firewall_automation_filter" "test_acc_resource_filter" {
     "automation"
   ]
   description = "Example firewall automation filter rule"
+  safe_apply  = true
 }
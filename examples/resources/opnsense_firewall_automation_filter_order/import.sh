@@ -0,0 +1,2 @@
+# Firewall automation filter rule orders can be imported by specifying the interface the order is scoped to, or `all` for an unscoped order. The rules are imported in their current order
+terraform import opnsense_firewall_automation_filter_order.import_example lan
@@ -0,0 +1,23 @@
+# Example ordering of firewall automation filter rules on the LAN interface
+resource "opnsense_firewall_automation_filter" "allow_dns" {
+  interfaces       = ["lan"]
+  protocol         = "udp"
+  destination_port = "53"
+  description      = "Allow DNS"
+}
+
+resource "opnsense_firewall_automation_filter" "block_all" {
+  action      = "block"
+  interfaces  = ["lan"]
+  description = "Block everything else"
+}
+
+resource "opnsense_firewall_automation_filter_order" "resource_example" {
+  interface = "lan"
+  start     = 100
+  gap       = 100
+  rules = [
+    opnsense_firewall_automation_filter.allow_dns.id,
+    opnsense_firewall_automation_filter.block_all.id,
+  ]
+}
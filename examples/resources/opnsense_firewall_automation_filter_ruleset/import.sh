@@ -0,0 +1,2 @@
+# Firewall automation filter rulesets can be imported by specifying the ruleset name.
+terraform import opnsense_firewall_automation_filter_ruleset.import_example lan_policy
@@ -0,0 +1,29 @@
+# Example firewall automation filter ruleset for the LAN interface
+resource "opnsense_firewall_automation_filter_ruleset" "resource_example" {
+  name  = "lan_policy"
+  start = 100
+  gap   = 100
+  rules = [
+    {
+      key              = "dns"
+      interfaces       = ["lan"]
+      protocol         = "udp"
+      destination_port = "53"
+      description      = "Allow DNS"
+    },
+    {
+      key              = "https"
+      interfaces       = ["lan"]
+      protocol         = "tcp"
+      destination_port = "443"
+      description      = "Allow HTTPS"
+    },
+    {
+      key         = "block"
+      action      = "block"
+      interfaces  = ["lan"]
+      log         = true
+      description = "Block everything else"
+    },
+  ]
+}
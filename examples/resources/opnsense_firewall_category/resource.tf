@@ -3,3 +3,15 @@ resource "opnsense_firewall_category" "resource_example" {
   auto  = true
   color = "000000"
 }
+
+# Remove the category from all aliases & rules still using it when it is deleted
+resource "opnsense_firewall_category" "force_detach_example" {
+  name         = "temporary"
+  force_detach = true
+}
+
+# Colors can be set as hex color codes (with or without `#`) or as palette colors
+resource "opnsense_firewall_category" "palette_example" {
+  name  = "blocked"
+  color = "red"
+}
@@ -0,0 +1,2 @@
+# Group members can be imported using the group name and interface identifier separated by a slash
+terraform import opnsense_firewall_group_member.example dmz/opt2
@@ -0,0 +1,15 @@
+# Shared group, its members are managed by opnsense_firewall_group_member resources
+resource "opnsense_firewall_group" "dmz" {
+  name    = "dmz"
+  members = ["opt1"]
+
+  lifecycle {
+    ignore_changes = [members]
+  }
+}
+
+# Add an interface to the group
+resource "opnsense_firewall_group_member" "opt2" {
+  group     = opnsense_firewall_group.dmz.name
+  interface = "opt2"
+}
@@ -0,0 +1,2 @@
+# The outbound NAT settings can be imported with an empty id, as there is only one instance of it
+terraform import opnsense_firewall_nat_outbound_settings.import_example ""
@@ -0,0 +1,14 @@
+# Use both automatically generated and manual source NAT rules, restoring automatic mode when the resource is destroyed
+resource "opnsense_firewall_nat_outbound_settings" "resource_example" {
+  mode         = "hybrid"
+  default_mode = "automatic"
+}
+
+resource "opnsense_firewall_automation_source_nat" "resource_example" {
+  interface   = "wan"
+  source      = "10.2.72.0/24"
+  target      = "wanip"
+  description = "Example source NAT rule"
+
+  depends_on = [opnsense_firewall_nat_outbound_settings.resource_example]
+}
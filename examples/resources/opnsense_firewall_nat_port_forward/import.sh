@@ -0,0 +1,2 @@
+# Port forward rules can be imported by specifying the rule UUID. This can be found by examining the API calls on the OPNsense web interface
+terraform import opnsense_firewall_nat_port_forward.import_example 7ce41c23-968c-46f2-9b6b-3c9a1519086f
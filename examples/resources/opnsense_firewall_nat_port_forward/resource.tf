@@ -0,0 +1,19 @@
+# Example port forward rule redirecting HTTPS traffic on the WAN address to an internal web server
+resource "opnsense_firewall_nat_port_forward" "resource_example" {
+  enabled          = true
+  sequence         = 10
+  interface        = "wan"
+  ip_version       = "ipv4"
+  protocol         = "tcp"
+  source           = "any"
+  source_not       = false
+  destination      = "wanip"
+  destination_not  = false
+  destination_port = "https"
+  target           = "10.2.72.10"
+  target_port      = "8443"
+  nat_reflection   = "enable"
+  filter_rule      = "associated"
+  log              = true
+  description      = "Example port forward rule"
+}
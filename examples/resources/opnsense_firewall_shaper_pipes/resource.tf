@@ -8,7 +8,7 @@ resource "opnsense_firewall_shaper_pipes" "example_shaper_pipe" {
   queue     = 10
   mask      = "src-ip"
   buckets   = 10
-  scheduler = "deficit round robin"
+  scheduler = "flowqueue-codel"
   codel = {
     enabled  = true
     target   = 10
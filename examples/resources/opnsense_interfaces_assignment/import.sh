@@ -0,0 +1,2 @@
+# Interface assignments can be imported using the interface identifier
+terraform import opnsense_interfaces_assignment.example opt3
@@ -0,0 +1,25 @@
+# Assign a VLAN to an interface with a static IPv4 address
+resource "opnsense_interfaces_vlan" "guests" {
+  parent      = "igb1"
+  tag         = 20
+  description = "Guest network"
+}
+
+resource "opnsense_interfaces_assignment" "guests" {
+  device       = opnsense_interfaces_vlan.guests.device
+  description  = "GUESTS"
+  ipv4_type    = "static"
+  ipv4_address = "192.168.20.1/24"
+}
+
+# Assign a device using DHCP on IPv4 and a tracked IPv6 prefix
+resource "opnsense_interfaces_assignment" "lab" {
+  device           = "igb2"
+  description      = "LAB"
+  ipv4_type        = "dhcp"
+  ipv6_type        = "track6"
+  track6_interface = "wan"
+  track6_prefix_id = 2
+  mtu              = 1500
+  block_bogons     = true
+}
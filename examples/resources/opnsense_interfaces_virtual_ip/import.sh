@@ -0,0 +1,2 @@
+# Virtual IPs can be imported using the id
+terraform import opnsense_interfaces_virtual_ip.example a8bc41ff-7a64-4d1b-9a0e-7c2f5c3d9e10
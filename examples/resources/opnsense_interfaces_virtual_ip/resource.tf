@@ -0,0 +1,32 @@
+variable "carp_password" {
+  type      = string
+  sensitive = true
+}
+
+# Create a CARP address shared by a high availability cluster, using an unused VHID
+resource "opnsense_interfaces_virtual_ip" "lan_carp" {
+  interface        = "lan"
+  mode             = "carp"
+  subnet           = "192.168.1.1/24"
+  advertising_skew = 0
+  password         = var.carp_password
+  password_version = 1
+  description      = "LAN gateway"
+}
+
+# Create an IP alias & use it as external address of a one-to-one NAT rule
+resource "opnsense_interfaces_virtual_ip" "web" {
+  interface   = "wan"
+  mode        = "ipalias"
+  subnet      = "203.0.113.10/32"
+  description = "Web server"
+}
+
+resource "opnsense_firewall_nat_one_to_one" "web" {
+  interface   = "wan"
+  type        = "binat"
+  source      = "10.0.10.10/32"
+  destination = "any"
+  external    = opnsense_interfaces_virtual_ip.web.address
+  description = "Web server"
+}
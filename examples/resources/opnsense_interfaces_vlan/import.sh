@@ -0,0 +1,2 @@
+# VLANs can be imported using the device name
+terraform import opnsense_interfaces_vlan.example vlan01
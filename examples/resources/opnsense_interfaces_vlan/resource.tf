@@ -0,0 +1,15 @@
+# Create a VLAN on the igb1 parent interface
+resource "opnsense_interfaces_vlan" "guests" {
+  parent      = "igb1"
+  tag         = 20
+  description = "Guest network"
+}
+
+# Create a VLAN with a fixed device name and priority
+resource "opnsense_interfaces_vlan" "voip" {
+  parent      = "igb1"
+  tag         = 30
+  priority    = 5
+  device      = "vlan0.30"
+  description = "VoIP network"
+}
@@ -1,13 +1,20 @@
 package acctest
 
 import (
+	"context"
 	"os"
+	"strings"
 	"testing"
 
 	"terraform-provider-opnsense/internal/provider"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
 const (
@@ -40,3 +47,114 @@ func TestAccPreCheck(t *testing.T) {
 		t.Fatal("OPNSENSE_API_SECRET must be set for acceptance tests")
 	}
 }
+
+// UpgradeResourceState runs the state upgrader registered for the specified prior schema version of a resource
+// against the raw state recorded in the specified file. Returns the upgraded state.
+func UpgradeResourceState(t *testing.T, r resource.Resource, version int64, stateFile string) tfsdk.State {
+	t.Helper()
+	ctx := context.Background()
+
+	upgradableResource, ok := r.(resource.ResourceWithUpgradeState)
+	if !ok {
+		t.Fatalf("resource %T does not implement state upgrades", r)
+	}
+
+	upgrader, ok := upgradableResource.UpgradeState(ctx)[version]
+	if !ok {
+		t.Fatalf("resource %T has no state upgrader for version %d", r, version)
+	}
+
+	rawState, err := os.ReadFile(stateFile)
+	if err != nil {
+		t.Fatalf("unable to read recorded state: %s", err)
+	}
+
+	schemaResp := resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	if schemaResp.Diagnostics.HasError() {
+		t.Fatalf("unable to get resource schema: %v", schemaResp.Diagnostics)
+	}
+
+	req := resource.UpgradeStateRequest{
+		RawState: &tfprotov6.RawState{JSON: rawState},
+	}
+	resp := resource.UpgradeStateResponse{
+		State: tfsdk.State{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+		},
+	}
+
+	upgrader.StateUpgrader(ctx, req, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unable to upgrade state: %v", resp.Diagnostics)
+	}
+
+	return resp.State
+}
+
+// CheckStateAttribute verifies that the attribute at the specified path of the state has the expected value.
+func CheckStateAttribute(t *testing.T, state tfsdk.State, attributePath path.Path, expected attr.Value) {
+	t.Helper()
+
+	var actual attr.Value
+	diags := state.GetAttribute(context.Background(), attributePath, &actual)
+	if diags.HasError() {
+		t.Fatalf("unable to get attribute %s: %v", attributePath, diags)
+	}
+
+	// Compare the underlying terraform values to support custom types
+	expectedValue, err := expected.ToTerraformValue(context.Background())
+	if err != nil {
+		t.Fatalf("unable to convert expected value of attribute %s: %v", attributePath, err)
+	}
+
+	actualValue, err := actual.ToTerraformValue(context.Background())
+	if err != nil {
+		t.Fatalf("unable to convert value of attribute %s: %v", attributePath, err)
+	}
+
+	if !expectedValue.Equal(actualValue) {
+		t.Errorf("attribute %s: expected %s, got %s", attributePath, expected, actual)
+	}
+}
+
+// StateUpgradeTestCase describes a recorded prior state & the attribute values the state upgrader is expected to fill in.
+type StateUpgradeTestCase struct {
+	// Name of the test case.
+	Name string
+	// Resource to upgrade the state of.
+	Resource resource.Resource
+	// Prior schema version of the recorded state.
+	Version int64
+	// File containing the raw recorded state.
+	StateFile string
+	// Expected attribute values, keyed by attribute path. Nested attributes are separated by a dot (e.g. `codel.enabled`).
+	Expected map[string]attr.Value
+}
+
+// RunStateUpgradeTests upgrades the recorded state of each test case & verifies the expected attribute values.
+func RunStateUpgradeTests(t *testing.T, testCases []StateUpgradeTestCase) {
+	t.Helper()
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			state := UpgradeResourceState(t, testCase.Resource, testCase.Version, testCase.StateFile)
+
+			for name, expected := range testCase.Expected {
+				CheckStateAttribute(t, state, attributePath(name), expected)
+			}
+		})
+	}
+}
+
+// attributePath converts a dot separated attribute name to an attribute path.
+func attributePath(name string) path.Path {
+	steps := strings.Split(name, ".")
+
+	attributePath := path.Root(steps[0])
+	for _, step := range steps[1:] {
+		attributePath = attributePath.AtName(step)
+	}
+	return attributePath
+}
@@ -0,0 +1,124 @@
+package customtypes
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ basetypes.StringTypable                    = CaseInsensitiveStringType{}
+	_ basetypes.StringValuableWithSemanticEquals = CaseInsensitiveStringValue{}
+)
+
+// CaseInsensitiveStringType is a string type for values that OPNsense compares case-insensitively (e.g protocols).
+type CaseInsensitiveStringType struct {
+	basetypes.StringType
+}
+
+// String returns a human readable string of the type name.
+func (t CaseInsensitiveStringType) String() string {
+	return "customtypes.CaseInsensitiveStringType"
+}
+
+// ValueType returns the Value type.
+func (t CaseInsensitiveStringType) ValueType(ctx context.Context) attr.Value {
+	return CaseInsensitiveStringValue{}
+}
+
+// Equal returns true if the given type is equivalent.
+func (t CaseInsensitiveStringType) Equal(o attr.Type) bool {
+	other, ok := o.(CaseInsensitiveStringType)
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+// ValueFromString returns a StringValuable type given a StringValue.
+func (t CaseInsensitiveStringType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return CaseInsensitiveStringValue{StringValue: in}, nil
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value.
+func (t CaseInsensitiveStringType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
+
+// CaseInsensitiveStringValue is a string value for values that OPNsense compares case-insensitively (e.g protocols).
+// Values are semantically equal if they only differ in case or surrounding whitespace (e.g `tcp` & `TCP`).
+type CaseInsensitiveStringValue struct {
+	basetypes.StringValue
+}
+
+// NewCaseInsensitiveStringValue creates a CaseInsensitiveStringValue with a known value.
+func NewCaseInsensitiveStringValue(value string) CaseInsensitiveStringValue {
+	return CaseInsensitiveStringValue{StringValue: basetypes.NewStringValue(value)}
+}
+
+// NewCaseInsensitiveStringNull creates a CaseInsensitiveStringValue with a null value.
+func NewCaseInsensitiveStringNull() CaseInsensitiveStringValue {
+	return CaseInsensitiveStringValue{StringValue: basetypes.NewStringNull()}
+}
+
+// Type returns a CaseInsensitiveStringType.
+func (v CaseInsensitiveStringValue) Type(ctx context.Context) attr.Type {
+	return CaseInsensitiveStringType{}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v CaseInsensitiveStringValue) Equal(o attr.Value) bool {
+	other, ok := o.(CaseInsensitiveStringValue)
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals returns true if the given value is semantically equal to the current value.
+func (v CaseInsensitiveStringValue) StringSemanticEquals(ctx context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(CaseInsensitiveStringValue)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", v)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+		)
+
+		return false, diags
+	}
+
+	return normaliseCaseInsensitiveString(v.ValueString()) == normaliseCaseInsensitiveString(newValue.ValueString()), diags
+}
+
+// normaliseCaseInsensitiveString returns the canonical representation of the specified value.
+func normaliseCaseInsensitiveString(value string) string {
+	return strings.ToLower(strings.TrimSpace(value))
+}
@@ -0,0 +1,31 @@
+package customtypes_test
+
+import (
+	"context"
+	"terraform-provider-opnsense/internal/customtypes"
+	"testing"
+)
+
+func TestCaseInsensitiveStringValue_StringSemanticEquals(t *testing.T) {
+	testCases := []struct {
+		prior    string
+		new      string
+		expected bool
+	}{
+		{prior: "tcp", new: "TCP", expected: true},
+		{prior: "TCP/UDP", new: "tcp/udp", expected: true},
+		{prior: " udp", new: "udp", expected: true},
+		{prior: "tcp", new: "udp", expected: false},
+	}
+
+	for _, testCase := range testCases {
+		match, diags := customtypes.NewCaseInsensitiveStringValue(testCase.prior).StringSemanticEquals(context.Background(), customtypes.NewCaseInsensitiveStringValue(testCase.new))
+		if diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+
+		if match != testCase.expected {
+			t.Errorf("expected semantic equality of `%s` & `%s` to be %t, got %t", testCase.prior, testCase.new, testCase.expected, match)
+		}
+	}
+}
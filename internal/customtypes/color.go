@@ -0,0 +1,190 @@
+package customtypes
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ basetypes.StringTypable                    = ColorType{}
+	_ basetypes.StringValuableWithSemanticEquals = ColorValue{}
+	_ xattr.ValidateableAttribute                = ColorValue{}
+)
+
+// hexColorRegex matches a hex color code, with or without a leading `#`.
+var hexColorRegex = regexp.MustCompile(`^#?[0-9a-fA-F]{6}$`)
+
+// colorPalette maps the named palette colors to their hex color codes.
+var colorPalette = map[string]string{
+	"black":  "000000",
+	"white":  "ffffff",
+	"grey":   "808080",
+	"red":    "ff0000",
+	"orange": "ffa500",
+	"yellow": "ffff00",
+	"green":  "008000",
+	"cyan":   "00ffff",
+	"blue":   "0000ff",
+	"purple": "800080",
+	"pink":   "ffc0cb",
+	"brown":  "a52a2a",
+}
+
+// GetColorPaletteNames returns the names of the palette colors, sorted alphabetically.
+func GetColorPaletteNames() []string {
+	names := []string{}
+	for name := range colorPalette {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// NormaliseColor returns the canonical representation of the specified color as stored by OPNsense, i.e a lowercase hex
+// color code without a leading `#`. Palette colors are converted to their hex color code. Returns false if the color is
+// not valid.
+func NormaliseColor(value string) (string, bool) {
+	value = strings.ToLower(strings.TrimSpace(value))
+
+	if value == "" {
+		return "", true
+	}
+
+	if hex, exists := colorPalette[value]; exists {
+		return hex, true
+	}
+
+	if hexColorRegex.MatchString(value) {
+		return strings.TrimPrefix(value, "#"), true
+	}
+
+	return value, false
+}
+
+// ColorType is a string type for hex color codes & named palette colors.
+type ColorType struct {
+	basetypes.StringType
+}
+
+// String returns a human readable string of the type name.
+func (t ColorType) String() string {
+	return "customtypes.ColorType"
+}
+
+// ValueType returns the Value type.
+func (t ColorType) ValueType(ctx context.Context) attr.Value {
+	return ColorValue{}
+}
+
+// Equal returns true if the given type is equivalent.
+func (t ColorType) Equal(o attr.Type) bool {
+	other, ok := o.(ColorType)
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+// ValueFromString returns a StringValuable type given a StringValue.
+func (t ColorType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return ColorValue{StringValue: in}, nil
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value.
+func (t ColorType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
+
+// ColorValue is a string value for hex color codes & named palette colors.
+// Values are semantically equal if they represent the same color (e.g `#FF0000`, `ff0000` & `red`).
+type ColorValue struct {
+	basetypes.StringValue
+}
+
+// NewColorValue creates a ColorValue with a known value.
+func NewColorValue(value string) ColorValue {
+	return ColorValue{StringValue: basetypes.NewStringValue(value)}
+}
+
+// NewColorNull creates a ColorValue with a null value.
+func NewColorNull() ColorValue {
+	return ColorValue{StringValue: basetypes.NewStringNull()}
+}
+
+// Type returns a ColorType.
+func (v ColorValue) Type(ctx context.Context) attr.Type {
+	return ColorType{}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v ColorValue) Equal(o attr.Value) bool {
+	other, ok := o.(ColorValue)
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals returns true if the given value is semantically equal to the current value.
+func (v ColorValue) StringSemanticEquals(ctx context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(ColorValue)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", v)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+		)
+
+		return false, diags
+	}
+
+	prior, _ := NormaliseColor(v.ValueString())
+	new, _ := NormaliseColor(newValue.ValueString())
+	return prior == new, diags
+}
+
+// ValidateAttribute validates that the value is a hex color code or a named palette color.
+func (v ColorValue) ValidateAttribute(ctx context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsNull() || v.IsUnknown() {
+		return
+	}
+
+	if _, ok := NormaliseColor(v.ValueString()); !ok {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Color",
+			fmt.Sprintf("Color `%s` must be a hex color code (e.g `#ff0000` or `ff0000`) or one of the palette colors: %s.", v.ValueString(), strings.Join(GetColorPaletteNames(), ", ")),
+		)
+	}
+}
@@ -0,0 +1,63 @@
+package customtypes_test
+
+import (
+	"context"
+	"terraform-provider-opnsense/internal/customtypes"
+	"testing"
+)
+
+func TestColorValue_StringSemanticEquals(t *testing.T) {
+	testCases := []struct {
+		prior    string
+		new      string
+		expected bool
+	}{
+		{prior: "ff0000", new: "ff0000", expected: true},
+		{prior: "ff0000", new: "FF0000", expected: true},
+		{prior: "ff0000", new: "#ff0000", expected: true},
+		{prior: "ff0000", new: "red", expected: true},
+		{prior: "ff0000", new: "Red", expected: true},
+		{prior: "", new: "", expected: true},
+		{prior: "ff0000", new: "00ff00", expected: false},
+		{prior: "ff0000", new: "", expected: false},
+	}
+
+	for _, testCase := range testCases {
+		match, diags := customtypes.NewColorValue(testCase.prior).StringSemanticEquals(context.Background(), customtypes.NewColorValue(testCase.new))
+		if diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+
+		if match != testCase.expected {
+			t.Errorf("expected semantic equality of `%s` & `%s` to be %t, got %t", testCase.prior, testCase.new, testCase.expected, match)
+		}
+	}
+}
+
+func TestNormaliseColor(t *testing.T) {
+	testCases := []struct {
+		value    string
+		expected string
+		valid    bool
+	}{
+		{value: "#A1B2C3", expected: "a1b2c3", valid: true},
+		{value: " a1b2c3 ", expected: "a1b2c3", valid: true},
+		{value: "blue", expected: "0000ff", valid: true},
+		{value: "", expected: "", valid: true},
+		{value: "#fff", valid: false},
+		{value: "a1b2c3d4", valid: false},
+		{value: "not_a_color", valid: false},
+	}
+
+	for _, testCase := range testCases {
+		color, valid := customtypes.NormaliseColor(testCase.value)
+		if valid != testCase.valid {
+			t.Errorf("expected validity of `%s` to be %t, got %t", testCase.value, testCase.valid, valid)
+			continue
+		}
+
+		if valid && color != testCase.expected {
+			t.Errorf("expected `%s` to be normalised to `%s`, got `%s`", testCase.value, testCase.expected, color)
+		}
+	}
+}
@@ -0,0 +1,144 @@
+package customtypes
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ basetypes.StringTypable                    = NetworkType{}
+	_ basetypes.StringValuableWithSemanticEquals = NetworkValue{}
+)
+
+// NetworkType is a string type for IP addresses, networks in CIDR notation, aliases & predefined networks.
+type NetworkType struct {
+	basetypes.StringType
+}
+
+// String returns a human readable string of the type name.
+func (t NetworkType) String() string {
+	return "customtypes.NetworkType"
+}
+
+// ValueType returns the Value type.
+func (t NetworkType) ValueType(ctx context.Context) attr.Value {
+	return NetworkValue{}
+}
+
+// Equal returns true if the given type is equivalent.
+func (t NetworkType) Equal(o attr.Type) bool {
+	other, ok := o.(NetworkType)
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+// ValueFromString returns a StringValuable type given a StringValue.
+func (t NetworkType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return NetworkValue{StringValue: in}, nil
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value.
+func (t NetworkType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
+
+// NetworkValue is a string value for IP addresses, networks in CIDR notation, aliases & predefined networks.
+// Values are semantically equal if they represent the same address or network (e.g `2001:db8:0::1` & `2001:db8::1`).
+type NetworkValue struct {
+	basetypes.StringValue
+}
+
+// NewNetworkValue creates a NetworkValue with a known value.
+func NewNetworkValue(value string) NetworkValue {
+	return NetworkValue{StringValue: basetypes.NewStringValue(value)}
+}
+
+// NewNetworkNull creates a NetworkValue with a null value.
+func NewNetworkNull() NetworkValue {
+	return NetworkValue{StringValue: basetypes.NewStringNull()}
+}
+
+// Type returns a NetworkType.
+func (v NetworkValue) Type(ctx context.Context) attr.Type {
+	return NetworkType{}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v NetworkValue) Equal(o attr.Value) bool {
+	other, ok := o.(NetworkValue)
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals returns true if the given value is semantically equal to the current value.
+func (v NetworkValue) StringSemanticEquals(ctx context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(NetworkValue)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", v)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+		)
+
+		return false, diags
+	}
+
+	return normaliseNetwork(v.ValueString()) == normaliseNetwork(newValue.ValueString()), diags
+}
+
+// normaliseNetwork returns the canonical representation of the specified network. Host networks (e.g `/32`) are
+// represented as a single address, while aliases & predefined networks are only trimmed.
+func normaliseNetwork(value string) string {
+	value = strings.TrimSpace(value)
+
+	if addr, err := netip.ParseAddr(value); err == nil {
+		return addr.String()
+	}
+
+	if prefix, err := netip.ParsePrefix(value); err == nil {
+		if prefix.IsSingleIP() {
+			return prefix.Addr().String()
+		}
+		return prefix.String()
+	}
+
+	return value
+}
+
+// NewNetworkSetValue creates a set of NetworkValue elements from the specified elements.
+func NewNetworkSetValue(ctx context.Context, elements []string) (basetypes.SetValue, diag.Diagnostics) {
+	return basetypes.NewSetValueFrom(ctx, NetworkType{}, elements)
+}
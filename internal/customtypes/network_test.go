@@ -0,0 +1,37 @@
+package customtypes_test
+
+import (
+	"context"
+	"terraform-provider-opnsense/internal/customtypes"
+	"testing"
+)
+
+func TestNetworkValue_StringSemanticEquals(t *testing.T) {
+	testCases := []struct {
+		prior    string
+		new      string
+		expected bool
+	}{
+		{prior: "10.0.0.1", new: "10.0.0.1", expected: true},
+		{prior: " 10.0.0.1 ", new: "10.0.0.1", expected: true},
+		{prior: "2001:db8:0:0::1", new: "2001:db8::1", expected: true},
+		{prior: "2001:DB8::1", new: "2001:db8::1", expected: true},
+		{prior: "10.0.0.1/32", new: "10.0.0.1", expected: true},
+		{prior: "10.0.0.0/24", new: "10.0.0.0/24", expected: true},
+		{prior: "10.0.0.0/24", new: "10.0.0.0/25", expected: false},
+		{prior: "10.0.0.1", new: "10.0.0.2", expected: false},
+		{prior: "lan", new: "lan", expected: true},
+		{prior: "lan", new: "LAN", expected: false},
+	}
+
+	for _, testCase := range testCases {
+		match, diags := customtypes.NewNetworkValue(testCase.prior).StringSemanticEquals(context.Background(), customtypes.NewNetworkValue(testCase.new))
+		if diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+
+		if match != testCase.expected {
+			t.Errorf("expected semantic equality of `%s` & `%s` to be %t, got %t", testCase.prior, testCase.new, testCase.expected, match)
+		}
+	}
+}
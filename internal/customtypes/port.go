@@ -0,0 +1,160 @@
+package customtypes
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ basetypes.StringTypable                    = PortType{}
+	_ basetypes.StringValuableWithSemanticEquals = PortValue{}
+)
+
+// PortType is a string type for port numbers, port ranges, well known port names & aliases.
+type PortType struct {
+	basetypes.StringType
+}
+
+// String returns a human readable string of the type name.
+func (t PortType) String() string {
+	return "customtypes.PortType"
+}
+
+// ValueType returns the Value type.
+func (t PortType) ValueType(ctx context.Context) attr.Value {
+	return PortValue{}
+}
+
+// Equal returns true if the given type is equivalent.
+func (t PortType) Equal(o attr.Type) bool {
+	other, ok := o.(PortType)
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+// ValueFromString returns a StringValuable type given a StringValue.
+func (t PortType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return PortValue{StringValue: in}, nil
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value.
+func (t PortType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
+
+// PortValue is a string value for port numbers, port ranges, well known port names & aliases.
+// Values are semantically equal if they represent the same port or port range (e.g `80:80` & `80`).
+type PortValue struct {
+	basetypes.StringValue
+}
+
+// NewPortValue creates a PortValue with a known value.
+func NewPortValue(value string) PortValue {
+	return PortValue{StringValue: basetypes.NewStringValue(value)}
+}
+
+// NewPortNull creates a PortValue with a null value.
+func NewPortNull() PortValue {
+	return PortValue{StringValue: basetypes.NewStringNull()}
+}
+
+// Type returns a PortType.
+func (v PortValue) Type(ctx context.Context) attr.Type {
+	return PortType{}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v PortValue) Equal(o attr.Value) bool {
+	other, ok := o.(PortValue)
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals returns true if the given value is semantically equal to the current value.
+func (v PortValue) StringSemanticEquals(ctx context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(PortValue)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", v)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+		)
+
+		return false, diags
+	}
+
+	return normalisePort(v.ValueString()) == normalisePort(newValue.ValueString()), diags
+}
+
+// wellKnownPortNames are the service names (as defined in /etc/services) accepted by OPNsense in place of a port number.
+// Service names are case-insensitive, unlike alias names.
+var wellKnownPortNames = map[string]struct{}{
+	"domain": {}, "ftp": {}, "ftp-data": {}, "http": {}, "https": {}, "imap": {}, "imaps": {}, "isakmp": {},
+	"kerberos": {}, "ldap": {}, "ldaps": {}, "microsoft-ds": {}, "netbios-dgm": {}, "netbios-ns": {},
+	"netbios-ssn": {}, "ntp": {}, "openvpn": {}, "pop3": {}, "pop3s": {}, "radius": {}, "radius-acct": {},
+	"rdp": {}, "smtp": {}, "snmp": {}, "snmptrap": {}, "ssh": {}, "submission": {}, "syslog": {}, "telnet": {},
+	"tftp": {},
+}
+
+// normalisePort returns the canonical representation of the specified port. Port ranges are represented using a dash
+// separator, with single port ranges (e.g `80:80`) represented as a single port. Well known service names are
+// lowercased, while alias names are kept as is since they are case-sensitive.
+func normalisePort(value string) string {
+	value = strings.TrimSpace(value)
+	if _, ok := wellKnownPortNames[strings.ToLower(value)]; ok {
+		return strings.ToLower(value)
+	}
+
+	separator := strings.IndexAny(value, ":-")
+	if separator <= 0 || separator == len(value)-1 {
+		return normalisePortName(value)
+	}
+
+	start := normalisePortName(strings.TrimSpace(value[:separator]))
+	end := normalisePortName(strings.TrimSpace(value[separator+1:]))
+	if start == end {
+		return start
+	}
+
+	return fmt.Sprintf("%s-%s", start, end)
+}
+
+// normalisePortName lowercases the specified port if it is a well known service name.
+func normalisePortName(value string) string {
+	if _, ok := wellKnownPortNames[strings.ToLower(value)]; ok {
+		return strings.ToLower(value)
+	}
+	return value
+}
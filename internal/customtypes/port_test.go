@@ -0,0 +1,39 @@
+package customtypes_test
+
+import (
+	"context"
+	"terraform-provider-opnsense/internal/customtypes"
+	"testing"
+)
+
+func TestPortValue_StringSemanticEquals(t *testing.T) {
+	testCases := []struct {
+		prior    string
+		new      string
+		expected bool
+	}{
+		{prior: "80", new: "80", expected: true},
+		{prior: "80:80", new: "80", expected: true},
+		{prior: "80-80", new: "80", expected: true},
+		{prior: "80:443", new: "80-443", expected: true},
+		{prior: " 443 ", new: "443", expected: true},
+		{prior: "https", new: "HTTPS", expected: true},
+		{prior: "HTTP:https", new: "http-HTTPS", expected: true},
+		{prior: "FTP-DATA", new: "ftp-data", expected: true},
+		{prior: "WebPorts", new: "WebPorts", expected: true},
+		{prior: "WebPorts", new: "webports", expected: false},
+		{prior: "80:443", new: "80:444", expected: false},
+		{prior: "80", new: "8080", expected: false},
+	}
+
+	for _, testCase := range testCases {
+		match, diags := customtypes.NewPortValue(testCase.prior).StringSemanticEquals(context.Background(), customtypes.NewPortValue(testCase.new))
+		if diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+
+		if match != testCase.expected {
+			t.Errorf("expected semantic equality of `%s` & `%s` to be %t, got %t", testCase.prior, testCase.new, testCase.expected, match)
+		}
+	}
+}
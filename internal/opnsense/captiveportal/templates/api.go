@@ -8,6 +8,7 @@ import (
 
 	"terraform-provider-opnsense/internal/opnsense"
 	"terraform-provider-opnsense/internal/opnsense/captiveportal"
+	"terraform-provider-opnsense/internal/utils"
 )
 
 const (
@@ -267,3 +268,13 @@ func applyCaptivePortalTemplateConfig(client *opnsense.Client) error {
 	}
 	return nil
 }
+
+// getCaptivePortalTemplateContentHash gets the content hash of the captive portal template from OPNsense.
+func getCaptivePortalTemplateContentHash(client *opnsense.Client, uuid string) (string, error) {
+	object, err := getCaptivePortalTemplate(client, uuid)
+	if err != nil {
+		return "", err
+	}
+
+	return utils.ContentHash(object)
+}
@@ -7,6 +7,7 @@ import (
 	"regexp"
 	"terraform-provider-opnsense/internal/opnsense"
 	"terraform-provider-opnsense/internal/opnsense/captiveportal"
+	"terraform-provider-opnsense/internal/utils"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
@@ -22,9 +23,10 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &captivePortalTemplatesResource{}
-	_ resource.ResourceWithConfigure   = &captivePortalTemplatesResource{}
-	_ resource.ResourceWithImportState = &captivePortalTemplatesResource{}
+	_ resource.Resource                 = &captivePortalTemplatesResource{}
+	_ resource.ResourceWithConfigure    = &captivePortalTemplatesResource{}
+	_ resource.ResourceWithImportState  = &captivePortalTemplatesResource{}
+	_ resource.ResourceWithUpgradeState = &captivePortalTemplatesResource{}
 )
 
 // NewCaptivePortalTemplatesResource is a helper function to simplify the provider implementation.
@@ -41,6 +43,7 @@ type captivePortalTemplatesResource struct {
 type captivePortalTemplatesResourceModel struct {
 	Id           types.String `tfsdk:"id"`
 	LastUpdated  types.String `tfsdk:"last_updated"`
+	ContentHash  types.String `tfsdk:"content_hash"`
 	Template     types.String `tfsdk:"template"`
 	TemplateHash types.String `tfsdk:"template_hash"`
 	FileId       types.String `tfsdk:"file_id"`
@@ -55,6 +58,7 @@ func (r *captivePortalTemplatesResource) Metadata(ctx context.Context, req resou
 // Schema defines the schema for the resource.
 func (r *captivePortalTemplatesResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:     1,
 		Description: "OPNsense’s template allows for customizing your own login page. It offers additional functionalities such as URL redirection, option for your own Pop-up and a custom Splash page.",
 
 		Attributes: map[string]schema.Attribute{
@@ -66,8 +70,13 @@ func (r *captivePortalTemplatesResource) Schema(ctx context.Context, req resourc
 				},
 			},
 			"last_updated": schema.StringAttribute{
+				Computed:           true,
+				Description:        fmt.Sprintf("DateTime when the %s was last updated.", resourceName),
+				DeprecationMessage: "Use `content_hash` to detect changes to this resource instead. This attribute will be removed in a future release.",
+			},
+			"content_hash": schema.StringAttribute{
 				Computed:    true,
-				Description: fmt.Sprintf("DateTime when the %s was last updated.", resourceName),
+				Description: fmt.Sprintf("Hash of the %s configuration on OPNsense. Changes whenever the configuration is modified, including changes made outside of Terraform.", resourceName),
 			},
 			"template": schema.StringAttribute{
 				Required:    true,
@@ -157,6 +166,11 @@ func (r *captivePortalTemplatesResource) Create(ctx context.Context, req resourc
 	// Update plan file id & sha512 hashed template fields
 	plan.FileId = types.StringValue(fileid)
 
+	// Get content hash from OPNsense
+	contentHash, err := getCaptivePortalTemplateContentHash(r.client, uuid)
+	plan.ContentHash, diags = utils.ContentHashValue(fmt.Sprintf("Create %s error", resourceName), contentHash, err)
+	resp.Diagnostics.Append(diags...)
+
 	// Update plan ID & last_updated fields
 	plan.Id = types.StringValue(uuid)
 	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
@@ -197,6 +211,11 @@ func (r *captivePortalTemplatesResource) Read(ctx context.Context, req resource.
 	tflog.Debug(ctx, fmt.Sprintf("Successfully got %s information", resourceName), map[string]any{"success": true})
 
 	// Overwite items with refreshed state
+	contentHash, err := utils.ContentHash(template)
+	contentHashValue, diags := utils.ContentHashValue(fmt.Sprintf("Read %s error", resourceName), contentHash, err)
+	resp.Diagnostics.Append(diags...)
+	state.ContentHash = contentHashValue
+
 	state.FileId = types.StringValue(template.FileId)
 	state.Name = types.StringValue(template.Name)
 
@@ -262,6 +281,11 @@ func (r *captivePortalTemplatesResource) Update(ctx context.Context, req resourc
 	// Update plan file id & sha512 hashed template fields
 	plan.FileId = types.StringValue(fileid)
 
+	// Get content hash from OPNsense
+	contentHash, err := getCaptivePortalTemplateContentHash(r.client, state.Id.ValueString())
+	plan.ContentHash, diags = utils.ContentHashValue(fmt.Sprintf("Update %s error", resourceName), contentHash, err)
+	resp.Diagnostics.Append(diags...)
+
 	// Update last_updated field (if change detected)
 	if !(reflect.DeepEqual(plan, state)) {
 		plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
@@ -0,0 +1,56 @@
+package templates
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-opnsense/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// captivePortalTemplatesResourceModelV0 describes the resource data model of schema version 0.
+type captivePortalTemplatesResourceModelV0 struct {
+	Id           *string `json:"id"`
+	LastUpdated  *string `json:"last_updated"`
+	Template     *string `json:"template"`
+	TemplateHash *string `json:"template_hash"`
+	FileId       *string `json:"file_id"`
+	Name         *string `json:"name"`
+}
+
+// UpgradeState upgrades the resource state from prior schema versions to the current schema version.
+func (r *captivePortalTemplatesResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {StateUpgrader: upgradeCaptivePortalTemplatesStateV0},
+	}
+}
+
+// upgradeCaptivePortalTemplatesStateV0 upgrades the resource state from schema version 0.
+func upgradeCaptivePortalTemplatesStateV0(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	tflog.Info(ctx, fmt.Sprintf("Upgrading %s state from version 0", resourceName))
+
+	var priorState captivePortalTemplatesResourceModelV0
+	err := utils.DecodeRawState(req, &priorState)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Upgrade %s state error", resourceName), fmt.Sprintf("%s", err))
+		return
+	}
+
+	state := captivePortalTemplatesResourceModel{
+		Id:           utils.StringOrNull(priorState.Id),
+		LastUpdated:  utils.StringOrNull(priorState.LastUpdated),
+		Template:     utils.StringOrNull(priorState.Template),
+		TemplateHash: utils.StringOrNull(priorState.TemplateHash),
+		FileId:       utils.StringOrNull(priorState.FileId),
+		Name:         utils.StringOrNull(priorState.Name),
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Successfully upgraded %s state from version 0", resourceName))
+}
@@ -0,0 +1,24 @@
+package templates_test
+
+import (
+	"terraform-provider-opnsense/internal/acctest"
+	"terraform-provider-opnsense/internal/opnsense/captiveportal/templates"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestCaptivePortalTemplatesResource_upgradeState(t *testing.T) {
+	acctest.RunStateUpgradeTests(t, []acctest.StateUpgradeTestCase{
+		{
+			Name:      "v0 defaults",
+			Resource:  templates.NewCaptivePortalTemplatesResource(),
+			Version:   0,
+			StateFile: "testdata/templates_resource_v0.json",
+			Expected: map[string]attr.Value{
+				"template_hash": types.StringNull(),
+			},
+		},
+	})
+}
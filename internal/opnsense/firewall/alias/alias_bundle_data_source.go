@@ -0,0 +1,200 @@
+package alias
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"terraform-provider-opnsense/internal/opnsense"
+	"terraform-provider-opnsense/internal/opnsense/firewall"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &aliasBundleDataSource{}
+	_ datasource.DataSourceWithConfigure = &aliasBundleDataSource{}
+)
+
+// NewAliasBundleDataSource is a helper function to simplify the provider implementation.
+func NewAliasBundleDataSource() datasource.DataSource {
+	return &aliasBundleDataSource{}
+}
+
+// aliasBundleDataSource defines the data source implementation.
+type aliasBundleDataSource struct {
+	client *opnsense.Client
+}
+
+// aliasBundleDataSourceModel describes the data source data model.
+type aliasBundleDataSourceModel struct {
+	Names   []types.String                    `tfsdk:"names"`
+	Aliases map[string]aliasBundleMemberModel `tfsdk:"aliases"`
+	Export  types.String                      `tfsdk:"export"`
+}
+
+// Metadata returns the data source type name.
+func (d *aliasBundleDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_%s_%s_bundle", req.ProviderTypeName, firewall.TypeName, controller)
+}
+
+// Schema defines the schema for the datasource.
+func (d *aliasBundleDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Exports firewall aliases through the bulk export API of OPNsense. Useful for backups, or to copy aliases to another firewall with `opnsense_firewall_alias_bundle`.",
+
+		Attributes: map[string]schema.Attribute{
+			"names": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "The names of the aliases to export. All aliases are exported if not set.",
+			},
+			"aliases": schema.MapNestedAttribute{
+				Computed:    true,
+				Description: "The exported aliases, keyed by alias name.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"enabled": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the alias is enabled.",
+						},
+						"type": schema.StringAttribute{
+							Computed:    true,
+							Description: "The type of the alias.",
+						},
+						"counters": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the statistics of the alias is enabled.",
+						},
+						"updatefreq": schema.Float64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The update frequency of the alias in days. Only applicable to `urltable` aliases.",
+						},
+						"description": schema.StringAttribute{
+							Computed:    true,
+							Description: "The description of the alias.",
+						},
+						"proto": schema.SetAttribute{
+							Computed:            true,
+							ElementType:         types.StringType,
+							MarkdownDescription: "The alias protocols. Only applicable to `asn` & `geoip` aliases.",
+						},
+						"content": schema.SetAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "The content of the alias.",
+						},
+						"interface": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The interface for the v6 dynamic IP. Only applicable to `dynipv6host` aliases.",
+						},
+					},
+				},
+			},
+			"export": schema.StringAttribute{
+				Computed:    true,
+				Description: "The exported aliases as JSON, in the format used by the alias import & export of OPNsense.",
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *aliasBundleDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*opnsense.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *opnsense.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *aliasBundleDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, fmt.Sprintf("Reading %s", aliasBundleResourceName))
+
+	// Read Terraform configuration data into the model
+	var data aliasBundleDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Export aliases
+	tflog.Debug(ctx, fmt.Sprintf("Getting %s information", aliasBundleResourceName))
+
+	aliases, raw, err := exportAliases(d.client)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Read %s error", aliasBundleResourceName), fmt.Sprintf("%s", err))
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Successfully got %s information", aliasBundleResourceName), map[string]any{"count": len(aliases)})
+
+	// Filter aliases by name
+	names := []string{}
+	if data.Names == nil {
+		for name := range aliases {
+			names = append(names, name)
+		}
+	} else {
+		missing := []string{}
+		for _, name := range data.Names {
+			if _, exists := aliases[name.ValueString()]; !exists {
+				missing = append(missing, name.ValueString())
+			}
+			names = append(names, name.ValueString())
+		}
+
+		if len(missing) > 0 {
+			resp.Diagnostics.AddError(fmt.Sprintf("Read %s error", aliasBundleResourceName), fmt.Sprintf("Aliases do not exist on OPNsense: %s", strings.Join(missing, ", ")))
+			return
+		}
+
+		raw, err = filterAliasExport(raw, names)
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Read %s error", aliasBundleResourceName), fmt.Sprintf("Unable to filter export: %s", err))
+			return
+		}
+	}
+	sort.Strings(names)
+
+	// Map response to model
+	data.Aliases = make(map[string]aliasBundleMemberModel)
+	for _, name := range names {
+		member, diags := aliasToAliasBundleMember(ctx, aliases[name])
+		resp.Diagnostics.Append(diags...)
+		data.Aliases[name] = member
+	}
+	data.Export = types.StringValue(raw)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Successfully read %s", aliasBundleResourceName), map[string]any{"success": true})
+}
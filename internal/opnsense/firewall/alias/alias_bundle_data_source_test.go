@@ -0,0 +1,71 @@
+package alias_test
+
+import (
+	"regexp"
+	"testing"
+
+	"terraform-provider-opnsense/internal/acctest"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccAliasBundleDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing (via names)
+			{
+				Config: testAccAliasBundleDataSourceConfig_names,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("data.opnsense_firewall_alias_bundle.test_acc_data_source_bundle", tfjsonpath.New("aliases"), knownvalue.MapSizeExact(1)),
+					statecheck.ExpectKnownValue("data.opnsense_firewall_alias_bundle.test_acc_data_source_bundle", tfjsonpath.New("aliases").AtMapKey("test_acc_bundle_data_source").AtMapKey("type"), knownvalue.StringExact("network")),
+					statecheck.ExpectKnownValue("data.opnsense_firewall_alias_bundle.test_acc_data_source_bundle", tfjsonpath.New("aliases").AtMapKey("test_acc_bundle_data_source").AtMapKey("content"), knownvalue.SetExact([]knownvalue.Check{
+						knownvalue.StringExact("10.0.0.0/24"),
+					})),
+					statecheck.ExpectKnownValue("data.opnsense_firewall_alias_bundle.test_acc_data_source_bundle", tfjsonpath.New("export"), knownvalue.StringRegexp(regexp.MustCompile("test_acc_bundle_data_source"))),
+				},
+			},
+		},
+	})
+}
+
+func TestAccAliasBundleDataSource_missing(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing (missing alias)
+			{
+				Config:      testAccAliasBundleDataSourceConfig_missing,
+				ExpectError: regexp.MustCompile("Aliases do not exist on OPNsense"),
+			},
+		},
+	})
+}
+
+// testAccAliasBundleDataSourceConfig_names creates an alias resource and exports it as a data source via its name.
+const testAccAliasBundleDataSourceConfig_names = `
+	resource "opnsense_firewall_alias" "test_acc_data_source_bundle" {
+		name = "test_acc_bundle_data_source"
+		type = "network"
+		description = "alias for terraform bundle datasource testing"
+		content = [
+			"10.0.0.0/24"
+		]
+	}
+
+	data "opnsense_firewall_alias_bundle" "test_acc_data_source_bundle" {
+		names = [opnsense_firewall_alias.test_acc_data_source_bundle.name]
+	}
+`
+
+// testAccAliasBundleDataSourceConfig_missing exports an alias that does not exist.
+const testAccAliasBundleDataSourceConfig_missing = `
+	data "opnsense_firewall_alias_bundle" "test_acc_data_source_bundle" {
+		names = ["test_acc_bundle_missing"]
+	}
+`
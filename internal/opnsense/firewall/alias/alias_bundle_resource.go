@@ -0,0 +1,766 @@
+package alias
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
+
+	"terraform-provider-opnsense/internal/opnsense"
+	"terraform-provider-opnsense/internal/opnsense/firewall"
+	"terraform-provider-opnsense/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                   = &aliasBundleResource{}
+	_ resource.ResourceWithConfigure      = &aliasBundleResource{}
+	_ resource.ResourceWithImportState    = &aliasBundleResource{}
+	_ resource.ResourceWithValidateConfig = &aliasBundleResource{}
+)
+
+// NewAliasBundleResource is a helper function to simplify the provider implementation.
+func NewAliasBundleResource() resource.Resource {
+	return &aliasBundleResource{}
+}
+
+// aliasBundleResource defines the resource implementation.
+type aliasBundleResource struct {
+	client *opnsense.Client
+}
+
+// aliasBundleResourceModel describes the resource data model.
+type aliasBundleResourceModel struct {
+	Id          types.String `tfsdk:"id"`
+	ContentHash types.String `tfsdk:"content_hash"`
+	Name        types.String `tfsdk:"name"`
+	Aliases     types.Map    `tfsdk:"aliases"`
+	ExportJson  types.String `tfsdk:"export_json"`
+}
+
+// aliasBundleMemberModel describes a single alias of the bundle.
+type aliasBundleMemberModel struct {
+	Enabled     types.Bool    `tfsdk:"enabled"`
+	Type        types.String  `tfsdk:"type"`
+	Counters    types.Bool    `tfsdk:"counters"`
+	UpdateFreq  types.Float64 `tfsdk:"updatefreq"`
+	Description types.String  `tfsdk:"description"`
+	Proto       types.Set     `tfsdk:"proto"`
+	Content     types.Set     `tfsdk:"content"`
+	Interface   types.String  `tfsdk:"interface"`
+}
+
+// getAliasBundleMemberAttrTypes returns the attribute types of an alias bundle member.
+func getAliasBundleMemberAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"enabled":     types.BoolType,
+		"type":        types.StringType,
+		"counters":    types.BoolType,
+		"updatefreq":  types.Float64Type,
+		"description": types.StringType,
+		"proto":       types.SetType{ElemType: types.StringType},
+		"content":     types.SetType{ElemType: types.StringType},
+		"interface":   types.StringType,
+	}
+}
+
+// Metadata returns the resource type name.
+func (r *aliasBundleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_%s_%s_bundle", req.ProviderTypeName, firewall.TypeName, controller)
+}
+
+// Schema defines the schema for the resource.
+func (r *aliasBundleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	emptySet, _ := basetypes.NewSetValue(types.StringType, []attr.Value{})
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a set of firewall aliases through the bulk import API of OPNsense. All changed aliases are imported in a single request and the alias configuration is only applied once, which is considerably faster than one `opnsense_firewall_alias` per alias for large sets. " +
+			"The aliases are either defined in `aliases` or given as an OPNsense alias export document in `export_json`. Aliases are tracked by name, existing aliases with the same name are taken over by the bundle. " +
+			"Members of `aliases` keep the categories of the alias they take over, categories of an `export_json` document are imported as-is.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: fmt.Sprintf("Identifier of the %s, same as `name`.", aliasBundleResourceName),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"content_hash": schema.StringAttribute{
+				Computed:    true,
+				Description: fmt.Sprintf("Hash of the %s configuration on OPNsense. Changes whenever the configuration is modified, including changes made outside of Terraform.", aliasBundleResourceName),
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the bundle. Only used to identify the bundle in Terraform.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"export_json": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The aliases of the bundle as an OPNsense alias export document (e.g the `export` of the `opnsense_firewall_alias_bundle` data source, or a file downloaded from **Firewall > Aliases > Export**), sent as-is to the import API. Exactly one of `aliases` and `export_json` must be set.",
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("aliases")),
+				},
+			},
+			"aliases": schema.MapNestedAttribute{
+				Optional:    true,
+				Description: "The aliases of the bundle, keyed by alias name. Exactly one of `aliases` and `export_json` must be set.",
+				Validators: []validator.Map{
+					mapvalidator.SizeAtLeast(1),
+					mapvalidator.KeysAre(
+						stringvalidator.RegexMatches(aliasNameRegex, "must only contain letters, digits and underscores, with a maximum length of 32 characters"),
+					),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"enabled": schema.BoolAttribute{
+							Optional:            true,
+							Computed:            true,
+							MarkdownDescription: "Whether the alias is enabled. Defaults to `true`.",
+							Default:             booldefault.StaticBool(true),
+						},
+						"type": schema.StringAttribute{
+							Required: true,
+							MarkdownDescription: fmt.Sprintf(
+								"The type of the alias. Must be one of: %s.", strings.Join(
+									// Surround each type with backticks (`)
+									utils.SliceMap(getAliasBundleMemberTypes(), func(aliasType string) string {
+										return fmt.Sprintf("`%s`", aliasType)
+									}),
+									", ",
+								),
+							),
+							Validators: []validator.String{
+								// Type must be one of the listed values
+								stringvalidator.OneOf(getAliasBundleMemberTypes()...),
+							},
+						},
+						"counters": schema.BoolAttribute{
+							Optional:            true,
+							Computed:            true,
+							MarkdownDescription: "Whether the statistics of the alias is enabled. Defaults to `false`.",
+							Default:             booldefault.StaticBool(false),
+						},
+						"updatefreq": schema.Float64Attribute{
+							Optional:            true,
+							Computed:            true,
+							MarkdownDescription: "[Only for `urltable` type] The update frequency of the alias in days (e.g `0.5` for every 12 hours). Defaults to `0`.",
+							Default:             float64default.StaticFloat64(0),
+						},
+						"description": schema.StringAttribute{
+							Optional:    true,
+							Computed:    true,
+							Description: "The description of the alias.",
+							Default:     stringdefault.StaticString(""),
+						},
+						"proto": schema.SetAttribute{
+							Optional:            true,
+							Computed:            true,
+							ElementType:         types.StringType,
+							MarkdownDescription: "[Only for `asn` & `geoip` types] The alias protocols. Must be one of: `ipv4`, `ipv6`.",
+							Validators: []validator.Set{
+								setvalidator.ValueStringsAre(stringvalidator.OneOf("ipv4", "ipv6")),
+							},
+							Default: setdefault.StaticValue(emptySet),
+						},
+						"content": schema.SetAttribute{
+							Optional:    true,
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "The content of the alias. Each element is validated based on the alias type, the same way as the content of `opnsense_firewall_alias`.",
+							Default:     setdefault.StaticValue(emptySet),
+						},
+						"interface": schema.StringAttribute{
+							Optional:    true,
+							Computed:    true,
+							Description: "[Only for `dynipv6host` type] The interface for the v6 dynamic IP.",
+							Default:     stringdefault.StaticString(""),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig validates the resource configuration for cross-attribute constraints.
+func (r *aliasBundleResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config aliasBundleResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.ExportJson.IsNull() && !config.ExportJson.IsUnknown() {
+		items, err := parseAliasExport(config.ExportJson.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("export_json"), "Invalid Alias Export", fmt.Sprintf("The alias export document could not be parsed: %s.", err))
+			return
+		}
+
+		if len(items) == 0 {
+			resp.Diagnostics.AddAttributeError(path.Root("export_json"), "Invalid Alias Export", "The alias export document must contain at least one alias.")
+		}
+
+		for name := range items {
+			if !aliasNameRegex.MatchString(name) {
+				resp.Diagnostics.AddAttributeError(path.Root("export_json"), "Invalid Alias Export", fmt.Sprintf("Alias name `%s` must only contain letters, digits and underscores, with a maximum length of 32 characters.", name))
+			}
+		}
+	}
+
+	// Skip validation if the aliases are not yet known
+	if config.Aliases.IsNull() || config.Aliases.IsUnknown() {
+		return
+	}
+
+	for name, element := range config.Aliases.Elements() {
+		object, ok := element.(types.Object)
+		if !ok || object.IsNull() || object.IsUnknown() {
+			continue
+		}
+
+		var member aliasBundleMemberModel
+		resp.Diagnostics.Append(object.As(ctx, &member, basetypes.ObjectAsOptions{UnhandledUnknownAsEmpty: true})...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		// Skip validation if the type is not yet known
+		if member.Type.IsNull() || member.Type.IsUnknown() {
+			continue
+		}
+
+		aliasType := member.Type.ValueString()
+		memberPath := path.Root("aliases").AtMapKey(name)
+
+		if !member.Interface.IsNull() && !member.Interface.IsUnknown() && member.Interface.ValueString() != "" && aliasType != "dynipv6host" {
+			resp.Diagnostics.AddAttributeError(memberPath.AtName("interface"), "Invalid Attribute Combination", fmt.Sprintf("The `interface` attribute is only applicable when `type` is set to `dynipv6host`, got: `%s`.", aliasType))
+		}
+
+		if !member.Proto.IsNull() && !member.Proto.IsUnknown() && len(member.Proto.Elements()) > 0 && aliasType != "asn" && aliasType != "geoip" {
+			resp.Diagnostics.AddAttributeError(memberPath.AtName("proto"), "Invalid Attribute Combination", fmt.Sprintf("The `proto` attribute is only applicable when `type` is set to `asn` or `geoip`, got: `%s`.", aliasType))
+		}
+
+		if !member.UpdateFreq.IsNull() && !member.UpdateFreq.IsUnknown() && member.UpdateFreq.ValueFloat64() != 0 && aliasType != "urltable" {
+			resp.Diagnostics.AddAttributeError(memberPath.AtName("updatefreq"), "Invalid Attribute Combination", fmt.Sprintf("The `updatefreq` attribute is only applicable when `type` is set to `urltable`, got: `%s`.", aliasType))
+		}
+
+		// Validate each content element based on the alias type
+		validateContent, exists := getAliasContentValidators()[aliasType]
+		if !exists || member.Content.IsNull() || member.Content.IsUnknown() {
+			continue
+		}
+
+		for _, contentElement := range member.Content.Elements() {
+			content, ok := contentElement.(types.String)
+			if !ok || content.IsNull() || content.IsUnknown() {
+				continue
+			}
+
+			if err := validateContent(content.ValueString()); err != nil {
+				resp.Diagnostics.AddAttributeError(memberPath.AtName("content"), "Invalid Alias Content", fmt.Sprintf("Content `%s` is not valid for an alias of type `%s`: %s.", content.ValueString(), aliasType, err))
+			}
+		}
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *aliasBundleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*opnsense.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *opnsense.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *aliasBundleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, fmt.Sprintf("Creating %s", aliasBundleResourceName))
+
+	// Read Terraform plan data into the model
+	var plan aliasBundleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Sync aliases on OPNsense
+	names := r.syncAliases(ctx, plan, nil, "Create", &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get content hash from OPNsense
+	contentHash, err := getAliasBundleContentHash(r.client, names)
+	contentHashValue, diags := utils.ContentHashValue(fmt.Sprintf("Create %s error", aliasBundleResourceName), contentHash, err)
+	resp.Diagnostics.Append(diags...)
+	plan.ContentHash = contentHashValue
+
+	plan.Id = plan.Name
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Successfully created %s", aliasBundleResourceName))
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *aliasBundleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, fmt.Sprintf("Reading %s", aliasBundleResourceName))
+
+	// Read Terraform prior state data into the model
+	var state aliasBundleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Export aliases
+	tflog.Debug(ctx, fmt.Sprintf("Getting %s information", aliasBundleResourceName))
+	tflog.SetField(ctx, "name", state.Name.ValueString())
+
+	aliases, raw, err := exportAliases(r.client)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Read %s error", aliasBundleResourceName), fmt.Sprintf("%s", err))
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Successfully got %s information", aliasBundleResourceName), map[string]any{"success": true})
+
+	// Overwite items with refreshed state, aliases removed outside of Terraform are dropped from the bundle
+	var hashed map[string]alias
+	if !state.ExportJson.IsNull() {
+		hashed = r.refreshExportJson(ctx, &state, aliases, raw, &resp.Diagnostics)
+	} else {
+		hashed = r.refreshAliases(ctx, &state, aliases, &resp.Diagnostics)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Remove bundle from state if all aliases were removed outside of Terraform
+	if len(hashed) == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	contentHash, err := utils.ContentHash(hashed)
+	contentHashValue, diags := utils.ContentHashValue(fmt.Sprintf("Read %s error", aliasBundleResourceName), contentHash, err)
+	resp.Diagnostics.Append(diags...)
+	state.ContentHash = contentHashValue
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Successfully read %s", aliasBundleResourceName))
+}
+
+// Update updates the resource on OPNsense and the Terraform state.
+func (r *aliasBundleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, fmt.Sprintf("Updating %s", aliasBundleResourceName))
+
+	// Read Terraform plan data into the model
+	var plan aliasBundleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read current Terraform state data into the model
+	var state aliasBundleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Sync aliases on OPNsense
+	names := r.syncAliases(ctx, plan, &state, "Update", &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get content hash from OPNsense
+	contentHash, err := getAliasBundleContentHash(r.client, names)
+	contentHashValue, diags := utils.ContentHashValue(fmt.Sprintf("Update %s error", aliasBundleResourceName), contentHash, err)
+	resp.Diagnostics.Append(diags...)
+	plan.ContentHash = contentHashValue
+
+	plan.Id = plan.Name
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Successfully updated %s", aliasBundleResourceName))
+}
+
+// Delete removes the resource on OPNsense and from the Terraform state.
+func (r *aliasBundleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, fmt.Sprintf("Deleting %s", aliasBundleResourceName))
+
+	// Read Terraform prior state data into the model
+	var state aliasBundleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete aliases on OPNsense
+	names, err := getAliasBundleNames(state)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Delete %s error", aliasBundleResourceName), fmt.Sprintf("%s", err))
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Deleting %s on OPNsense", aliasBundleResourceName), map[string]any{"aliases": names})
+
+	err = deleteAliasesByName(r.client, names)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Delete %s error", aliasBundleResourceName), fmt.Sprintf("%s", err))
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Apply configuration on OPNsense
+	tflog.Debug(ctx, "Applying configuration on OPNsense")
+
+	err = applyConfig(r.client)
+	if err != nil {
+		resp.Diagnostics.AddWarning(fmt.Sprintf("Delete %s error", aliasBundleResourceName), fmt.Sprintf("%s", err))
+	} else {
+		tflog.Debug(ctx, "Successfully applied configuration on OPNsense", map[string]any{"success": true})
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Successfully deleted %s", aliasBundleResourceName))
+}
+
+// ImportState imports the resource from OPNsense and enables Terraform to begin managing the resource.
+func (r *aliasBundleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Info(ctx, fmt.Sprintf("Importing %s", aliasBundleResourceName))
+
+	name, aliasList, found := strings.Cut(req.ID, ":")
+	names := splitAliasList(aliasList, ",")
+	if !found || name == "" || len(names) == 0 {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Import %s error", aliasBundleResourceName),
+			fmt.Sprintf("Expected import identifier in the format `<name>:<alias>,<alias>,...`, got: `%s`", req.ID),
+		)
+		return
+	}
+
+	// Only the alias names are known at this point, the aliases are refreshed on read
+	members := make(map[string]attr.Value)
+	for _, alias := range names {
+		members[alias] = types.ObjectNull(getAliasBundleMemberAttrTypes())
+	}
+
+	aliasesValue, diags := types.MapValue(types.ObjectType{AttrTypes: getAliasBundleMemberAttrTypes()}, members)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), name)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("aliases"), aliasesValue)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Successfully imported %s", aliasBundleResourceName))
+}
+
+// syncAliases imports the new & changed aliases of the plan, deletes the aliases removed from the bundle and applies the configuration once. Returns the names of the aliases in the bundle.
+func (r *aliasBundleResource) syncAliases(ctx context.Context, plan aliasBundleResourceModel, state *aliasBundleResourceModel, action string, diagnostics *diag.Diagnostics) []string {
+	names, err := getAliasBundleNames(plan)
+	if err != nil {
+		diagnostics.AddError(fmt.Sprintf("%s %s error", action, aliasBundleResourceName), fmt.Sprintf("%s", err))
+		return nil
+	}
+
+	removed := []string{}
+	if state != nil {
+		stateNames, err := getAliasBundleNames(*state)
+		if err != nil {
+			diagnostics.AddError(fmt.Sprintf("%s %s error", action, aliasBundleResourceName), fmt.Sprintf("%s", err))
+			return nil
+		}
+
+		for _, name := range stateNames {
+			if !slices.Contains(names, name) {
+				removed = append(removed, name)
+			}
+		}
+	}
+
+	var imported int
+	if !plan.ExportJson.IsNull() {
+		imported = r.importExportJson(ctx, plan, state, action, diagnostics)
+	} else {
+		imported = r.importMembers(ctx, plan, state, action, diagnostics)
+	}
+	if diagnostics.HasError() {
+		return nil
+	}
+
+	if len(removed) > 0 {
+		tflog.Debug(ctx, fmt.Sprintf("Deleting aliases removed from %s on OPNsense", aliasBundleResourceName), map[string]any{"aliases": removed})
+
+		err := deleteAliasesByName(r.client, removed)
+		if err != nil {
+			diagnostics.AddError(fmt.Sprintf("%s %s error", action, aliasBundleResourceName), fmt.Sprintf("%s", err))
+			return nil
+		}
+	}
+
+	// Apply configuration on OPNsense
+	if imported > 0 || len(removed) > 0 {
+		tflog.Debug(ctx, "Applying configuration on OPNsense")
+
+		err := applyConfig(r.client)
+		if err != nil {
+			diagnostics.AddWarning(fmt.Sprintf("%s %s error", action, aliasBundleResourceName), fmt.Sprintf("%s", err))
+		} else {
+			tflog.Debug(ctx, "Successfully applied configuration on OPNsense", map[string]any{"success": true})
+		}
+	}
+
+	return names
+}
+
+// importMembers imports the new & changed members of the `aliases` attribute of the plan. Aliases taken over by the bundle keep their categories. Returns the number of imported aliases.
+func (r *aliasBundleResource) importMembers(ctx context.Context, plan aliasBundleResourceModel, state *aliasBundleResourceModel, action string, diagnostics *diag.Diagnostics) int {
+	var planMembers, stateMembers map[string]aliasBundleMemberModel
+	diagnostics.Append(plan.Aliases.ElementsAs(ctx, &planMembers, false)...)
+	if state != nil && !state.Aliases.IsNull() {
+		diagnostics.Append(state.Aliases.ElementsAs(ctx, &stateMembers, false)...)
+	}
+	if diagnostics.HasError() {
+		return 0
+	}
+
+	// Only import aliases that are new or changed
+	changed := []alias{}
+	for name, member := range planMembers {
+		if stateMember, exists := stateMembers[name]; exists && reflect.DeepEqual(member, stateMember) {
+			continue
+		}
+
+		alias, diags := aliasBundleMemberToAlias(ctx, name, member)
+		diagnostics.Append(diags...)
+		changed = append(changed, alias)
+	}
+	if diagnostics.HasError() || len(changed) == 0 {
+		return 0
+	}
+
+	// Keep the categories of existing aliases, the bundle members do not manage them
+	existing, _, err := exportAliases(r.client)
+	if err != nil {
+		diagnostics.AddError(fmt.Sprintf("%s %s error", action, aliasBundleResourceName), fmt.Sprintf("%s", err))
+		return 0
+	}
+
+	for i := range changed {
+		if current, exists := existing[changed[i].Name]; exists {
+			changed[i].Categories = current.Categories
+		}
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Importing %s on OPNsense", aliasBundleResourceName), map[string]any{"count": len(changed)})
+
+	err = importAliases(r.client, changed)
+	if err != nil {
+		diagnostics.AddError(fmt.Sprintf("%s %s error", action, aliasBundleResourceName), fmt.Sprintf("%s", err))
+		return 0
+	}
+
+	return len(changed)
+}
+
+// importExportJson imports the new & changed aliases of the `export_json` document of the plan as-is. Returns the number of imported aliases.
+func (r *aliasBundleResource) importExportJson(ctx context.Context, plan aliasBundleResourceModel, state *aliasBundleResourceModel, action string, diagnostics *diag.Diagnostics) int {
+	planItems, err := parseAliasExport(plan.ExportJson.ValueString())
+	if err != nil {
+		diagnostics.AddError(fmt.Sprintf("%s %s error", action, aliasBundleResourceName), fmt.Sprintf("Failed to parse `export_json`: %s", err))
+		return 0
+	}
+
+	stateItems := map[string]aliasExportItem{}
+	if state != nil && !state.ExportJson.IsNull() {
+		stateItems, err = parseAliasExport(state.ExportJson.ValueString())
+		if err != nil {
+			diagnostics.AddError(fmt.Sprintf("%s %s error", action, aliasBundleResourceName), fmt.Sprintf("Failed to parse `export_json`: %s", err))
+			return 0
+		}
+	}
+
+	// Only import aliases that are new or changed
+	changed := make(map[string]json.RawMessage)
+	for name, item := range planItems {
+		if stateItem, exists := stateItems[name]; exists && aliasExportItemsEqual(item, stateItem) {
+			continue
+		}
+
+		changed[item.Uuid] = item.Raw
+	}
+	if len(changed) == 0 {
+		return 0
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Importing %s on OPNsense", aliasBundleResourceName), map[string]any{"count": len(changed)})
+
+	err = importAliasExport(r.client, changed)
+	if err != nil {
+		diagnostics.AddError(fmt.Sprintf("%s %s error", action, aliasBundleResourceName), fmt.Sprintf("%s", err))
+		return 0
+	}
+
+	return len(changed)
+}
+
+// refreshAliases refreshes the `aliases` attribute of the state with the specified exported aliases. Aliases removed outside of Terraform are dropped from the bundle. Returns the refreshed aliases.
+func (r *aliasBundleResource) refreshAliases(ctx context.Context, state *aliasBundleResourceModel, aliases map[string]alias, diagnostics *diag.Diagnostics) map[string]alias {
+	members := make(map[string]aliasBundleMemberModel)
+	refreshed := make(map[string]alias)
+	for name := range state.Aliases.Elements() {
+		alias, exists := aliases[name]
+		if !exists {
+			tflog.Warn(ctx, fmt.Sprintf("Alias of %s not found on OPNsense, removing from state", aliasBundleResourceName), map[string]any{"alias": name})
+			continue
+		}
+
+		member, diags := aliasToAliasBundleMember(ctx, alias)
+		diagnostics.Append(diags...)
+		members[name] = member
+		refreshed[name] = alias
+	}
+
+	aliasesValue, diags := types.MapValueFrom(ctx, types.ObjectType{AttrTypes: getAliasBundleMemberAttrTypes()}, members)
+	diagnostics.Append(diags...)
+	state.Aliases = aliasesValue
+
+	return refreshed
+}
+
+// refreshExportJson refreshes the `export_json` attribute of the state with the specified exported aliases. The document is only replaced by the
+// export of OPNsense when an alias differs, so that the formatting of the configured document is kept. Returns the refreshed aliases.
+func (r *aliasBundleResource) refreshExportJson(ctx context.Context, state *aliasBundleResourceModel, aliases map[string]alias, raw string, diagnostics *diag.Diagnostics) map[string]alias {
+	items, err := parseAliasExport(state.ExportJson.ValueString())
+	if err != nil {
+		diagnostics.AddError(fmt.Sprintf("Read %s error", aliasBundleResourceName), fmt.Sprintf("Failed to parse `export_json`: %s", err))
+		return nil
+	}
+
+	refreshed := make(map[string]alias)
+	changed := false
+	for name, item := range items {
+		current, exists := aliases[name]
+		if !exists {
+			tflog.Warn(ctx, fmt.Sprintf("Alias of %s not found on OPNsense, removing from state", aliasBundleResourceName), map[string]any{"alias": name})
+			changed = true
+			continue
+		}
+
+		refreshed[name] = current
+
+		configured, err := aliasExportItemToAlias(item.Raw)
+		if err != nil || !reflect.DeepEqual(configured, current) {
+			changed = true
+		}
+	}
+
+	if !changed || len(refreshed) == 0 {
+		return refreshed
+	}
+
+	names := make([]string, 0, len(refreshed))
+	for name := range refreshed {
+		names = append(names, name)
+	}
+
+	export, err := filterAliasExport(raw, names)
+	if err != nil {
+		diagnostics.AddError(fmt.Sprintf("Read %s error", aliasBundleResourceName), fmt.Sprintf("Failed to filter alias export: %s", err))
+		return nil
+	}
+	state.ExportJson = types.StringValue(export)
+
+	return refreshed
+}
+
+// getAliasBundleNames returns the sorted names of the aliases of the specified bundle, from either the `aliases` or the `export_json` attribute.
+func getAliasBundleNames(bundle aliasBundleResourceModel) ([]string, error) {
+	names := []string{}
+	if !bundle.ExportJson.IsNull() {
+		items, err := parseAliasExport(bundle.ExportJson.ValueString())
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse `export_json`: %s", err)
+		}
+
+		for name := range items {
+			names = append(names, name)
+		}
+	} else {
+		for name := range bundle.Aliases.Elements() {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	return names, nil
+}
@@ -0,0 +1,268 @@
+package alias_test
+
+import (
+	"regexp"
+	"testing"
+
+	"terraform-provider-opnsense/internal/acctest"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccAliasBundleResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccAliasBundleResourceConfig,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("opnsense_firewall_alias_bundle.test_acc_resource_bundle", tfjsonpath.New("id"), knownvalue.StringExact("test_acc_bundle")),
+					statecheck.ExpectKnownValue("opnsense_firewall_alias_bundle.test_acc_resource_bundle", tfjsonpath.New("content_hash"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue("opnsense_firewall_alias_bundle.test_acc_resource_bundle", tfjsonpath.New("aliases").AtMapKey("test_acc_bundle_hosts").AtMapKey("enabled"), knownvalue.Bool(true)),
+					statecheck.ExpectKnownValue("opnsense_firewall_alias_bundle.test_acc_resource_bundle", tfjsonpath.New("aliases").AtMapKey("test_acc_bundle_hosts").AtMapKey("content"), knownvalue.SetExact([]knownvalue.Check{
+						knownvalue.StringExact("10.0.0.10"),
+						knownvalue.StringExact("10.0.0.11"),
+					})),
+					statecheck.ExpectKnownValue("opnsense_firewall_alias_bundle.test_acc_resource_bundle", tfjsonpath.New("aliases").AtMapKey("test_acc_bundle_ports").AtMapKey("type"), knownvalue.StringExact("port")),
+					statecheck.ExpectKnownValue("opnsense_firewall_alias_bundle.test_acc_resource_bundle", tfjsonpath.New("aliases").AtMapKey("test_acc_bundle_geoip").AtMapKey("proto"), knownvalue.SetExact([]knownvalue.Check{
+						knownvalue.StringExact("ipv4"),
+						knownvalue.StringExact("ipv6"),
+					})),
+				},
+			},
+			// ImportState testing
+			{
+				ResourceName:      "opnsense_firewall_alias_bundle.test_acc_resource_bundle",
+				ImportState:       true,
+				ImportStateId:     "test_acc_bundle:test_acc_bundle_hosts,test_acc_bundle_ports,test_acc_bundle_geoip",
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccAliasBundleResourceConfig_update,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("opnsense_firewall_alias_bundle.test_acc_resource_bundle", tfjsonpath.New("aliases").AtMapKey("test_acc_bundle_hosts").AtMapKey("content"), knownvalue.SetExact([]knownvalue.Check{
+						knownvalue.StringExact("10.0.0.12"),
+					})),
+					statecheck.ExpectKnownValue("opnsense_firewall_alias_bundle.test_acc_resource_bundle", tfjsonpath.New("aliases").AtMapKey("test_acc_bundle_networks").AtMapKey("content"), knownvalue.SetExact([]knownvalue.Check{
+						knownvalue.StringExact("10.0.0.0/24"),
+					})),
+					statecheck.ExpectKnownValue("opnsense_firewall_alias_bundle.test_acc_resource_bundle", tfjsonpath.New("aliases"), knownvalue.MapSizeExact(3)),
+				},
+			},
+		},
+	})
+}
+
+func TestAccAliasBundleResource_exportJson(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccAliasBundleResourceConfig_exportJson,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("opnsense_firewall_alias_bundle.test_acc_resource_bundle_export", tfjsonpath.New("id"), knownvalue.StringExact("test_acc_bundle_export")),
+					statecheck.ExpectKnownValue("opnsense_firewall_alias_bundle.test_acc_resource_bundle_export", tfjsonpath.New("content_hash"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue("opnsense_firewall_alias_bundle.test_acc_resource_bundle_export", tfjsonpath.New("aliases"), knownvalue.Null()),
+				},
+			},
+			// Update and Read testing
+			{
+				Config: testAccAliasBundleResourceConfig_exportJsonUpdate,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("opnsense_firewall_alias_bundle.test_acc_resource_bundle_export", tfjsonpath.New("content_hash"), knownvalue.NotNull()),
+				},
+			},
+		},
+	})
+}
+
+func TestAccAliasBundleResource_invalidConfig(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAliasBundleResourceConfig_invalidContent,
+				ExpectError: regexp.MustCompile("Invalid Alias Content"),
+			},
+			{
+				Config:      testAccAliasBundleResourceConfig_invalidProto,
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+			{
+				Config:      testAccAliasBundleResourceConfig_invalidExportJson,
+				ExpectError: regexp.MustCompile("Invalid Alias Export"),
+			},
+		},
+	})
+}
+
+// testAccAliasBundleResourceConfig creates an alias bundle resource with aliases of different types.
+const testAccAliasBundleResourceConfig = `
+	resource "opnsense_firewall_alias_bundle" "test_acc_resource_bundle" {
+		name = "test_acc_bundle"
+
+		aliases = {
+			test_acc_bundle_hosts = {
+				type        = "host"
+				description = "bundle hosts for terraform resource testing"
+				content     = ["10.0.0.10", "10.0.0.11"]
+			}
+
+			test_acc_bundle_ports = {
+				type    = "port"
+				content = ["80", "443"]
+			}
+
+			test_acc_bundle_geoip = {
+				type    = "geoip"
+				proto   = ["ipv4", "ipv6"]
+				content = ["SG"]
+			}
+		}
+	}
+`
+
+// testAccAliasBundleResourceConfig_update changes, removes and adds aliases of the bundle.
+const testAccAliasBundleResourceConfig_update = `
+	resource "opnsense_firewall_alias_bundle" "test_acc_resource_bundle" {
+		name = "test_acc_bundle"
+
+		aliases = {
+			test_acc_bundle_hosts = {
+				type        = "host"
+				description = "bundle hosts for terraform resource testing"
+				content     = ["10.0.0.12"]
+			}
+
+			test_acc_bundle_ports = {
+				type    = "port"
+				content = ["80", "443"]
+			}
+
+			test_acc_bundle_networks = {
+				type    = "network"
+				content = ["10.0.0.0/24"]
+			}
+		}
+	}
+`
+
+// testAccAliasBundleResourceConfig_exportJson creates an alias bundle resource from an alias export document.
+const testAccAliasBundleResourceConfig_exportJson = `
+	resource "opnsense_firewall_alias_bundle" "test_acc_resource_bundle_export" {
+		name = "test_acc_bundle_export"
+
+		export_json = jsonencode({
+			aliases = {
+				alias = {
+					"3c6a4a5e-0d6f-4a43-9b3c-0a4b5a0f1c01" = {
+						enabled     = "1"
+						name        = "test_acc_bundle_export_hosts"
+						type        = "host"
+						proto       = ""
+						interface   = ""
+						counters    = "0"
+						updatefreq  = ""
+						content     = "10.0.1.10\n10.0.1.11"
+						categories  = ""
+						description = "bundle export hosts for terraform resource testing"
+					}
+					"3c6a4a5e-0d6f-4a43-9b3c-0a4b5a0f1c02" = {
+						enabled     = "1"
+						name        = "test_acc_bundle_export_ports"
+						type        = "port"
+						proto       = ""
+						interface   = ""
+						counters    = "0"
+						updatefreq  = ""
+						content     = "80\n443"
+						categories  = ""
+						description = ""
+					}
+				}
+			}
+		})
+	}
+`
+
+// testAccAliasBundleResourceConfig_exportJsonUpdate changes and removes aliases of the alias export document.
+const testAccAliasBundleResourceConfig_exportJsonUpdate = `
+	resource "opnsense_firewall_alias_bundle" "test_acc_resource_bundle_export" {
+		name = "test_acc_bundle_export"
+
+		export_json = jsonencode({
+			aliases = {
+				alias = {
+					"3c6a4a5e-0d6f-4a43-9b3c-0a4b5a0f1c01" = {
+						enabled     = "1"
+						name        = "test_acc_bundle_export_hosts"
+						type        = "host"
+						proto       = ""
+						interface   = ""
+						counters    = "0"
+						updatefreq  = ""
+						content     = "10.0.1.12"
+						categories  = ""
+						description = "bundle export hosts for terraform resource testing"
+					}
+				}
+			}
+		})
+	}
+`
+
+// testAccAliasBundleResourceConfig_invalidContent creates an alias bundle resource with content not matching the alias type.
+const testAccAliasBundleResourceConfig_invalidContent = `
+	resource "opnsense_firewall_alias_bundle" "test_acc_resource_bundle_invalid" {
+		name = "test_acc_bundle_invalid"
+
+		aliases = {
+			test_acc_bundle_invalid = {
+				type    = "port"
+				content = ["not_a_port"]
+			}
+		}
+	}
+`
+
+// testAccAliasBundleResourceConfig_invalidProto creates an alias bundle resource with proto on an alias type that does not support it.
+const testAccAliasBundleResourceConfig_invalidProto = `
+	resource "opnsense_firewall_alias_bundle" "test_acc_resource_bundle_invalid" {
+		name = "test_acc_bundle_invalid"
+
+		aliases = {
+			test_acc_bundle_invalid = {
+				type    = "host"
+				proto   = ["ipv4"]
+				content = ["10.0.0.10"]
+			}
+		}
+	}
+`
+
+// testAccAliasBundleResourceConfig_invalidExportJson creates an alias bundle resource from an alias export document with an alias without a name.
+const testAccAliasBundleResourceConfig_invalidExportJson = `
+	resource "opnsense_firewall_alias_bundle" "test_acc_resource_bundle_invalid" {
+		name = "test_acc_bundle_invalid"
+
+		export_json = jsonencode({
+			aliases = {
+				alias = {
+					"3c6a4a5e-0d6f-4a43-9b3c-0a4b5a0f1c03" = {
+						type    = "host"
+						content = "10.0.0.10"
+					}
+				}
+			}
+		})
+	}
+`
@@ -130,7 +130,7 @@ func (d *aliasDataSource) Schema(ctx context.Context, req datasource.SchemaReque
 			},
 			"interface": schema.StringAttribute{
 				Computed:    true,
-				Description: "[Only for `dynipv6` type] The interface for the v6 dynamic IP.",
+				Description: "[Only for `dynipv6host` type] The interface for the v6 dynamic IP.",
 			},
 		},
 	}
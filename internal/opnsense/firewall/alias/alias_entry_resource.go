@@ -0,0 +1,269 @@
+package alias
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"terraform-provider-opnsense/internal/opnsense"
+	"terraform-provider-opnsense/internal/opnsense/firewall"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                   = &aliasEntryResource{}
+	_ resource.ResourceWithConfigure      = &aliasEntryResource{}
+	_ resource.ResourceWithImportState    = &aliasEntryResource{}
+	_ resource.ResourceWithValidateConfig = &aliasEntryResource{}
+)
+
+// NewAliasEntryResource is a helper function to simplify the provider implementation.
+func NewAliasEntryResource() resource.Resource {
+	return &aliasEntryResource{}
+}
+
+// aliasEntryResource defines the resource implementation.
+type aliasEntryResource struct {
+	client *opnsense.Client
+}
+
+// aliasEntryResourceModel describes the resource data model.
+type aliasEntryResourceModel struct {
+	Id      types.String `tfsdk:"id"`
+	Alias   types.String `tfsdk:"alias"`
+	Address types.String `tfsdk:"address"`
+}
+
+// Metadata returns the resource type name.
+func (r *aliasEntryResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_%s_%s_entry", req.ProviderTypeName, firewall.TypeName, controller)
+}
+
+// Schema defines the schema for the resource.
+func (r *aliasEntryResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a single address in the pf table of an existing firewall alias, without changing the alias configuration or reloading the alias. " +
+			"This allows several configurations to each own entries in a shared alias, e.g. a blocklist fed by external tooling. " +
+			"Entries only live in the pf table, so they are lost when OPNsense reloads the alias (e.g. when its `content` changes, on URL table refresh or on reboot). Terraform detects a lost entry and adds it again on the next apply. Use an alias of type `external` to keep entries across reloads.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: fmt.Sprintf("Identifier of the %s, in the format `<alias>/<address>`.", aliasEntryResourceName),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"alias": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the alias to add the address to. The alias must already exist.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"address": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The IP address or network in CIDR notation (e.g `10.0.0.0/24`) to add to the alias.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig validates the resource configuration.
+func (r *aliasEntryResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config aliasEntryResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Skip validation if the address is not yet known
+	if config.Address.IsNull() || config.Address.IsUnknown() {
+		return
+	}
+
+	err := validateAliasEntryAddress(config.Address.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("address"), "Invalid Attribute Value", fmt.Sprintf("Attribute address %s, got: `%s`.", err, config.Address.ValueString()))
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *aliasEntryResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*opnsense.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *opnsense.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *aliasEntryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, fmt.Sprintf("Creating %s", aliasEntryResourceName))
+
+	// Read Terraform plan data into the model
+	var plan aliasEntryResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Check alias exists, adding to a missing table would silently create it
+	_, err := getAliasUuid(r.client, plan.Alias.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Create %s error", aliasEntryResourceName), fmt.Sprintf("%s", err))
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Add address to alias table on OPNsense
+	tflog.Debug(ctx, fmt.Sprintf("Adding %s on OPNsense", aliasEntryResourceName), map[string]any{"alias": plan.Alias.ValueString(), "address": plan.Address.ValueString()})
+
+	err = addAliasTableEntry(r.client, plan.Alias.ValueString(), plan.Address.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Create %s error", aliasEntryResourceName), fmt.Sprintf("%s", err))
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Successfully added %s on OPNsense", aliasEntryResourceName), map[string]any{"success": true})
+
+	plan.Id = types.StringValue(fmt.Sprintf("%s/%s", plan.Alias.ValueString(), plan.Address.ValueString()))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Successfully created %s", aliasEntryResourceName))
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *aliasEntryResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, fmt.Sprintf("Reading %s", aliasEntryResourceName))
+
+	// Read Terraform prior state data into the model
+	var state aliasEntryResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get alias table
+	tflog.Debug(ctx, fmt.Sprintf("Getting %s information", aliasTableResourceName), map[string]any{"alias": state.Alias.ValueString()})
+
+	entries, err := listAliasTable(r.client, state.Alias.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Read %s error", aliasEntryResourceName), fmt.Sprintf("%s", err))
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Successfully got %s information", aliasTableResourceName), map[string]any{"success": true})
+
+	// Remove entry from state if it is no longer in the alias table (e.g. after the alias was reloaded)
+	if !aliasTableContains(entries, state.Address.ValueString()) {
+		tflog.Warn(ctx, fmt.Sprintf("%s not found in alias table, removing from state", aliasEntryResourceName), map[string]any{"alias": state.Alias.ValueString(), "address": state.Address.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Successfully read %s", aliasEntryResourceName))
+}
+
+// Update updates the resource on OPNsense and the Terraform state. All attributes require replacement, so only the state is updated.
+func (r *aliasEntryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, fmt.Sprintf("Updating %s", aliasEntryResourceName))
+
+	// Read Terraform plan data into the model
+	var plan aliasEntryResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Successfully updated %s", aliasEntryResourceName))
+}
+
+// Delete removes the resource on OPNsense and from the Terraform state.
+func (r *aliasEntryResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, fmt.Sprintf("Deleting %s", aliasEntryResourceName))
+
+	// Read Terraform prior state data into the model
+	var state aliasEntryResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete address from alias table on OPNsense
+	tflog.Debug(ctx, fmt.Sprintf("Deleting %s on OPNsense", aliasEntryResourceName), map[string]any{"alias": state.Alias.ValueString(), "address": state.Address.ValueString()})
+
+	err := deleteAliasTableEntry(r.client, state.Alias.ValueString(), state.Address.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Delete %s error", aliasEntryResourceName), fmt.Sprintf("%s", err))
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Successfully deleted %s", aliasEntryResourceName))
+}
+
+// ImportState imports the resource from OPNsense and enables Terraform to begin managing the resource.
+func (r *aliasEntryResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Info(ctx, fmt.Sprintf("Importing %s", aliasEntryResourceName))
+
+	// Alias names cannot contain slashes, so the first slash separates the alias from the address
+	alias, address, found := strings.Cut(req.ID, "/")
+	if !found || alias == "" || address == "" {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Import %s error", aliasEntryResourceName),
+			fmt.Sprintf("Expected import identifier in the format `<alias>/<address>`, got: `%s`", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("alias"), alias)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("address"), address)...)
+}
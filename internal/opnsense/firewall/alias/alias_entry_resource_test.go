@@ -0,0 +1,111 @@
+package alias_test
+
+import (
+	"regexp"
+	"testing"
+
+	"terraform-provider-opnsense/internal/acctest"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccAliasEntryResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccAliasEntryResourceConfig,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("opnsense_firewall_alias_entry.test_acc_resource_entry_host", tfjsonpath.New("id"), knownvalue.StringExact("test_acc_alias_entry/203.0.113.10")),
+					statecheck.ExpectKnownValue("opnsense_firewall_alias_entry.test_acc_resource_entry_host", tfjsonpath.New("alias"), knownvalue.StringExact("test_acc_alias_entry")),
+					statecheck.ExpectKnownValue("opnsense_firewall_alias_entry.test_acc_resource_entry_host", tfjsonpath.New("address"), knownvalue.StringExact("203.0.113.10")),
+					statecheck.ExpectKnownValue("opnsense_firewall_alias_entry.test_acc_resource_entry_network", tfjsonpath.New("id"), knownvalue.StringExact("test_acc_alias_entry/198.51.100.0/24")),
+					statecheck.ExpectKnownValue("opnsense_firewall_alias_entry.test_acc_resource_entry_network", tfjsonpath.New("address"), knownvalue.StringExact("198.51.100.0/24")),
+					statecheck.ExpectKnownValue("data.opnsense_firewall_alias_table.test_acc_resource_entry", tfjsonpath.New("entry_count"), knownvalue.Int64Exact(2)),
+				},
+			},
+			// ImportState testing
+			{
+				ResourceName:      "opnsense_firewall_alias_entry.test_acc_resource_entry_network",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccAliasEntryResourceConfig_update,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("opnsense_firewall_alias_entry.test_acc_resource_entry_host", tfjsonpath.New("id"), knownvalue.StringExact("test_acc_alias_entry/203.0.113.20")),
+					statecheck.ExpectKnownValue("opnsense_firewall_alias_entry.test_acc_resource_entry_host", tfjsonpath.New("address"), knownvalue.StringExact("203.0.113.20")),
+				},
+			},
+		},
+	})
+}
+
+func TestAccAliasEntryResource_invalidConfig(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAliasEntryResourceConfig_invalidAddress,
+				ExpectError: regexp.MustCompile("must be an IP address or network in CIDR notation"),
+			},
+		},
+	})
+}
+
+// testAccAliasEntryResourceConfig defines an external alias with two alias entry resources.
+const testAccAliasEntryResourceConfig = `
+	resource "opnsense_firewall_alias" "test_acc_resource_entry" {
+		name = "test_acc_alias_entry"
+		type = "external"
+		description = "alias for terraform alias entry testing"
+	}
+
+	resource "opnsense_firewall_alias_entry" "test_acc_resource_entry_host" {
+		alias = opnsense_firewall_alias.test_acc_resource_entry.name
+		address = "203.0.113.10"
+	}
+
+	resource "opnsense_firewall_alias_entry" "test_acc_resource_entry_network" {
+		alias = opnsense_firewall_alias.test_acc_resource_entry.name
+		address = "198.51.100.0/24"
+	}
+
+	data "opnsense_firewall_alias_table" "test_acc_resource_entry" {
+		name = opnsense_firewall_alias.test_acc_resource_entry.name
+
+		depends_on = [
+			opnsense_firewall_alias_entry.test_acc_resource_entry_host,
+			opnsense_firewall_alias_entry.test_acc_resource_entry_network,
+		]
+	}
+`
+
+// testAccAliasEntryResourceConfig_update replaces the address of an alias entry resource and removes the other.
+const testAccAliasEntryResourceConfig_update = `
+	resource "opnsense_firewall_alias" "test_acc_resource_entry" {
+		name = "test_acc_alias_entry"
+		type = "external"
+		description = "alias for terraform alias entry testing"
+	}
+
+	resource "opnsense_firewall_alias_entry" "test_acc_resource_entry_host" {
+		alias = opnsense_firewall_alias.test_acc_resource_entry.name
+		address = "203.0.113.20"
+	}
+`
+
+// testAccAliasEntryResourceConfig_invalidAddress defines an alias entry resource with an invalid address.
+const testAccAliasEntryResourceConfig_invalidAddress = `
+	resource "opnsense_firewall_alias_entry" "test_acc_resource_entry" {
+		alias = "test_acc_alias_entry"
+		address = "not-an-address"
+	}
+`
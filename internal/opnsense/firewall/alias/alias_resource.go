@@ -30,9 +30,11 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &aliasResource{}
-	_ resource.ResourceWithConfigure   = &aliasResource{}
-	_ resource.ResourceWithImportState = &aliasResource{}
+	_ resource.Resource                   = &aliasResource{}
+	_ resource.ResourceWithConfigure      = &aliasResource{}
+	_ resource.ResourceWithImportState    = &aliasResource{}
+	_ resource.ResourceWithUpgradeState   = &aliasResource{}
+	_ resource.ResourceWithValidateConfig = &aliasResource{}
 )
 
 // NewAliasResource is a helper function to simplify the provider implementation.
@@ -49,6 +51,7 @@ type aliasResource struct {
 type aliasResourceModel struct {
 	Id          types.String `tfsdk:"id"`
 	LastUpdated types.String `tfsdk:"last_updated"`
+	ContentHash types.String `tfsdk:"content_hash"`
 	Enabled     types.Bool   `tfsdk:"enabled"`
 	Name        types.String `tfsdk:"name"`
 	Type        types.String `tfsdk:"type"`
@@ -59,6 +62,12 @@ type aliasResourceModel struct {
 	Categories  types.Set    `tfsdk:"categories"`
 	Content     types.Set    `tfsdk:"content"`
 	Interface   types.String `tfsdk:"interface"`
+
+	RefreshTriggers types.Map    `tfsdk:"refresh_triggers"`
+	EntryCount      types.Int64  `tfsdk:"entry_count"`
+	LastRefreshed   types.String `tfsdk:"last_refreshed"`
+
+	ReferencedAliases types.Set `tfsdk:"referenced_aliases"`
 }
 
 type updateFreqModel struct {
@@ -81,6 +90,7 @@ func (r *aliasResource) Schema(ctx context.Context, req resource.SchemaRequest,
 	emptySet, _ := basetypes.NewSetValue(types.StringType, []attr.Value{})
 
 	resp.Schema = schema.Schema{
+		Version:             1,
 		MarkdownDescription: "Aliases are named lists of networks, hosts or ports that can be used as one entity by referencing the alias name in the various supported sections of the firewall. These aliases are particularly useful to condense firewall rules and minimize changes.",
 
 		Attributes: map[string]schema.Attribute{
@@ -92,8 +102,13 @@ func (r *aliasResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				},
 			},
 			"last_updated": schema.StringAttribute{
+				Computed:           true,
+				Description:        fmt.Sprintf("DateTime when the %s was last updated.", aliasResourceName),
+				DeprecationMessage: "Use `content_hash` to detect changes to this resource instead. This attribute will be removed in a future release.",
+			},
+			"content_hash": schema.StringAttribute{
 				Computed:    true,
-				Description: fmt.Sprintf("DateTime when the %s was last updated.", aliasResourceName),
+				Description: fmt.Sprintf("Hash of the %s configuration on OPNsense. Changes whenever the configuration is modified, including changes made outside of Terraform.", aliasResourceName),
 			},
 			"enabled": schema.BoolAttribute{
 				Optional:            true,
@@ -195,22 +210,102 @@ func (r *aliasResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				Default:     setdefault.StaticValue(emptySet),
 			},
 			"content": schema.SetAttribute{
-				Optional:    true,
-				Computed:    true,
-				ElementType: types.StringType,
-				Description: "The content of the alias.",
-				Default:     setdefault.StaticValue(emptySet),
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The content of the alias. Each element is validated based on the alias type: `host` accepts IP addresses, IP ranges, FQDNs or alias names, `network` accepts networks in CIDR notation or alias names, `port` accepts port numbers, port ranges (e.g `80:443`) or alias names, `mac` accepts full or partial MAC addresses, `asn` accepts AS numbers, `geoip` accepts ISO 3166-1 alpha-2 country codes and `url`/`urltable` accept URLs.",
+				Default:             setdefault.StaticValue(emptySet),
 			},
 			"interface": schema.StringAttribute{
 				Optional:    true,
 				Computed:    true,
-				Description: "[Only for `dynipv6` type] The interface for the v6 dynamic IP.",
+				Description: "[Only for `dynipv6host` type] The interface for the v6 dynamic IP.",
 				Default:     stringdefault.StaticString(""),
 			},
+			"refresh_triggers": schema.MapAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "[Only for `url` & `urltable` types] Arbitrary map of values that, when changed, forces OPNsense to download the content of the alias and reload it right away, regardless of `updatefreq`. For example, set it to a hash or version of the remote list.",
+			},
+			"referenced_aliases": schema.SetAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "[Only for `host`, `network`, `networkgroup` & `port` types] The names of the aliases referenced in `content`. Referenced aliases must exist, have a compatible type and must not reference this alias in return.",
+			},
+			"entry_count": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The number of entries currently loaded in the alias table.",
+			},
+			"last_refreshed": schema.StringAttribute{
+				Computed:    true,
+				Description: "DateTime when OPNsense last loaded the content of the alias, e.g. the last download of a URL table. Empty if not reported by OPNsense.",
+			},
 		},
 	}
 }
 
+// ValidateConfig validates the resource configuration for cross-attribute constraints.
+func (r *aliasResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config aliasResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Skip validation if the type is not yet known
+	if config.Type.IsNull() || config.Type.IsUnknown() {
+		return
+	}
+
+	aliasType := config.Type.ValueString()
+
+	// Interface is only applicable to (and required for) dynipv6host aliases
+	if aliasType == "dynipv6host" {
+		if config.Interface.IsNull() || (!config.Interface.IsUnknown() && config.Interface.ValueString() == "") {
+			resp.Diagnostics.AddAttributeError(path.Root("interface"), "Missing Required Attribute", "The `interface` attribute must be set when `type` is set to `dynipv6host`.")
+		}
+	} else if !config.Interface.IsNull() && !config.Interface.IsUnknown() && config.Interface.ValueString() != "" {
+		resp.Diagnostics.AddAttributeError(path.Root("interface"), "Invalid Attribute Combination", fmt.Sprintf("The `interface` attribute is only applicable when `type` is set to `dynipv6host`, got: `%s`.", aliasType))
+	}
+
+	// Protocols are only applicable to asn & geoip aliases
+	if !config.Proto.IsNull() && aliasType != "asn" && aliasType != "geoip" {
+		resp.Diagnostics.AddAttributeError(path.Root("proto"), "Invalid Attribute Combination", fmt.Sprintf("The `proto` attribute is only applicable when `type` is set to `asn` or `geoip`, got: `%s`.", aliasType))
+	}
+
+	// Refresh triggers are only applicable to url & urltable aliases
+	if !config.RefreshTriggers.IsNull() && aliasType != "url" && aliasType != "urltable" {
+		resp.Diagnostics.AddAttributeError(path.Root("refresh_triggers"), "Invalid Attribute Combination", fmt.Sprintf("The `refresh_triggers` attribute is only applicable when `type` is set to `url` or `urltable`, got: `%s`.", aliasType))
+	}
+
+	// Update frequency is only applicable to urltable aliases
+	if !config.UpdateFreq.IsNull() && aliasType != "urltable" {
+		resp.Diagnostics.AddAttributeError(path.Root("updatefreq"), "Invalid Attribute Combination", fmt.Sprintf("The `updatefreq` attribute is only applicable when `type` is set to `urltable`, got: `%s`.", aliasType))
+	}
+
+	// Validate each content element based on the alias type
+	validateContent, exists := getAliasContentValidators()[aliasType]
+	if !exists || config.Content.IsNull() || config.Content.IsUnknown() {
+		return
+	}
+
+	for _, element := range config.Content.Elements() {
+		content, ok := element.(types.String)
+		if !ok || content.IsNull() || content.IsUnknown() {
+			continue
+		}
+
+		err := validateContent(content.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("content").AtSetValue(content),
+				"Invalid Alias Content",
+				fmt.Sprintf("Content `%s` is not valid for alias type `%s`: %s.", content.ValueString(), aliasType, err),
+			)
+		}
+	}
+}
+
 // Configure adds the provider configured client to the resource.
 func (r *aliasResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Add a nil check when handling ProviderData because Terraform
@@ -271,6 +366,21 @@ func (r *aliasResource) Create(ctx context.Context, req resource.CreateRequest,
 		tflog.Debug(ctx, "Successfully applied configuration on OPNsense", map[string]any{"success": true})
 	}
 
+	// Get content hash from OPNsense
+	contentHash, err := getAliasContentHash(r.client, uuid)
+	plan.ContentHash, diags = utils.ContentHashValue(fmt.Sprintf("Create %s error", aliasResourceName), contentHash, err)
+	resp.Diagnostics.Append(diags...)
+
+	// Get alias statistics from OPNsense
+	plan.EntryCount, plan.LastRefreshed, err = getAliasStatisticsValues(r.client, plan.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddWarning(fmt.Sprintf("Create %s error", aliasResourceName), fmt.Sprintf("Unable to get alias statistics: %s", err))
+	}
+
+	referencedAliases, diags := utils.SetGoToTerraform(ctx, alias.ReferencedAliases)
+	resp.Diagnostics.Append(diags...)
+	plan.ReferencedAliases = referencedAliases
+
 	// Update plan ID & last_updated fields
 	plan.Id = types.StringValue(uuid)
 	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
@@ -311,6 +421,11 @@ func (r *aliasResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	tflog.Debug(ctx, fmt.Sprintf("Successfully got %s information", aliasResourceName), map[string]any{"success": true})
 
 	// Overwite items with refreshed state
+	contentHash, err := utils.ContentHash(alias)
+	contentHashValue, diags := utils.ContentHashValue(fmt.Sprintf("Read %s error", aliasResourceName), contentHash, err)
+	resp.Diagnostics.Append(diags...)
+	state.ContentHash = contentHashValue
+
 	state.Enabled = types.BoolValue(alias.Enabled)
 	state.Name = types.StringValue(alias.Name)
 	state.Type = types.StringValue(alias.Type)
@@ -345,12 +460,30 @@ func (r *aliasResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	resp.Diagnostics.Append(diags...)
 	state.Categories = categories
 
-	content, diags := utils.SetGoToTerraform(ctx, alias.Content)
+	priorContent, diags := utils.SetTerraformToGo(ctx, state.Content)
+	resp.Diagnostics.Append(diags...)
+
+	content, diags := utils.SetGoToTerraform(ctx, preserveNetworkContent(ctx, alias.Type, priorContent, alias.Content))
 	resp.Diagnostics.Append(diags...)
 	state.Content = content
 
 	state.Interface = types.StringValue(alias.Interface)
 
+	// Resolve the referenced aliases against all alias names, to avoid a lookup per content element
+	aliasNames, err := getAliasNames(r.client)
+	if err != nil {
+		resp.Diagnostics.AddWarning(fmt.Sprintf("Read %s error", aliasResourceName), fmt.Sprintf("Unable to resolve referenced aliases: %s", err))
+		state.ReferencedAliases = types.SetNull(types.StringType)
+	} else {
+		state.ReferencedAliases, diags = utils.SetGoToTerraform(ctx, getReferencedAliases(aliasNames, alias.Type, alias.Content.Elements()))
+		resp.Diagnostics.Append(diags...)
+	}
+
+	state.EntryCount, state.LastRefreshed, err = getAliasStatisticsValues(r.client, alias.Name)
+	if err != nil {
+		resp.Diagnostics.AddWarning(fmt.Sprintf("Read %s error", aliasResourceName), fmt.Sprintf("Unable to get alias statistics: %s", err))
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -410,6 +543,33 @@ func (r *aliasResource) Update(ctx context.Context, req resource.UpdateRequest,
 		tflog.Debug(ctx, "Successfully applied configuration on OPNsense", map[string]any{"success": true})
 	}
 
+	// Refresh alias table on OPNsense (if refresh triggers changed)
+	if !plan.RefreshTriggers.Equal(state.RefreshTriggers) && (alias.Type == "url" || alias.Type == "urltable") {
+		tflog.Debug(ctx, fmt.Sprintf("Refreshing %s on OPNsense", aliasResourceName), map[string]any{"name": alias.Name})
+
+		err = refreshAliasTable(r.client, alias.Name)
+		if err != nil {
+			resp.Diagnostics.AddWarning(fmt.Sprintf("Update %s error", aliasResourceName), fmt.Sprintf("%s", err))
+		} else {
+			tflog.Debug(ctx, fmt.Sprintf("Successfully refreshed %s on OPNsense", aliasResourceName), map[string]any{"success": true})
+		}
+	}
+
+	// Get alias statistics from OPNsense
+	plan.EntryCount, plan.LastRefreshed, err = getAliasStatisticsValues(r.client, alias.Name)
+	if err != nil {
+		resp.Diagnostics.AddWarning(fmt.Sprintf("Update %s error", aliasResourceName), fmt.Sprintf("Unable to get alias statistics: %s", err))
+	}
+
+	// Get content hash from OPNsense
+	contentHash, err := getAliasContentHash(r.client, state.Id.ValueString())
+	plan.ContentHash, diags = utils.ContentHashValue(fmt.Sprintf("Update %s error", aliasResourceName), contentHash, err)
+	resp.Diagnostics.Append(diags...)
+
+	referencedAliases, diags := utils.SetGoToTerraform(ctx, alias.ReferencedAliases)
+	resp.Diagnostics.Append(diags...)
+	plan.ReferencedAliases = referencedAliases
+
 	// Update last_updated field (if change detected)
 	if !(reflect.DeepEqual(plan, state)) {
 		plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
@@ -1,6 +1,7 @@
 package alias_test
 
 import (
+	"regexp"
 	"terraform-provider-opnsense/internal/acctest"
 	"testing"
 
@@ -194,6 +195,150 @@ func TestAccAliasResource_defaults(t *testing.T) {
 	})
 }
 
+func TestAccAliasResource_urltableRefresh(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccAliasResourceConfig_urltable,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("opnsense_firewall_alias.test_acc_resource_urltable", tfjsonpath.New("type"), knownvalue.StringExact("urltable")),
+					statecheck.ExpectKnownValue("opnsense_firewall_alias.test_acc_resource_urltable", tfjsonpath.New("refresh_triggers"), knownvalue.MapExact(map[string]knownvalue.Check{
+						"version": knownvalue.StringExact("1"),
+					})),
+					statecheck.ExpectKnownValue("opnsense_firewall_alias.test_acc_resource_urltable", tfjsonpath.New("entry_count"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue("opnsense_firewall_alias.test_acc_resource_urltable", tfjsonpath.New("last_refreshed"), knownvalue.NotNull()),
+				},
+			},
+			// ImportState testing
+			{
+				ResourceName:            "opnsense_firewall_alias.test_acc_resource_urltable",
+				ImportState:             true,
+				ImportStateId:           "test_acc_alias_urltable_resource",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated", "refresh_triggers"},
+			},
+			// Update testing (refresh only)
+			{
+				Config: testAccAliasResourceConfig_urltable_refreshed,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("opnsense_firewall_alias.test_acc_resource_urltable", tfjsonpath.New("refresh_triggers"), knownvalue.MapExact(map[string]knownvalue.Check{
+						"version": knownvalue.StringExact("2"),
+					})),
+					statecheck.ExpectKnownValue("opnsense_firewall_alias.test_acc_resource_urltable", tfjsonpath.New("entry_count"), knownvalue.NotNull()),
+				},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccAliasResource_references(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccAliasResourceConfig_references,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("opnsense_firewall_alias.test_acc_resource_references_group", tfjsonpath.New("referenced_aliases"), knownvalue.SetExact([]knownvalue.Check{
+						knownvalue.StringExact("test_acc_alias_references_host"),
+						knownvalue.StringExact("test_acc_alias_references_network"),
+					})),
+					statecheck.ExpectKnownValue("opnsense_firewall_alias.test_acc_resource_references_host", tfjsonpath.New("referenced_aliases"), knownvalue.SetExact([]knownvalue.Check{})),
+				},
+			},
+			// ImportState testing
+			{
+				ResourceName:            "opnsense_firewall_alias.test_acc_resource_references_group",
+				ImportState:             true,
+				ImportStateId:           "test_acc_alias_references_group",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+			// Cyclic reference testing
+			{
+				Config:      testAccAliasResourceConfig_references_cycle,
+				ExpectError: regexp.MustCompile(`Alias references form a cycle`),
+			},
+		},
+	})
+}
+
+func TestAccAliasResource_invalidReferences(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAliasResourceConfig_references_missing,
+				ExpectError: regexp.MustCompile(`Referenced alias .* does not exist`),
+			},
+			{
+				Config:      testAccAliasResourceConfig_references_type,
+				ExpectError: regexp.MustCompile(`cannot be used in an alias of type`),
+			},
+		},
+	})
+}
+
+func TestAccAliasResource_invalidConfig(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAliasResourceConfig_dynipv6_missing_interface,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Missing Required Attribute`),
+			},
+			{
+				Config:      testAccAliasResourceConfig_host_proto,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+			{
+				Config:      testAccAliasResourceConfig_host_updatefreq,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+			{
+				Config:      testAccAliasResourceConfig_host_refresh_triggers,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+			{
+				Config:      testAccAliasResourceConfig_network_content,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Invalid Alias Content`),
+			},
+			{
+				Config:      testAccAliasResourceConfig_host_content,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Invalid Alias Content`),
+			},
+			{
+				Config:      testAccAliasResourceConfig_port_content,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Invalid Alias Content`),
+			},
+			{
+				Config:      testAccAliasResourceConfig_geoip_content,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Invalid Alias Content`),
+			},
+			{
+				Config:      testAccAliasResourceConfig_urltable_content,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Invalid Alias Content`),
+			},
+		},
+	})
+}
+
 // testAccAliasResourceConfig_host defines an alias resource of type `host`.
 const testAccAliasResourceConfig_host = `
 	resource "opnsense_firewall_alias" "test_acc_resource_host" {
@@ -268,6 +413,131 @@ const testAccAliasResourceConfig_asn_modified = `
 	}
 `
 
+// testAccAliasResourceConfig_urltable defines an alias resource of type `urltable` with refresh triggers.
+const testAccAliasResourceConfig_urltable = `
+	resource "opnsense_firewall_alias" "test_acc_resource_urltable" {
+		name = "test_acc_alias_urltable_resource"
+		type = "urltable"
+		description = "urltable alias for terraform resource testing"
+		updatefreq = {
+			days = 1
+			hours = 0
+		}
+		content = [
+			"https://www.spamhaus.org/drop/drop.txt"
+		]
+		refresh_triggers = {
+			version = "1"
+		}
+	}
+`
+
+// testAccAliasResourceConfig_urltable_refreshed defines an alias resource of type `urltable` with changed refresh triggers.
+const testAccAliasResourceConfig_urltable_refreshed = `
+	resource "opnsense_firewall_alias" "test_acc_resource_urltable" {
+		name = "test_acc_alias_urltable_resource"
+		type = "urltable"
+		description = "urltable alias for terraform resource testing"
+		updatefreq = {
+			days = 1
+			hours = 0
+		}
+		content = [
+			"https://www.spamhaus.org/drop/drop.txt"
+		]
+		refresh_triggers = {
+			version = "2"
+		}
+	}
+`
+
+// testAccAliasResourceConfig_references defines a `networkgroup` alias resource referencing a `host` and a `network` alias resource.
+const testAccAliasResourceConfig_references = `
+	resource "opnsense_firewall_alias" "test_acc_resource_references_host" {
+		name = "test_acc_alias_references_host"
+		type = "host"
+		content = [
+			"10.0.0.1"
+		]
+	}
+
+	resource "opnsense_firewall_alias" "test_acc_resource_references_network" {
+		name = "test_acc_alias_references_network"
+		type = "network"
+		content = [
+			"10.1.0.0/24"
+		]
+	}
+
+	resource "opnsense_firewall_alias" "test_acc_resource_references_group" {
+		name = "test_acc_alias_references_group"
+		type = "networkgroup"
+		content = [
+			opnsense_firewall_alias.test_acc_resource_references_host.name,
+			opnsense_firewall_alias.test_acc_resource_references_network.name
+		]
+	}
+`
+
+// testAccAliasResourceConfig_references_cycle changes the referenced `network` alias resource to reference the `networkgroup` alias.
+const testAccAliasResourceConfig_references_cycle = `
+	resource "opnsense_firewall_alias" "test_acc_resource_references_host" {
+		name = "test_acc_alias_references_host"
+		type = "host"
+		content = [
+			"10.0.0.1"
+		]
+	}
+
+	resource "opnsense_firewall_alias" "test_acc_resource_references_network" {
+		name = "test_acc_alias_references_network"
+		type = "network"
+		content = [
+			"10.1.0.0/24",
+			"test_acc_alias_references_group"
+		]
+	}
+
+	resource "opnsense_firewall_alias" "test_acc_resource_references_group" {
+		name = "test_acc_alias_references_group"
+		type = "networkgroup"
+		content = [
+			"test_acc_alias_references_host",
+			"test_acc_alias_references_network"
+		]
+	}
+`
+
+// testAccAliasResourceConfig_references_missing defines a `network` alias resource referencing an alias that does not exist.
+const testAccAliasResourceConfig_references_missing = `
+	resource "opnsense_firewall_alias" "test_acc_resource_references_missing" {
+		name = "test_acc_alias_references_missing"
+		type = "network"
+		content = [
+			"test_acc_alias_does_not_exist"
+		]
+	}
+`
+
+// testAccAliasResourceConfig_references_type defines a `port` alias resource referencing a `host` alias.
+const testAccAliasResourceConfig_references_type = `
+	resource "opnsense_firewall_alias" "test_acc_resource_references_host" {
+		name = "test_acc_alias_references_type_host"
+		type = "host"
+		content = [
+			"10.0.0.1"
+		]
+	}
+
+	resource "opnsense_firewall_alias" "test_acc_resource_references_port" {
+		name = "test_acc_alias_references_type_port"
+		type = "port"
+		content = [
+			opnsense_firewall_alias.test_acc_resource_references_host.name
+		]
+	}
+`
+
 // testAccAliasResourceConfig_default defines an alias resource of type `host` with default values.
 const testAccAliasResourceConfig_default = `
 	resource "opnsense_firewall_alias" "test_acc_resource_default" {
@@ -275,3 +545,103 @@ const testAccAliasResourceConfig_default = `
 		type = "host"
 	}
 `
+
+// testAccAliasResourceConfig_dynipv6_missing_interface defines an alias resource of type `dynipv6host` without an interface.
+const testAccAliasResourceConfig_dynipv6_missing_interface = `
+	resource "opnsense_firewall_alias" "test_acc_resource_invalid" {
+		name = "test_acc_alias_invalid_resource"
+		type = "dynipv6host"
+	}
+`
+
+// testAccAliasResourceConfig_host_proto defines an alias resource of type `host` with protocols.
+const testAccAliasResourceConfig_host_proto = `
+	resource "opnsense_firewall_alias" "test_acc_resource_invalid" {
+		name = "test_acc_alias_invalid_resource"
+		type = "host"
+		proto = {
+			ipv4 = true
+		}
+	}
+`
+
+// testAccAliasResourceConfig_host_updatefreq defines an alias resource of type `host` with an update frequency.
+const testAccAliasResourceConfig_host_updatefreq = `
+	resource "opnsense_firewall_alias" "test_acc_resource_invalid" {
+		name = "test_acc_alias_invalid_resource"
+		type = "host"
+		updatefreq = {
+			days = 1
+			hours = 0
+		}
+	}
+`
+
+// testAccAliasResourceConfig_host_refresh_triggers defines an alias resource of type `host` with refresh triggers.
+const testAccAliasResourceConfig_host_refresh_triggers = `
+	resource "opnsense_firewall_alias" "test_acc_resource_host" {
+		name = "test_acc_alias_host_resource"
+		type = "host"
+		content = [
+			"1.1.1.1"
+		]
+		refresh_triggers = {
+			version = "1"
+		}
+	}
+`
+
+// testAccAliasResourceConfig_network_content defines an alias resource of type `network` with an invalid network.
+const testAccAliasResourceConfig_network_content = `
+	resource "opnsense_firewall_alias" "test_acc_resource_invalid" {
+		name = "test_acc_alias_invalid_resource"
+		type = "network"
+		content = [
+			"10.0.0.0/33"
+		]
+	}
+`
+
+// testAccAliasResourceConfig_host_content defines an alias resource of type `host` with a mistyped IP address.
+const testAccAliasResourceConfig_host_content = `
+	resource "opnsense_firewall_alias" "test_acc_resource_invalid" {
+		name = "test_acc_alias_invalid_resource"
+		type = "host"
+		content = [
+			"10.0.0.256"
+		]
+	}
+`
+
+// testAccAliasResourceConfig_port_content defines an alias resource of type `port` with an out of range port.
+const testAccAliasResourceConfig_port_content = `
+	resource "opnsense_firewall_alias" "test_acc_resource_invalid" {
+		name = "test_acc_alias_invalid_resource"
+		type = "port"
+		content = [
+			"70000"
+		]
+	}
+`
+
+// testAccAliasResourceConfig_geoip_content defines an alias resource of type `geoip` with an invalid country code.
+const testAccAliasResourceConfig_geoip_content = `
+	resource "opnsense_firewall_alias" "test_acc_resource_invalid" {
+		name = "test_acc_alias_invalid_resource"
+		type = "geoip"
+		content = [
+			"Singapore"
+		]
+	}
+`
+
+// testAccAliasResourceConfig_urltable_content defines an alias resource of type `urltable` with an invalid URL.
+const testAccAliasResourceConfig_urltable_content = `
+	resource "opnsense_firewall_alias" "test_acc_resource_invalid" {
+		name = "test_acc_alias_invalid_resource"
+		type = "urltable"
+		content = [
+			"not a url"
+		]
+	}
+`
@@ -0,0 +1,130 @@
+package alias
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-opnsense/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// aliasResourceModelV0 describes the resource data model of schema version 0.
+type aliasResourceModelV0 struct {
+	Id          *string `json:"id"`
+	LastUpdated *string `json:"last_updated"`
+	Enabled     *bool   `json:"enabled"`
+	Name        *string `json:"name"`
+	Type        *string `json:"type"`
+	Counters    *bool   `json:"counters"`
+	UpdateFreq  *struct {
+		Days  *int32   `json:"days"`
+		Hours *float64 `json:"hours"`
+	} `json:"updatefreq"`
+	Description *string `json:"description"`
+	Proto       *struct {
+		Ipv4 *bool `json:"ipv4"`
+		Ipv6 *bool `json:"ipv6"`
+	} `json:"proto"`
+	Categories []string `json:"categories"`
+	Content    []string `json:"content"`
+	Interface  *string  `json:"interface"`
+}
+
+// UpgradeState upgrades the resource state from prior schema versions to the current schema version.
+func (r *aliasResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {StateUpgrader: upgradeAliasStateV0},
+	}
+}
+
+// upgradeAliasStateV0 upgrades the resource state from schema version 0.
+func upgradeAliasStateV0(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	tflog.Info(ctx, fmt.Sprintf("Upgrading %s state from version 0", aliasResourceName))
+
+	var priorState aliasResourceModelV0
+	err := utils.DecodeRawState(req, &priorState)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Upgrade %s state error", aliasResourceName), fmt.Sprintf("%s", err))
+		return
+	}
+
+	// Normalise update frequency (in event of abnormal days/hours values e.g 0 days, 48 hours)
+	var updateFreqFloat float64
+	if priorState.UpdateFreq != nil {
+		if priorState.UpdateFreq.Days != nil {
+			updateFreqFloat += float64(*priorState.UpdateFreq.Days)
+		}
+		if priorState.UpdateFreq.Hours != nil {
+			updateFreqFloat += *priorState.UpdateFreq.Hours / float64(24)
+		}
+	}
+
+	updateFreq, diags := types.ObjectValue(
+		map[string]attr.Type{
+			"days":  types.Int32Type,
+			"hours": types.Float64Type,
+		},
+		freqFloatToObject(updateFreqFloat),
+	)
+	resp.Diagnostics.Append(diags...)
+
+	var ipv4, ipv6 *bool
+	if priorState.Proto != nil {
+		ipv4 = priorState.Proto.Ipv4
+		ipv6 = priorState.Proto.Ipv6
+	}
+
+	proto, diags := types.ObjectValue(
+		map[string]attr.Type{
+			"ipv4": types.BoolType,
+			"ipv6": types.BoolType,
+		},
+		map[string]attr.Value{
+			"ipv4": utils.BoolOrDefault(ipv4, false),
+			"ipv6": utils.BoolOrDefault(ipv6, false),
+		},
+	)
+	resp.Diagnostics.Append(diags...)
+
+	categories, diags := utils.StringSetOrDefault(ctx, priorState.Categories, []string{})
+	resp.Diagnostics.Append(diags...)
+
+	content, diags := utils.StringSetOrDefault(ctx, priorState.Content, []string{})
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state := aliasResourceModel{
+		Id:          utils.StringOrNull(priorState.Id),
+		LastUpdated: utils.StringOrNull(priorState.LastUpdated),
+		Enabled:     utils.BoolOrDefault(priorState.Enabled, true),
+		Name:        utils.StringOrNull(priorState.Name),
+		Type:        utils.StringOrNull(priorState.Type),
+		Counters:    utils.BoolOrDefault(priorState.Counters, false),
+		UpdateFreq:  updateFreq,
+		Description: utils.StringOrDefault(priorState.Description, ""),
+		Proto:       proto,
+		Categories:  categories,
+		Content:     content,
+		Interface:   utils.StringOrDefault(priorState.Interface, ""),
+
+		RefreshTriggers: types.MapNull(types.StringType),
+		EntryCount:      types.Int64Null(),
+		LastRefreshed:   types.StringNull(),
+
+		ReferencedAliases: types.SetNull(types.StringType),
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Successfully upgraded %s state from version 0", aliasResourceName))
+}
@@ -0,0 +1,54 @@
+package alias_test
+
+import (
+	"terraform-provider-opnsense/internal/acctest"
+	"terraform-provider-opnsense/internal/opnsense/firewall/alias"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestAliasResources_upgradeState(t *testing.T) {
+	acctest.RunStateUpgradeTests(t, []acctest.StateUpgradeTestCase{
+		{
+			Name:      "alias v0 defaults",
+			Resource:  alias.NewAliasResource(),
+			Version:   0,
+			StateFile: "testdata/alias_resource_v0.json",
+			Expected: map[string]attr.Value{
+				"enabled":          types.BoolValue(true),
+				"counters":         types.BoolValue(false),
+				"description":      types.StringValue(""),
+				"interface":        types.StringValue(""),
+				"proto.ipv4":       types.BoolValue(false),
+				"updatefreq.days":  types.Int32Value(0),
+				"updatefreq.hours": types.Float64Value(0),
+				"content":          types.SetValueMust(types.StringType, []attr.Value{}),
+				"categories":       types.SetValueMust(types.StringType, []attr.Value{}),
+				"refresh_triggers": types.MapNull(types.StringType),
+			},
+		},
+		{
+			Name:      "alias v0 update frequency in hours",
+			Resource:  alias.NewAliasResource(),
+			Version:   0,
+			StateFile: "testdata/alias_resource_v0_updatefreq.json",
+			Expected: map[string]attr.Value{
+				"updatefreq.days":  types.Int32Value(2),
+				"updatefreq.hours": types.Float64Value(0),
+			},
+		},
+		{
+			Name:      "geoip v0 defaults",
+			Resource:  alias.NewGeoIpResource(),
+			Version:   0,
+			StateFile: "testdata/geoip_resource_v0.json",
+			Expected: map[string]attr.Value{
+				"download_database":  types.BoolValue(false),
+				"maxmind_account_id": types.StringNull(),
+				"content_hash":       types.StringNull(),
+			},
+		},
+	})
+}
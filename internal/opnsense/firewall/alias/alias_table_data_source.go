@@ -0,0 +1,190 @@
+package alias
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-opnsense/internal/opnsense"
+	"terraform-provider-opnsense/internal/opnsense/firewall"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &aliasTableDataSource{}
+	_ datasource.DataSourceWithConfigure = &aliasTableDataSource{}
+)
+
+// NewAliasTableDataSource is a helper function to simplify the provider implementation.
+func NewAliasTableDataSource() datasource.DataSource {
+	return &aliasTableDataSource{}
+}
+
+// aliasTableDataSource defines the data source implementation.
+type aliasTableDataSource struct {
+	client *opnsense.Client
+}
+
+// aliasTableDataSourceModel describes the data source data model.
+type aliasTableDataSourceModel struct {
+	Name       types.String           `tfsdk:"name"`
+	EntryCount types.Int64            `tfsdk:"entry_count"`
+	Addresses  []types.String         `tfsdk:"addresses"`
+	Entries    []aliasTableEntryModel `tfsdk:"entries"`
+}
+
+// aliasTableEntryModel describes a single pf table entry in the data source data model.
+type aliasTableEntryModel struct {
+	Address         types.String `tfsdk:"address"`
+	InBlockPackets  types.Int64  `tfsdk:"in_block_packets"`
+	InBlockBytes    types.Int64  `tfsdk:"in_block_bytes"`
+	InPassPackets   types.Int64  `tfsdk:"in_pass_packets"`
+	InPassBytes     types.Int64  `tfsdk:"in_pass_bytes"`
+	OutBlockPackets types.Int64  `tfsdk:"out_block_packets"`
+	OutBlockBytes   types.Int64  `tfsdk:"out_block_bytes"`
+	OutPassPackets  types.Int64  `tfsdk:"out_pass_packets"`
+	OutPassBytes    types.Int64  `tfsdk:"out_pass_bytes"`
+}
+
+// Metadata returns the data source type name.
+func (d *aliasTableDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_%s_%s_table", req.ProviderTypeName, firewall.TypeName, controller)
+}
+
+// Schema defines the schema for the datasource.
+func (d *aliasTableDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	counterAttribute := func(description string) schema.Int64Attribute {
+		return schema.Int64Attribute{
+			Computed:            true,
+			MarkdownDescription: fmt.Sprintf("%s Only set when `counters` is enabled on the alias.", description),
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Retrieves the current contents of the pf table of a firewall alias. Unlike `opnsense_firewall_alias`, which returns the configured content, this returns the resolved addresses, e.g. the entries loaded from a URL table, GeoIP, ASN or dynamic alias.",
+
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the alias.",
+			},
+			"entry_count": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The number of entries in the table.",
+			},
+			"addresses": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "The addresses and networks in the table.",
+			},
+			"entries": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The entries in the table, including their counters.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"address": schema.StringAttribute{
+							Computed:    true,
+							Description: "The address or network of the entry.",
+						},
+						"in_block_packets":  counterAttribute("Number of blocked inbound packets matching the entry."),
+						"in_block_bytes":    counterAttribute("Number of blocked inbound bytes matching the entry."),
+						"in_pass_packets":   counterAttribute("Number of passed inbound packets matching the entry."),
+						"in_pass_bytes":     counterAttribute("Number of passed inbound bytes matching the entry."),
+						"out_block_packets": counterAttribute("Number of blocked outbound packets matching the entry."),
+						"out_block_bytes":   counterAttribute("Number of blocked outbound bytes matching the entry."),
+						"out_pass_packets":  counterAttribute("Number of passed outbound packets matching the entry."),
+						"out_pass_bytes":    counterAttribute("Number of passed outbound bytes matching the entry."),
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *aliasTableDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*opnsense.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *opnsense.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *aliasTableDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, fmt.Sprintf("Reading %s", aliasTableResourceName))
+
+	// Read Terraform configuration data into the model
+	var data aliasTableDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Check alias exists
+	tflog.Debug(ctx, "Getting alias UUID", map[string]any{"name": data.Name.ValueString()})
+
+	_, err := getAliasUuid(d.client, data.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Read %s error", aliasTableResourceName), fmt.Sprintf("%s", err))
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get alias table
+	tflog.Debug(ctx, fmt.Sprintf("Getting %s information", aliasTableResourceName), map[string]any{"name": data.Name.ValueString()})
+
+	entries, err := listAliasTable(d.client, data.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Read %s error", aliasTableResourceName), fmt.Sprintf("%s", err))
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Successfully got %s information", aliasTableResourceName), map[string]any{"count": len(entries)})
+
+	// Map response to model
+	data.EntryCount = types.Int64Value(int64(len(entries)))
+	data.Addresses = []types.String{}
+	data.Entries = []aliasTableEntryModel{}
+	for _, entry := range entries {
+		data.Addresses = append(data.Addresses, types.StringValue(entry.Address))
+		data.Entries = append(data.Entries, aliasTableEntryModel{
+			Address:         types.StringValue(entry.Address),
+			InBlockPackets:  types.Int64PointerValue(entry.Counters.InBlockPackets),
+			InBlockBytes:    types.Int64PointerValue(entry.Counters.InBlockBytes),
+			InPassPackets:   types.Int64PointerValue(entry.Counters.InPassPackets),
+			InPassBytes:     types.Int64PointerValue(entry.Counters.InPassBytes),
+			OutBlockPackets: types.Int64PointerValue(entry.Counters.OutBlockPackets),
+			OutBlockBytes:   types.Int64PointerValue(entry.Counters.OutBlockBytes),
+			OutPassPackets:  types.Int64PointerValue(entry.Counters.OutPassPackets),
+			OutPassBytes:    types.Int64PointerValue(entry.Counters.OutPassBytes),
+		})
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Successfully read %s", aliasTableResourceName), map[string]any{"success": true})
+}
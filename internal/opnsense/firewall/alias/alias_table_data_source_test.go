@@ -0,0 +1,100 @@
+package alias_test
+
+import (
+	"regexp"
+	"testing"
+
+	"terraform-provider-opnsense/internal/acctest"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccAliasTableDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing (with counters)
+			{
+				Config: testAccAliasTableDataSourceConfig_counters,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("data.opnsense_firewall_alias_table.test_acc_data_source_table", tfjsonpath.New("name"), knownvalue.StringExact("test_acc_alias_table_data_source")),
+					statecheck.ExpectKnownValue("data.opnsense_firewall_alias_table.test_acc_data_source_table", tfjsonpath.New("entry_count"), knownvalue.Int64Exact(2)),
+					statecheck.ExpectKnownValue("data.opnsense_firewall_alias_table.test_acc_data_source_table", tfjsonpath.New("addresses"), knownvalue.ListExact([]knownvalue.Check{
+						knownvalue.StringExact("1.1.1.1"),
+						knownvalue.StringExact("10.0.0.0/24"),
+					})),
+					statecheck.ExpectKnownValue("data.opnsense_firewall_alias_table.test_acc_data_source_table", tfjsonpath.New("entries").AtSliceIndex(0).AtMapKey("in_pass_packets"), knownvalue.NotNull()),
+				},
+			},
+			// Read testing (without counters)
+			{
+				Config: testAccAliasTableDataSourceConfig_noCounters,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("data.opnsense_firewall_alias_table.test_acc_data_source_table", tfjsonpath.New("entry_count"), knownvalue.Int64Exact(2)),
+					statecheck.ExpectKnownValue("data.opnsense_firewall_alias_table.test_acc_data_source_table", tfjsonpath.New("entries").AtSliceIndex(0).AtMapKey("in_pass_packets"), knownvalue.Null()),
+				},
+			},
+		},
+	})
+}
+
+func TestAccAliasTableDataSource_missing(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing (missing alias)
+			{
+				Config:      testAccAliasTableDataSourceConfig_missing,
+				ExpectError: regexp.MustCompile("Alias does not exist"),
+			},
+		},
+	})
+}
+
+// testAccAliasTableDataSourceConfig_counters creates an alias resource with counters and reads its table as a data source.
+const testAccAliasTableDataSourceConfig_counters = `
+	resource "opnsense_firewall_alias" "test_acc_data_source_table" {
+		name = "test_acc_alias_table_data_source"
+		type = "network"
+		counters = true
+		description = "alias for terraform table datasource testing"
+		content = [
+			"1.1.1.1",
+			"10.0.0.0/24"
+		]
+	}
+
+	data "opnsense_firewall_alias_table" "test_acc_data_source_table" {
+		name = opnsense_firewall_alias.test_acc_data_source_table.name
+	}
+`
+
+// testAccAliasTableDataSourceConfig_noCounters creates an alias resource without counters and reads its table as a data source.
+const testAccAliasTableDataSourceConfig_noCounters = `
+	resource "opnsense_firewall_alias" "test_acc_data_source_table" {
+		name = "test_acc_alias_table_data_source"
+		type = "network"
+		counters = false
+		description = "alias for terraform table datasource testing"
+		content = [
+			"1.1.1.1",
+			"10.0.0.0/24"
+		]
+	}
+
+	data "opnsense_firewall_alias_table" "test_acc_data_source_table" {
+		name = opnsense_firewall_alias.test_acc_data_source_table.name
+	}
+`
+
+// testAccAliasTableDataSourceConfig_missing reads the table of an alias that does not exist.
+const testAccAliasTableDataSourceConfig_missing = `
+	data "opnsense_firewall_alias_table" "test_acc_data_source_table" {
+		name = "test_acc_alias_table_missing"
+	}
+`
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"reflect"
 	"strings"
@@ -16,18 +17,36 @@ import (
 )
 
 const (
-	getAliasUuidCommand opnsense.Command = "getAliasUUID"
-	getAliasCommand     opnsense.Command = "getItem"
-	addAliasCommand     opnsense.Command = "addItem"
-	setAliasCommand     opnsense.Command = "setItem"
-	deleteAliasCommand  opnsense.Command = "delItem"
-	getGeoIpCommand     opnsense.Command = "getGeoIP"
-	setGeoIPCommand     opnsense.Command = "set"
-	applyConfigCommand  opnsense.Command = "reconfigure"
+	getAliasUuidCommand  opnsense.Command = "getAliasUUID"
+	getAliasCommand      opnsense.Command = "getItem"
+	addAliasCommand      opnsense.Command = "addItem"
+	setAliasCommand      opnsense.Command = "setItem"
+	deleteAliasCommand   opnsense.Command = "delItem"
+	getGeoIpCommand      opnsense.Command = "getGeoIP"
+	setGeoIPCommand      opnsense.Command = "set"
+	applyConfigCommand   opnsense.Command = "reconfigure"
+	searchAliasCommand   opnsense.Command = "searchItem"
+	updateTablesCommand  opnsense.Command = "update_tables"
+	importAliasesCommand opnsense.Command = "import"
+	exportAliasesCommand opnsense.Command = "export"
+
+	listAliasTableCommand        opnsense.Command = "list"
+	addAliasTableEntryCommand    opnsense.Command = "add"
+	deleteAliasTableEntryCommand opnsense.Command = "delete"
+)
+
+const (
+	// Number of attempts to check whether the GeoIP database was downloaded.
+	geoIpDownloadAttempts int = 60
+	// Delay between attempts to check whether the GeoIP database was downloaded.
+	geoIpDownloadDelay time.Duration = 5 * time.Second
 )
 
 // HTTP errors
 
+// errAliasNotExist is returned when an alias with the requested name does not exist.
+var errAliasNotExist = errors.New("Get alias uuid error: Alias does not exist")
+
 type getAliasStatusCodeError struct{}
 
 func (e getAliasStatusCodeError) Error() string {
@@ -53,6 +72,17 @@ type aliasRequest struct {
 	Description string  `json:"description"`
 }
 
+type importAliasesRequest struct {
+	Data aliasExportDocument `json:"data"`
+}
+
+// aliasExportDocument is the document format used by the alias export & import APIs, with the aliases keyed by UUID.
+type aliasExportDocument struct {
+	Aliases struct {
+		Alias any `json:"alias"`
+	} `json:"aliases"`
+}
+
 type setHttpRequest struct {
 	Alias setAliasRequest `json:"alias"`
 }
@@ -61,6 +91,25 @@ type setAliasRequest struct {
 	GeoIp geoIpRequest `json:"geoip"`
 }
 
+type listAliasTableRequest struct {
+	Current  int64 `json:"current"`
+	RowCount int64 `json:"rowCount"`
+}
+
+type searchAliasRequest struct {
+	Current      int64  `json:"current"`
+	RowCount     int64  `json:"rowCount"`
+	SearchPhrase string `json:"searchPhrase"`
+}
+
+type updateTablesRequest struct {
+	Alias string `json:"alias"`
+}
+
+type aliasTableEntryRequest struct {
+	Address string `json:"address"`
+}
+
 type geoIpRequest struct {
 	Url string `json:"url"`
 }
@@ -105,6 +154,64 @@ type aliasResponse struct {
 	Description string `json:"description"`
 }
 
+type listAliasTableResponse struct {
+	Total int64                `json:"total"`
+	Rows  []aliasTableEntryRow `json:"rows"`
+}
+
+type aliasTableEntryRow struct {
+	Ip              string                    `json:"ip"`
+	InBlockPackets  *opnsense.Float64AsString `json:"in_block_packets"`
+	InBlockBytes    *opnsense.Float64AsString `json:"in_block_bytes"`
+	InPassPackets   *opnsense.Float64AsString `json:"in_pass_packets"`
+	InPassBytes     *opnsense.Float64AsString `json:"in_pass_bytes"`
+	OutBlockPackets *opnsense.Float64AsString `json:"out_block_packets"`
+	OutBlockBytes   *opnsense.Float64AsString `json:"out_block_bytes"`
+	OutPassPackets  *opnsense.Float64AsString `json:"out_pass_packets"`
+	OutPassBytes    *opnsense.Float64AsString `json:"out_pass_bytes"`
+}
+
+type searchAliasResponse struct {
+	Rows []searchAliasRow `json:"rows"`
+}
+
+type searchAliasRow struct {
+	Uuid         string                   `json:"uuid"`
+	Name         string                   `json:"name"`
+	CurrentItems opnsense.Float64AsString `json:"current_items"`
+	LastUpdated  string                   `json:"last_updated"`
+}
+
+type importAliasesResponse struct {
+	Status      string         `json:"status"`
+	Existing    int64          `json:"existing"`
+	New         int64          `json:"new"`
+	Validations map[string]any `json:"validations"`
+}
+
+type exportAliasesResponse struct {
+	Aliases struct {
+		Alias map[string]exportAliasItem `json:"alias"`
+	} `json:"aliases"`
+}
+
+type exportAliasItem struct {
+	Enabled     opnsense.Uint8AsString   `json:"enabled"`
+	Name        string                   `json:"name"`
+	Type        string                   `json:"type"`
+	Proto       string                   `json:"proto"`
+	Interface   string                   `json:"interface"`
+	Counters    opnsense.Uint8AsString   `json:"counters"`
+	UpdateFreq  opnsense.Float64AsString `json:"updatefreq"`
+	Content     string                   `json:"content"`
+	Categories  string                   `json:"categories"`
+	Description string                   `json:"description"`
+}
+
+type aliasUtilResponse struct {
+	Status string `json:"status"`
+}
+
 type geoIpResponse struct {
 	AddressCount   int64 `json:"address_count"`
 	AddressSources struct {
@@ -158,7 +265,7 @@ func getAliasUuid(client *opnsense.Client, name string) (string, error) {
 
 		// Alias does not exist
 		if errors.As(err, &unmarshalTypeError) {
-			return "", errors.New("Get alias uuid error: Alias does not exist")
+			return "", errAliasNotExist
 		}
 
 		return "", err
@@ -435,6 +542,331 @@ func setGeoIp(client *opnsense.Client, url string) error {
 	return nil
 }
 
+// downloadGeoIpDatabase forces OPNsense to download the GeoIP database of the configured url and reload the alias tables.
+func downloadGeoIpDatabase(client *opnsense.Client) error {
+	path := fmt.Sprintf("%s/%s/%s", firewall.Module, controller, updateTablesCommand)
+
+	// Without an alias, all tables are updated, including the GeoIP database
+	reqBody, err := json.Marshal(updateTablesRequest{})
+	if err != nil {
+		return fmt.Errorf("Download %s error: failed to marshal json body - %s", geoipResourceName, err)
+	}
+
+	httpResp, err := client.DoRequest(http.MethodPost, path, reqBody)
+	if err != nil {
+		return fmt.Errorf("OPNsense client error: %s", err)
+	}
+
+	if httpResp.StatusCode != 200 {
+		return fmt.Errorf("Download %s error (http): abnormal status code %d in HTTP response. Please contact the provider for assistance", geoipResourceName, httpResp.StatusCode)
+	}
+
+	var response opnsense.OpnsenseApplyConfigResponse
+	err = json.NewDecoder(httpResp.Body).Decode(&response)
+	if err != nil {
+		return fmt.Errorf("Download %s error (http): failed to decode http response - %s", geoipResourceName, err)
+	}
+
+	status := strings.ToLower(strings.TrimSpace(response.Status))
+	if status != "ok" && status != "done" {
+		return fmt.Errorf("Download %s error: failed to download the %s database on OPNsense, got status `%s`", geoipResourceName, geoipResourceName, response.Status)
+	}
+
+	return nil
+}
+
+// waitForGeoIpDatabase waits until the GeoIP database downloaded by downloadGeoIpDatabase is imported on the OPNsense firewall,
+// i.e. the database contains addresses and is split into address files. The vendor timestamp of the database is not used, as
+// downloading the same database again does not change it.
+func waitForGeoIpDatabase(client *opnsense.Client) (*geoip, error) {
+	var geoip *geoip
+	var err error
+	for attempt := 1; attempt <= geoIpDownloadAttempts; attempt++ {
+		geoip, err = getGeoIp(client)
+		if err != nil {
+			return nil, err
+		}
+
+		if geoip.AddressCount > 0 && geoip.FileCount > 0 && (geoip.AddressSources.Ipv4 != "" || geoip.AddressSources.Ipv6 != "") {
+			return geoip, nil
+		}
+
+		if attempt < geoIpDownloadAttempts {
+			time.Sleep(geoIpDownloadDelay)
+		}
+	}
+
+	return nil, fmt.Errorf("Download %s error: no database imported after %s. Check the configured url & the OPNsense system log for details", geoipResourceName, time.Duration(geoIpDownloadAttempts)*geoIpDownloadDelay)
+}
+
+// listAliasTable gets the current entries of the pf table of the alias with a matching name.
+func listAliasTable(client *opnsense.Client, name string) ([]aliasTableEntry, error) {
+	path := fmt.Sprintf("%s/%s/%s/%s", firewall.Module, aliasUtilController, listAliasTableCommand, name)
+
+	// Request all entries on a single page
+	reqBody, err := json.Marshal(listAliasTableRequest{
+		Current:  1,
+		RowCount: -1,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Get %s error: failed to marshal json body - %s", aliasTableResourceName, err)
+	}
+
+	httpResp, err := client.DoRequest(http.MethodPost, path, reqBody)
+	if err != nil {
+		return nil, fmt.Errorf("OPNsense client error: %s", err)
+	}
+
+	if httpResp.StatusCode != 200 {
+		return nil, fmt.Errorf("Get %s error (http): abnormal status code %d in HTTP response. Please contact the provider for assistance", aliasTableResourceName, httpResp.StatusCode)
+	}
+
+	var response listAliasTableResponse
+	err = json.NewDecoder(httpResp.Body).Decode(&response)
+	if err != nil {
+		return nil, fmt.Errorf("Get %s error (http): %s", aliasTableResourceName, err)
+	}
+
+	entries := []aliasTableEntry{}
+	for _, row := range response.Rows {
+		entries = append(entries, aliasTableEntry{
+			Address: row.Ip,
+			Counters: aliasTableCounters{
+				InBlockPackets:  counterValue(row.InBlockPackets),
+				InBlockBytes:    counterValue(row.InBlockBytes),
+				InPassPackets:   counterValue(row.InPassPackets),
+				InPassBytes:     counterValue(row.InPassBytes),
+				OutBlockPackets: counterValue(row.OutBlockPackets),
+				OutBlockBytes:   counterValue(row.OutBlockBytes),
+				OutPassPackets:  counterValue(row.OutPassPackets),
+				OutPassBytes:    counterValue(row.OutPassBytes),
+			},
+		})
+	}
+
+	return entries, nil
+}
+
+// getAliasStatistics gets the number of loaded entries & last update time of the alias with a matching name.
+func getAliasStatistics(client *opnsense.Client, name string) (aliasStatistics, error) {
+	rows, err := searchAliases(client, name)
+	if err != nil {
+		return aliasStatistics{}, err
+	}
+
+	// The search phrase also matches partial names & descriptions, find the exact match
+	for _, row := range rows {
+		if row.Name == name {
+			return aliasStatistics{
+				EntryCount:    int64(row.CurrentItems),
+				LastRefreshed: row.LastUpdated,
+			}, nil
+		}
+	}
+
+	return aliasStatistics{}, fmt.Errorf("Get %[1]s statistics error: %[1]s with name `%s` does not exist", aliasResourceName, name)
+}
+
+// getAliasNames gets the names of all aliases on the OPNsense firewall with a single search.
+func getAliasNames(client *opnsense.Client) (*utils.Set, error) {
+	rows, err := searchAliases(client, "")
+	if err != nil {
+		return nil, err
+	}
+
+	names := utils.NewSet()
+	for _, row := range rows {
+		names.Add(row.Name)
+	}
+
+	return names, nil
+}
+
+// searchAliases searches the aliases on the OPNsense firewall matching the search phrase (name or description).
+func searchAliases(client *opnsense.Client, searchPhrase string) ([]searchAliasRow, error) {
+	path := fmt.Sprintf("%s/%s/%s", firewall.Module, controller, searchAliasCommand)
+
+	reqBody, err := json.Marshal(searchAliasRequest{
+		Current:      1,
+		RowCount:     -1,
+		SearchPhrase: searchPhrase,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Search %s error: failed to marshal json body - %s", aliasResourceName, err)
+	}
+
+	httpResp, err := client.DoRequest(http.MethodPost, path, reqBody)
+	if err != nil {
+		return nil, fmt.Errorf("OPNsense client error: %s", err)
+	}
+
+	if httpResp.StatusCode != 200 {
+		return nil, fmt.Errorf("Search %s error (http): abnormal status code %d in HTTP response. Please contact the provider for assistance", aliasResourceName, httpResp.StatusCode)
+	}
+
+	var response searchAliasResponse
+	err = json.NewDecoder(httpResp.Body).Decode(&response)
+	if err != nil {
+		return nil, fmt.Errorf("Search %s error (http): %s", aliasResourceName, err)
+	}
+
+	return response.Rows, nil
+}
+
+// refreshAliasTable forces OPNsense to download the content of the url or urltable alias with a matching name and reload its pf table, regardless of the update frequency.
+func refreshAliasTable(client *opnsense.Client, name string) error {
+	path := fmt.Sprintf("%s/%s/%s", firewall.Module, controller, updateTablesCommand)
+
+	reqBody, err := json.Marshal(updateTablesRequest{Alias: name})
+	if err != nil {
+		return fmt.Errorf("Refresh %s error: failed to marshal json body - %s", aliasResourceName, err)
+	}
+
+	httpResp, err := client.DoRequest(http.MethodPost, path, reqBody)
+	if err != nil {
+		return fmt.Errorf("OPNsense client error: %s", err)
+	}
+
+	if httpResp.StatusCode != 200 {
+		return fmt.Errorf("Refresh %s error (http): abnormal status code %d in HTTP response. Please contact the provider for assistance", aliasResourceName, httpResp.StatusCode)
+	}
+
+	var response opnsense.OpnsenseApplyConfigResponse
+	err = json.NewDecoder(httpResp.Body).Decode(&response)
+	if err != nil {
+		return fmt.Errorf("Refresh %s error (http): failed to decode http response - %s", aliasResourceName, err)
+	}
+
+	status := strings.ToLower(strings.TrimSpace(response.Status))
+	if status != "ok" && status != "done" {
+		return fmt.Errorf("Refresh %s error: failed to refresh alias `%s` on OPNsense, got status `%s`", aliasResourceName, name, response.Status)
+	}
+
+	return nil
+}
+
+// importAliases creates or updates the specified aliases on the OPNsense firewall in a single request. Existing aliases are matched by name.
+func importAliases(client *opnsense.Client, aliases []alias) error {
+	items := make(map[string]aliasRequest)
+	for _, alias := range aliases {
+		items[alias.Name] = aliasToHttpBody(alias).Alias
+	}
+
+	return postAliasImport(client, items)
+}
+
+// importAliasExport imports the specified items of an OPNsense alias export on the OPNsense firewall in a single request. The items are sent as-is, existing aliases are matched by name.
+func importAliasExport(client *opnsense.Client, items map[string]json.RawMessage) error {
+	return postAliasImport(client, items)
+}
+
+// postAliasImport sends the specified alias items to the bulk import API of the OPNsense firewall, in the format of an alias export.
+func postAliasImport(client *opnsense.Client, items any) error {
+	path := fmt.Sprintf("%s/%s/%s", firewall.Module, controller, importAliasesCommand)
+
+	body := importAliasesRequest{}
+	body.Data.Aliases.Alias = items
+
+	reqBody, err := json.Marshal(body)
+	if err != nil {
+		return fmt.Errorf("Import %s error: failed to marshal json body - %s", aliasBundleResourceName, err)
+	}
+
+	httpResp, err := client.DoRequest(http.MethodPost, path, reqBody)
+	if err != nil {
+		return fmt.Errorf("OPNsense client error: %s", err)
+	}
+
+	if httpResp.StatusCode != 200 {
+		return fmt.Errorf("Import %s error (http): abnormal status code %d in HTTP response. Please contact the provider for assistance", aliasBundleResourceName, httpResp.StatusCode)
+	}
+
+	var response importAliasesResponse
+	err = json.NewDecoder(httpResp.Body).Decode(&response)
+	if err != nil {
+		return fmt.Errorf("Import %s error (http): failed to decode http response - %s", aliasBundleResourceName, err)
+	}
+
+	if strings.ToLower(response.Status) != "ok" || len(response.Validations) > 0 {
+		return fmt.Errorf("Import %[1]s error: failed to import %[1]s on OPNsense - failed validations:\n%s", aliasBundleResourceName, opnsense.ValidationsToString(response.Validations))
+	}
+
+	return nil
+}
+
+// exportAliases gets all aliases on the OPNsense firewall, keyed by name. The raw export is also returned.
+func exportAliases(client *opnsense.Client) (map[string]alias, string, error) {
+	path := fmt.Sprintf("%s/%s/%s", firewall.Module, controller, exportAliasesCommand)
+
+	httpResp, err := client.DoRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, "", fmt.Errorf("OPNsense client error: %s", err)
+	}
+
+	if httpResp.StatusCode != 200 {
+		return nil, "", fmt.Errorf("Export %s error (http): abnormal status code %d in HTTP response. Please contact the provider for assistance", aliasBundleResourceName, httpResp.StatusCode)
+	}
+
+	raw, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		return nil, "", fmt.Errorf("Export %s error (http): failed to read http response - %s", aliasBundleResourceName, err)
+	}
+
+	var response exportAliasesResponse
+	err = json.Unmarshal(raw, &response)
+	if err != nil {
+		return nil, "", fmt.Errorf("Export %s error (http): %s", aliasBundleResourceName, err)
+	}
+
+	aliases := make(map[string]alias)
+	for _, item := range response.Aliases.Alias {
+		aliases[item.Name] = exportAliasItemToAlias(item)
+	}
+
+	return aliases, string(raw), nil
+}
+
+// addAliasTableEntry adds the specified address to the pf table of the alias with a matching name.
+func addAliasTableEntry(client *opnsense.Client, name string, address string) error {
+	return doAliasTableEntryRequest(client, name, address, addAliasTableEntryCommand, "Add")
+}
+
+// deleteAliasTableEntry removes the specified address from the pf table of the alias with a matching name.
+func deleteAliasTableEntry(client *opnsense.Client, name string, address string) error {
+	return doAliasTableEntryRequest(client, name, address, deleteAliasTableEntryCommand, "Delete")
+}
+
+// doAliasTableEntryRequest runs the specified alias util command for an address in the pf table of the alias with a matching name.
+func doAliasTableEntryRequest(client *opnsense.Client, name string, address string, command opnsense.Command, action string) error {
+	path := fmt.Sprintf("%s/%s/%s/%s", firewall.Module, aliasUtilController, command, name)
+
+	reqBody, err := json.Marshal(aliasTableEntryRequest{Address: address})
+	if err != nil {
+		return fmt.Errorf("%s %s error: failed to marshal json body - %s", action, aliasEntryResourceName, err)
+	}
+
+	httpResp, err := client.DoRequest(http.MethodPost, path, reqBody)
+	if err != nil {
+		return fmt.Errorf("OPNsense client error: %s", err)
+	}
+
+	if httpResp.StatusCode != 200 {
+		return fmt.Errorf("%s %s error (http): abnormal status code %d in HTTP response. Please contact the provider for assistance", action, aliasEntryResourceName, httpResp.StatusCode)
+	}
+
+	var response aliasUtilResponse
+	err = json.NewDecoder(httpResp.Body).Decode(&response)
+	if err != nil {
+		return fmt.Errorf("%s %s error (http): failed to decode http response - %s", action, aliasEntryResourceName, err)
+	}
+
+	if strings.ToLower(response.Status) != "done" {
+		return fmt.Errorf("%s %s error: failed to %s address `%s` in alias `%s` on OPNsense, got status `%s`", action, aliasEntryResourceName, strings.ToLower(action), address, name, response.Status)
+	}
+
+	return nil
+}
+
 // applyConfig applies the alias configuration on the OPNsense firewall.
 func applyConfig(client *opnsense.Client) error {
 	path := fmt.Sprintf("%s/%s/%s", firewall.Module, controller, applyConfigCommand)
@@ -465,3 +897,36 @@ func applyConfig(client *opnsense.Client) error {
 	}
 	return nil
 }
+
+// getAliasContentHash gets the content hash of the alias from OPNsense.
+func getAliasContentHash(client *opnsense.Client, uuid string) (string, error) {
+	object, err := getAlias(client, uuid)
+	if err != nil {
+		return "", err
+	}
+
+	return utils.ContentHash(object)
+}
+
+// getGeoIpContentHash gets the content hash of the GeoIP configuration. Credentials embedded in the url (e.g. a MaxMind
+// license key) are removed before hashing.
+func getGeoIpContentHash(geoip *geoip) (string, error) {
+	return utils.ContentHash(removeUrlCredentials(geoip.Url))
+}
+
+// getAliasBundleContentHash gets the content hash of the aliases with the specified names from OPNsense.
+func getAliasBundleContentHash(client *opnsense.Client, names []string) (string, error) {
+	aliases, _, err := exportAliases(client)
+	if err != nil {
+		return "", err
+	}
+
+	members := make(map[string]alias)
+	for _, name := range names {
+		if alias, exists := aliases[name]; exists {
+			members[name] = alias
+		}
+	}
+
+	return utils.ContentHash(members)
+}
@@ -8,19 +8,24 @@ import (
 
 	"terraform-provider-opnsense/internal/opnsense"
 	"terraform-provider-opnsense/internal/opnsense/firewall"
+	"terraform-provider-opnsense/internal/utils"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &geoIpResource{}
-	_ resource.ResourceWithConfigure   = &geoIpResource{}
-	_ resource.ResourceWithImportState = &geoIpResource{}
+	_ resource.Resource                 = &geoIpResource{}
+	_ resource.ResourceWithConfigure    = &geoIpResource{}
+	_ resource.ResourceWithImportState  = &geoIpResource{}
+	_ resource.ResourceWithUpgradeState = &geoIpResource{}
 )
 
 // NewGeoIpResource is a helper function to simplify the provider implementation.
@@ -35,8 +40,16 @@ type geoIpResource struct {
 
 // geoIpResourceModel describes the resource data model.
 type geoIpResourceModel struct {
-	Url         types.String `tfsdk:"url"`
-	LastUpdated types.String `tfsdk:"last_updated"`
+	Url                       types.String `tfsdk:"url"`
+	MaxMindAccountId          types.String `tfsdk:"maxmind_account_id"`
+	MaxMindLicenseKey         types.String `tfsdk:"maxmind_license_key"`
+	MaxMindCredentialsVersion types.Int64  `tfsdk:"maxmind_credentials_version"`
+	DownloadDatabase          types.Bool   `tfsdk:"download_database"`
+	AddressCount              types.Int64  `tfsdk:"address_count"`
+	FileCount                 types.Int64  `tfsdk:"file_count"`
+	Timestamp                 types.String `tfsdk:"timestamp"`
+	LastUpdated               types.String `tfsdk:"last_updated"`
+	ContentHash               types.String `tfsdk:"content_hash"`
 }
 
 // Metadata returns the resource type name.
@@ -47,16 +60,67 @@ func (r *geoIpResource) Metadata(ctx context.Context, req resource.MetadataReque
 // Schema defines the schema for the resource.
 func (r *geoIpResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:             1,
 		MarkdownDescription: "With GeoIP aliases you can select one or more countries or whole continents to block or allow. This resource allows you to configure the source for fetching GeoIP addresses.",
 
 		Attributes: map[string]schema.Attribute{
 			"url": schema.StringAttribute{
-				Required:    true,
-				Description: " Location to fetch geoip address ranges from.",
+				Optional:            true,
+				MarkdownDescription: "Location to fetch geoip address ranges from. Exactly one of `url` or `maxmind_license_key` must be set.",
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("maxmind_license_key")),
+				},
+			},
+			"maxmind_account_id": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+				MarkdownDescription: "The MaxMind account ID, used together with `maxmind_license_key` to build the download url of the MaxMind GeoLite2 country database. This value is write-only and never stored in the state (requires Terraform 1.11+).",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.AlsoRequires(path.MatchRoot("maxmind_license_key")),
+				},
+			},
+			"maxmind_license_key": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+				MarkdownDescription: "The MaxMind license key, used together with `maxmind_account_id` to build the download url of the MaxMind GeoLite2 country database. This value is write-only and never stored in the state (requires Terraform 1.11+).",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.AlsoRequires(path.MatchRoot("maxmind_account_id")),
+				},
+			},
+			"maxmind_credentials_version": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Version of the MaxMind credentials. As write-only attributes are not stored in the state, changing the credentials alone is not detected; change this value to update the credentials on OPNsense.",
+			},
+			"download_database": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Whether to download the GeoIP database after the configuration is applied and wait for it to be imported. The apply fails if no addresses are imported within 5 minutes. Defaults to `false`.",
+				Default:             booldefault.StaticBool(false),
+			},
+			"address_count": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The number of entries in the downloaded set.",
+			},
+			"file_count": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The number of files used to store all GeoIP addresses.",
+			},
+			"timestamp": schema.StringAttribute{
+				Computed:    true,
+				Description: "The date & time the GeoIP addresses were last updated (time the vendor created the list).",
 			},
 			"last_updated": schema.StringAttribute{
+				Computed:           true,
+				Description:        "DateTime when this resource was last updated.",
+				DeprecationMessage: "Use `content_hash` to detect changes to this resource instead. This attribute will be removed in a future release.",
+			},
+			"content_hash": schema.StringAttribute{
 				Computed:    true,
-				Description: "DateTime when this resource was last updated.",
+				Description: "Hash of the GeoIP configuration on OPNsense. Changes whenever the configuration is modified, including changes made outside of Terraform.",
 			},
 		},
 	}
@@ -94,10 +158,17 @@ func (r *geoIpResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	// Read Terraform configuration data into the model, write-only attributes are only available in the configuration
+	var config geoIpResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Set geoip config on OPNsense
 	tflog.Debug(ctx, fmt.Sprintf("Setting %s configuration on OPNsense", geoipResourceName), map[string]any{"url": plan.Url.ValueString()})
 
-	err := setGeoIp(r.client, plan.Url.ValueString())
+	err := setGeoIp(r.client, getGeoIpUrl(config))
 	if err != nil {
 		resp.Diagnostics.AddError(fmt.Sprintf("Set %s error", geoipResourceName), fmt.Sprintf("%s", err))
 	}
@@ -115,6 +186,39 @@ func (r *geoIpResource) Create(ctx context.Context, req resource.CreateRequest,
 		tflog.Debug(ctx, "Successfully applied configuration on OPNsense", map[string]any{"success": true})
 	}
 
+	// Download the GeoIP database & wait for it to be imported
+	if plan.DownloadDatabase.ValueBool() {
+		tflog.Debug(ctx, fmt.Sprintf("Downloading %s database on OPNsense", geoipResourceName))
+
+		err = downloadGeoIpDatabase(r.client)
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Create %s error", geoipResourceName), fmt.Sprintf("%s", err))
+			return
+		}
+
+		_, err = waitForGeoIpDatabase(r.client)
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Create %s error", geoipResourceName), fmt.Sprintf("%s", err))
+			return
+		}
+
+		tflog.Debug(ctx, fmt.Sprintf("Successfully downloaded %s database on OPNsense", geoipResourceName), map[string]any{"success": true})
+	}
+
+	// Get content hash & database status of the GeoIP configuration on OPNsense
+	geoip, err := getGeoIp(r.client)
+	if err != nil {
+		resp.Diagnostics.AddWarning(fmt.Sprintf("Create %s error", geoipResourceName), fmt.Sprintf("Unable to get content hash: %s", err))
+		plan.ContentHash = types.StringNull()
+		setGeoIpDatabaseStatus(&plan, nil)
+	} else {
+		contentHash, err := getGeoIpContentHash(geoip)
+		contentHashValue, diags := utils.ContentHashValue(fmt.Sprintf("Create %s error", geoipResourceName), contentHash, err)
+		resp.Diagnostics.Append(diags...)
+		plan.ContentHash = contentHashValue
+		setGeoIpDatabaseStatus(&plan, geoip)
+	}
+
 	// Update plan last_updated field
 	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
 
@@ -152,7 +256,19 @@ func (r *geoIpResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	tflog.Debug(ctx, fmt.Sprintf("Successfully got %s configuration", geoipResourceName), map[string]any{"success": true})
 
 	// Overwite items with refreshed state
-	state.Url = types.StringValue(geoip.Url)
+	contentHash, err := getGeoIpContentHash(geoip)
+	contentHashValue, diags := utils.ContentHashValue(fmt.Sprintf("Read %s error", geoipResourceName), contentHash, err)
+	resp.Diagnostics.Append(diags...)
+	state.ContentHash = contentHashValue
+
+	// The url is not stored when built from MaxMind credentials or when it contains credentials not in the configuration
+	state.Url = getGeoIpUrlValue(state.Url, geoip.Url)
+	setGeoIpDatabaseStatus(&state, geoip)
+
+	// Default provider-side settings, e.g. after import
+	if state.DownloadDatabase.IsNull() {
+		state.DownloadDatabase = types.BoolValue(false)
+	}
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -184,10 +300,17 @@ func (r *geoIpResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	// Read Terraform configuration data into the model, write-only attributes are only available in the configuration
+	var config geoIpResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update geoip on OPNsense
 	tflog.Debug(ctx, fmt.Sprintf("Updating %s configuration on OPNsense", geoipResourceName), map[string]any{"url": plan.Url.ValueString()})
 
-	err := setGeoIp(r.client, plan.Url.ValueString())
+	err := setGeoIp(r.client, getGeoIpUrl(config))
 	if err != nil {
 		resp.Diagnostics.AddError(fmt.Sprintf("Update %s error", geoipResourceName), fmt.Sprintf("%s", err))
 	}
@@ -205,6 +328,39 @@ func (r *geoIpResource) Update(ctx context.Context, req resource.UpdateRequest,
 		tflog.Debug(ctx, "Successfully applied configuration on OPNsense", map[string]any{"success": true})
 	}
 
+	// Download the GeoIP database & wait for it to be imported
+	if plan.DownloadDatabase.ValueBool() {
+		tflog.Debug(ctx, fmt.Sprintf("Downloading %s database on OPNsense", geoipResourceName))
+
+		err = downloadGeoIpDatabase(r.client)
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Update %s error", geoipResourceName), fmt.Sprintf("%s", err))
+			return
+		}
+
+		_, err = waitForGeoIpDatabase(r.client)
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Update %s error", geoipResourceName), fmt.Sprintf("%s", err))
+			return
+		}
+
+		tflog.Debug(ctx, fmt.Sprintf("Successfully downloaded %s database on OPNsense", geoipResourceName), map[string]any{"success": true})
+	}
+
+	// Get content hash & database status of the GeoIP configuration on OPNsense
+	geoip, err := getGeoIp(r.client)
+	if err != nil {
+		resp.Diagnostics.AddWarning(fmt.Sprintf("Update %s error", geoipResourceName), fmt.Sprintf("Unable to get content hash: %s", err))
+		plan.ContentHash = types.StringNull()
+		setGeoIpDatabaseStatus(&plan, nil)
+	} else {
+		contentHash, err := getGeoIpContentHash(geoip)
+		contentHashValue, diags := utils.ContentHashValue(fmt.Sprintf("Update %s error", geoipResourceName), contentHash, err)
+		resp.Diagnostics.Append(diags...)
+		plan.ContentHash = contentHashValue
+		setGeoIpDatabaseStatus(&plan, geoip)
+	}
+
 	// Update last_updated field (if change detected)
 	if !(reflect.DeepEqual(plan, state)) {
 		plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
@@ -265,7 +421,7 @@ func (r *geoIpResource) ImportState(ctx context.Context, req resource.ImportStat
 
 	// Nothing special needs to be done since the geoip read function does not require any attributes.
 	// Setting url to an empty string to prevent terraform from throwing a missing resource import state
-	// error.
+	// error. The url is refreshed on read, urls with credentials are not stored in the state.
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("url"), "")...)
 	if resp.Diagnostics.HasError() {
 		return
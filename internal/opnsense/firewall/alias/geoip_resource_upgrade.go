@@ -0,0 +1,57 @@
+package alias
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-opnsense/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// geoIpResourceModelV0 describes the resource data model of schema version 0.
+type geoIpResourceModelV0 struct {
+	Url         *string `json:"url"`
+	LastUpdated *string `json:"last_updated"`
+}
+
+// UpgradeState upgrades the resource state from prior schema versions to the current schema version.
+func (r *geoIpResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {StateUpgrader: upgradeGeoIpStateV0},
+	}
+}
+
+// upgradeGeoIpStateV0 upgrades the resource state from schema version 0.
+func upgradeGeoIpStateV0(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	tflog.Info(ctx, fmt.Sprintf("Upgrading %s state from version 0", geoipResourceName))
+
+	var priorState geoIpResourceModelV0
+	err := utils.DecodeRawState(req, &priorState)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Upgrade %s state error", geoipResourceName), fmt.Sprintf("%s", err))
+		return
+	}
+
+	state := geoIpResourceModel{
+		Url:                       utils.StringOrNull(priorState.Url),
+		MaxMindAccountId:          types.StringNull(),
+		MaxMindLicenseKey:         types.StringNull(),
+		MaxMindCredentialsVersion: types.Int64Null(),
+		DownloadDatabase:          types.BoolValue(false),
+		AddressCount:              types.Int64Null(),
+		FileCount:                 types.Int64Null(),
+		Timestamp:                 types.StringNull(),
+		LastUpdated:               utils.StringOrNull(priorState.LastUpdated),
+		ContentHash:               types.StringNull(),
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Successfully upgraded %s state from version 0", geoipResourceName))
+}
@@ -2,10 +2,20 @@ package alias
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"math"
+	"net/netip"
+	"net/url"
+	"reflect"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
 	"strings"
 
+	"terraform-provider-opnsense/internal/customtypes"
 	"terraform-provider-opnsense/internal/opnsense"
 	"terraform-provider-opnsense/internal/opnsense/firewall/category"
 	"terraform-provider-opnsense/internal/opnsense/interfaces/overview"
@@ -20,10 +30,19 @@ import (
 )
 
 const (
-	controller string = "alias"
+	controller          string = "alias"
+	aliasUtilController string = "alias_util"
 
 	aliasResourceName string = "alias"
 	geoipResourceName string = "geoip"
+
+	aliasTableResourceName string = "alias table"
+	aliasEntryResourceName string = "alias entry"
+
+	aliasBundleResourceName string = "alias bundle"
+
+	// MaxMind database edition used when building the GeoIP url from MaxMind credentials.
+	geoIpMaxMindEdition string = "GeoLite2-Country-CSV"
 )
 
 type alias struct {
@@ -37,6 +56,9 @@ type alias struct {
 	Categories  *utils.Set
 	Content     *utils.Set
 	Interface   string
+
+	// Aliases referenced by name in the content, resolved on OPNsense. Excluded from the content hash.
+	ReferencedAliases *utils.Set `json:"-"`
 }
 
 type geoip struct {
@@ -52,6 +74,28 @@ type geoip struct {
 	Usages            int64
 }
 
+type aliasStatistics struct {
+	EntryCount    int64
+	LastRefreshed string
+}
+
+type aliasTableEntry struct {
+	Address  string
+	Counters aliasTableCounters
+}
+
+// aliasTableCounters holds the pf table counters of an address. A nil value means the counter is not reported by OPNsense.
+type aliasTableCounters struct {
+	InBlockPackets  *int64
+	InBlockBytes    *int64
+	InPassPackets   *int64
+	InPassBytes     *int64
+	OutBlockPackets *int64
+	OutBlockBytes   *int64
+	OutPassPackets  *int64
+	OutPassBytes    *int64
+}
+
 // Alias values
 
 func getAliasTypes() []string {
@@ -72,6 +116,365 @@ func getAliasTypes() []string {
 	}
 }
 
+// Alias content validation
+
+var (
+	aliasNameRegex   = regexp.MustCompile(`^[a-zA-Z0-9_]{1,32}$`)
+	hostnameRegex    = regexp.MustCompile(`^([a-zA-Z0-9_]([a-zA-Z0-9_-]{0,61}[a-zA-Z0-9_])?\.)*[a-zA-Z0-9_]([a-zA-Z0-9_-]{0,61}[a-zA-Z0-9_])?\.?$`)
+	macAddressRegex  = regexp.MustCompile(`^[0-9a-fA-F]{2}([:-][0-9a-fA-F]{2}){0,5}$`)
+	countryCodeRegex = regexp.MustCompile(`^[A-Z]{2}$`)
+)
+
+// Alias references
+
+// getReferenceableAliasTypes returns the alias types that each alias type can reference by name in its content.
+func getReferenceableAliasTypes() map[string][]string {
+	return map[string][]string{
+		"host":         {"host", "dynipv6host", "internal", "external"},
+		"network":      {"host", "network", "networkgroup", "url", "urltable", "geoip", "asn", "dynipv6host", "authgroup", "internal", "external"},
+		"networkgroup": {"host", "network", "networkgroup", "url", "urltable", "geoip", "asn", "dynipv6host", "authgroup", "internal", "external"},
+		"port":         {"port"},
+	}
+}
+
+// getAliasReferenceCandidates returns the content elements of the specified alias type that could be alias names.
+func getAliasReferenceCandidates(aliasType string, content []string) []string {
+	if _, exists := getReferenceableAliasTypes()[aliasType]; !exists {
+		return []string{}
+	}
+
+	candidates := []string{}
+	for _, element := range content {
+		value := strings.TrimPrefix(element, "!")
+
+		// Purely numeric values are port numbers rather than alias names
+		if _, err := strconv.Atoi(value); err == nil {
+			continue
+		}
+		if aliasNameRegex.MatchString(value) {
+			candidates = append(candidates, value)
+		}
+	}
+
+	return candidates
+}
+
+// aliasLookup gets aliases from OPNsense by name, caching the result of each lookup.
+type aliasLookup struct {
+	client *opnsense.Client
+	cache  map[string]*alias
+}
+
+// newAliasLookup creates a new aliasLookup using the specified client.
+func newAliasLookup(client *opnsense.Client) *aliasLookup {
+	return &aliasLookup{client: client, cache: make(map[string]*alias)}
+}
+
+// get returns the alias with a matching name, or nil if it does not exist.
+func (l *aliasLookup) get(name string) (*alias, error) {
+	if cached, exists := l.cache[name]; exists {
+		return cached, nil
+	}
+
+	uuid, err := getAliasUuid(l.client, name)
+	if errors.Is(err, errAliasNotExist) {
+		l.cache[name] = nil
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := getAlias(l.client, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	l.cache[name] = result
+	return result, nil
+}
+
+// getReferencedAliases returns the names of the existing aliases (from the specified alias names) referenced in the
+// content of the specified alias.
+func getReferencedAliases(names *utils.Set, aliasType string, content []string) *utils.Set {
+	referenced := utils.NewSet()
+	for _, candidate := range getAliasReferenceCandidates(aliasType, content) {
+		if names.Contains(candidate) {
+			referenced.Add(candidate)
+		}
+	}
+	return referenced
+}
+
+// findAliasReferenceCycle searches the references of the alias with the specified name for a path leading back to the alias. Returns the path of the cycle, or nil if there is none.
+func findAliasReferenceCycle(lookup *aliasLookup, name string, references []string) ([]string, error) {
+	visited := utils.NewSet()
+
+	var visit func(current string, trail []string) ([]string, error)
+	visit = func(current string, trail []string) ([]string, error) {
+		trail = append(trail, current)
+		if current == name {
+			return trail, nil
+		}
+		if visited.Contains(current) {
+			return nil, nil
+		}
+		visited.Add(current)
+
+		result, err := lookup.get(current)
+		if err != nil || result == nil {
+			return nil, err
+		}
+
+		for _, next := range getAliasReferenceCandidates(result.Type, result.Content.Elements()) {
+			cycle, err := visit(next, trail)
+			if err != nil || cycle != nil {
+				return cycle, err
+			}
+		}
+		return nil, nil
+	}
+
+	for _, reference := range references {
+		cycle, err := visit(reference, []string{name})
+		if err != nil || cycle != nil {
+			return cycle, err
+		}
+	}
+	return nil, nil
+}
+
+// resolveAliasReferences verifies that the aliases referenced in the content of the specified alias exist, have a compatible type and do not reference the alias in return. Returns the names of the referenced aliases.
+func resolveAliasReferences(ctx context.Context, client *opnsense.Client, name string, aliasType string, content []string) (*utils.Set, diag.Diagnostics) {
+	var diagnostics diag.Diagnostics
+	referenced := utils.NewSet()
+
+	candidates := getAliasReferenceCandidates(aliasType, content)
+	if len(candidates) == 0 {
+		return referenced, diagnostics
+	}
+
+	tflog.Debug(ctx, "Resolving alias references", map[string]any{"candidates": candidates})
+
+	lookup := newAliasLookup(client)
+	compatibleTypes := getReferenceableAliasTypes()[aliasType]
+
+	for _, candidate := range candidates {
+		if candidate == name {
+			diagnostics.AddAttributeError(path.Root("content"), "Invalid Alias Reference", fmt.Sprintf("Alias `%s` cannot reference itself.", name))
+			continue
+		}
+
+		result, err := lookup.get(candidate)
+		if err != nil {
+			diagnostics.AddError(fmt.Sprintf("Create %s object error", aliasResourceName), fmt.Sprintf("%s", err))
+			return referenced, diagnostics
+		}
+
+		// Host aliases also accept hostnames, which cannot be told apart from alias names
+		if result == nil {
+			if aliasType != "host" {
+				diagnostics.AddAttributeError(path.Root("content"), "Invalid Alias Reference", fmt.Sprintf("Referenced alias `%s` does not exist. Please verify that the alias exists on your OPNsense firewall.", candidate))
+			}
+			continue
+		}
+
+		if !slices.Contains(compatibleTypes, result.Type) {
+			diagnostics.AddAttributeError(path.Root("content"), "Invalid Alias Reference", fmt.Sprintf("Referenced alias `%s` has type `%s`, which cannot be used in an alias of type `%s`. Must be one of: %s.", candidate, result.Type, aliasType, strings.Join(compatibleTypes, ", ")))
+			continue
+		}
+
+		referenced.Add(candidate)
+	}
+
+	if diagnostics.HasError() {
+		return referenced, diagnostics
+	}
+
+	cycle, err := findAliasReferenceCycle(lookup, name, referenced.Elements())
+	if err != nil {
+		diagnostics.AddError(fmt.Sprintf("Create %s object error", aliasResourceName), fmt.Sprintf("%s", err))
+	} else if cycle != nil {
+		diagnostics.AddAttributeError(path.Root("content"), "Invalid Alias Reference", fmt.Sprintf("Alias references form a cycle: %s.", strings.Join(cycle, " -> ")))
+	}
+
+	tflog.Debug(ctx, "Successfully resolved alias references", map[string]any{"referenced": referenced.Elements()})
+
+	return referenced, diagnostics
+}
+
+// preserveNetworkContent returns the content reported by OPNsense, keeping the notation of the prior content for
+// `host`, `network` & `networkgroup` elements that are semantically equal (e.g `192.168.1.1` & `192.168.1.1/32`).
+// Content of other alias types is returned as is.
+func preserveNetworkContent(ctx context.Context, aliasType string, prior *utils.Set, content *utils.Set) *utils.Set {
+	if !slices.Contains([]string{"host", "network", "networkgroup"}, aliasType) {
+		return content
+	}
+
+	preserved := utils.NewSet()
+	for _, element := range content.Elements() {
+		value := element
+		for _, priorElement := range prior.Elements() {
+			equal, _ := customtypes.NewNetworkValue(priorElement).StringSemanticEquals(ctx, customtypes.NewNetworkValue(element))
+			if equal {
+				value = priorElement
+				break
+			}
+		}
+
+		preserved.Add(value)
+	}
+
+	return preserved
+}
+
+// getAliasContentValidators returns the content validation function for each alias type that supports validation.
+// Each function returns an error describing why the specified content element is invalid.
+func getAliasContentValidators() map[string]func(string) error {
+	return map[string]func(string) error{
+		"host":     validateHostContent,
+		"network":  validateNetworkContent,
+		"port":     validatePortContent,
+		"mac":      validateMacContent,
+		"asn":      validateAsnContent,
+		"geoip":    validateGeoIpContent,
+		"url":      validateUrlContent,
+		"urltable": validateUrlContent,
+	}
+}
+
+// validateHostContent validates that the specified content is an IP address, IP range, FQDN or nested alias name.
+func validateHostContent(content string) error {
+	value := strings.TrimPrefix(content, "!")
+
+	if _, err := netip.ParseAddr(value); err == nil {
+		return nil
+	}
+
+	if start, end, found := strings.Cut(value, "-"); found {
+		startAddr, startErr := netip.ParseAddr(start)
+		endAddr, endErr := netip.ParseAddr(end)
+		if startErr == nil && endErr == nil {
+			if startAddr.Is4() != endAddr.Is4() || endAddr.Less(startAddr) {
+				return fmt.Errorf("`%s` is not a valid IP range", content)
+			}
+			return nil
+		}
+	}
+
+	if isHostname(value) {
+		return nil
+	}
+
+	return errors.New("must be an IP address, IP range, fully qualified domain name or alias name")
+}
+
+// isHostname checks if the specified value is a fully qualified domain name or alias name. A numeric top-level label
+// is rejected, so mistyped IP addresses (e.g `10.0.0.256`) are not accepted as hostnames.
+func isHostname(value string) bool {
+	if len(value) > 253 || !hostnameRegex.MatchString(value) {
+		return false
+	}
+
+	labels := strings.Split(strings.TrimSuffix(value, "."), ".")
+	topLevelLabel := labels[len(labels)-1]
+	return strings.Trim(topLevelLabel, "0123456789") != ""
+}
+
+// validateNetworkContent validates that the specified content is a network in CIDR notation, IP address or nested alias name.
+func validateNetworkContent(content string) error {
+	value := strings.TrimPrefix(content, "!")
+
+	if _, err := netip.ParsePrefix(value); err == nil {
+		return nil
+	}
+
+	if _, err := netip.ParseAddr(value); err == nil {
+		return nil
+	}
+
+	if aliasNameRegex.MatchString(value) {
+		return nil
+	}
+
+	return errors.New("must be a network in CIDR notation (e.g `10.0.0.0/24`), IP address or alias name")
+}
+
+// validatePortContent validates that the specified content is a port number, port range or nested alias name.
+func validatePortContent(content string) error {
+	isPort := func(value string) bool {
+		port, err := strconv.ParseUint(value, 10, 16)
+		return err == nil && port <= 65535
+	}
+
+	if isPort(content) {
+		return nil
+	}
+
+	if start, end, found := strings.Cut(content, ":"); found {
+		if !isPort(start) || !isPort(end) {
+			return errors.New("must be a port range in the format `start:end` with ports between 0 and 65535")
+		}
+
+		startPort, _ := strconv.Atoi(start)
+		endPort, _ := strconv.Atoi(end)
+		if startPort > endPort {
+			return errors.New("the start of the port range must not be greater than the end of the port range")
+		}
+		return nil
+	}
+
+	// Purely numeric values are out of range port numbers rather than alias names
+	if _, err := strconv.Atoi(content); err != nil && aliasNameRegex.MatchString(content) {
+		return nil
+	}
+
+	return errors.New("must be a port number between 0 and 65535, port range (e.g `80:443`) or alias name")
+}
+
+// validateMacContent validates that the specified content is a full or partial MAC address.
+func validateMacContent(content string) error {
+	if macAddressRegex.MatchString(content) {
+		return nil
+	}
+
+	return errors.New("must be a full or partial MAC address (e.g `00:11:22:33:44:55` or `00:11:22`)")
+}
+
+// validateAsnContent validates that the specified content is an autonomous system number.
+func validateAsnContent(content string) error {
+	asn, err := strconv.ParseUint(content, 10, 32)
+	if err != nil || asn == 0 {
+		return errors.New("must be an autonomous system number between 1 and 4294967295")
+	}
+
+	return nil
+}
+
+// validateGeoIpContent validates that the specified content is an ISO 3166-1 alpha-2 country code.
+func validateGeoIpContent(content string) error {
+	if countryCodeRegex.MatchString(content) {
+		return nil
+	}
+
+	return errors.New("must be an uppercase ISO 3166-1 alpha-2 country code (e.g `SG`)")
+}
+
+// validateUrlContent validates that the specified content is an absolute URL.
+func validateUrlContent(content string) error {
+	parsedUrl, err := url.ParseRequestURI(content)
+	if err != nil || parsedUrl.Host == "" {
+		return errors.New("must be a valid absolute URL (e.g `https://www.example.com/list.txt`)")
+	}
+
+	switch strings.ToLower(parsedUrl.Scheme) {
+	case "http", "https", "ftp":
+		return nil
+	default:
+		return fmt.Errorf("URL scheme `%s` is not supported. Must be one of: `http`, `https`, `ftp`", parsedUrl.Scheme)
+	}
+}
+
 // Helper functions
 
 // freqFloatToObject converts an updateFreq value from a float64 value to an updateFreqType value.
@@ -95,6 +498,335 @@ func protoContains(protoList []string, proto string) bool {
 	return false
 }
 
+// getAliasStatisticsValues gets the statistics of the alias with a matching name as Terraform values. Null values are returned if the statistics cannot be retrieved.
+func getAliasStatisticsValues(client *opnsense.Client, name string) (types.Int64, types.String, error) {
+	statistics, err := getAliasStatistics(client, name)
+	if err != nil {
+		return types.Int64Null(), types.StringNull(), err
+	}
+
+	return types.Int64Value(statistics.EntryCount), types.StringValue(statistics.LastRefreshed), nil
+}
+
+// getMaxMindGeoIpUrl builds the GeoIP download url for the specified MaxMind account ID & license key.
+func getMaxMindGeoIpUrl(accountId string, licenseKey string) string {
+	download := url.URL{
+		Scheme:   "https",
+		User:     url.UserPassword(accountId, licenseKey),
+		Host:     "download.maxmind.com",
+		Path:     fmt.Sprintf("/geoip/databases/%s/download", geoIpMaxMindEdition),
+		RawQuery: "suffix=zip",
+	}
+	return download.String()
+}
+
+// getGeoIpUrl returns the GeoIP download url of the specified configuration, built from the MaxMind credentials if set.
+func getGeoIpUrl(config geoIpResourceModel) string {
+	if !config.MaxMindLicenseKey.IsNull() {
+		return getMaxMindGeoIpUrl(config.MaxMindAccountId.ValueString(), config.MaxMindLicenseKey.ValueString())
+	}
+	return config.Url.ValueString()
+}
+
+// removeUrlCredentials removes the user information (e.g. credentials) from the specified url.
+func removeUrlCredentials(rawUrl string) string {
+	parsed, err := url.Parse(rawUrl)
+	if err != nil || parsed.User == nil {
+		return rawUrl
+	}
+
+	parsed.User = nil
+	return parsed.String()
+}
+
+// getGeoIpUrlValue returns the url to store in the state for the specified url on OPNsense. Urls with credentials (e.g. a MaxMind
+// license key) are only stored when the prior state already contains the same url, otherwise null is returned. The url is also null
+// when the prior state is null, i.e. the url is built from MaxMind credentials.
+func getGeoIpUrlValue(prior types.String, rawUrl string) types.String {
+	if prior.IsNull() {
+		return types.StringNull()
+	}
+
+	if removeUrlCredentials(rawUrl) != rawUrl && prior.ValueString() != rawUrl {
+		return types.StringNull()
+	}
+
+	return types.StringValue(rawUrl)
+}
+
+// setGeoIpDatabaseStatus sets the database status attributes of the specified model. The attributes are set to null if the GeoIP configuration is not known.
+func setGeoIpDatabaseStatus(model *geoIpResourceModel, geoip *geoip) {
+	if geoip == nil {
+		model.AddressCount = types.Int64Null()
+		model.FileCount = types.Int64Null()
+		model.Timestamp = types.StringNull()
+		return
+	}
+
+	model.AddressCount = types.Int64Value(geoip.AddressCount)
+	model.FileCount = types.Int64Value(geoip.FileCount)
+	model.Timestamp = types.StringValue(geoip.Timestamp)
+}
+
+// splitAliasList splits the specified list of alias values, ignoring empty values.
+func splitAliasList(list string, separator string) []string {
+	values := []string{}
+	for _, value := range strings.Split(list, separator) {
+		if value = strings.TrimSpace(value); value != "" {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
+// setFromSlice creates a set containing the elements of the specified slice.
+func setFromSlice(slice []string) *utils.Set {
+	set := utils.NewSet()
+	set.AddSlice(slice)
+	return set
+}
+
+// counterValue converts an optional counter read from OPNsense to an optional int64 value.
+func counterValue(counter *opnsense.Float64AsString) *int64 {
+	if counter == nil {
+		return nil
+	}
+
+	value := int64(*counter)
+	return &value
+}
+
+// validateAliasEntryAddress validates that the specified address is an IP address or network in CIDR notation.
+func validateAliasEntryAddress(address string) error {
+	if _, err := netip.ParseAddr(address); err == nil {
+		return nil
+	}
+
+	if _, err := netip.ParsePrefix(address); err == nil {
+		return nil
+	}
+
+	return errors.New("must be an IP address or network in CIDR notation (e.g `10.0.0.0/24`)")
+}
+
+// normaliseTableAddress converts the specified address to the notation used by pf tables, e.g `10.0.0.1/32` becomes `10.0.0.1`.
+func normaliseTableAddress(address string) string {
+	if prefix, err := netip.ParsePrefix(address); err == nil {
+		if prefix.IsSingleIP() {
+			return prefix.Addr().String()
+		}
+		return prefix.Masked().String()
+	}
+
+	if addr, err := netip.ParseAddr(address); err == nil {
+		return addr.String()
+	}
+
+	return address
+}
+
+// aliasTableContains checks if the specified pf table entries contain the specified address.
+func aliasTableContains(entries []aliasTableEntry, address string) bool {
+	normalised := normaliseTableAddress(address)
+	for _, entry := range entries {
+		if normaliseTableAddress(entry.Address) == normalised {
+			return true
+		}
+	}
+	return false
+}
+
+// getAliasBundleMemberTypes returns the alias types supported by alias bundle members.
+func getAliasBundleMemberTypes() []string {
+	return slices.DeleteFunc(getAliasTypes(), func(aliasType string) bool {
+		// Authgroup content references users & groups by id, which cannot be carried across firewalls
+		return aliasType == "authgroup"
+	})
+}
+
+// deleteAliasesByName deletes the aliases with the specified names on OPNsense. Aliases that no longer exist are skipped.
+func deleteAliasesByName(client *opnsense.Client, names []string) error {
+	for _, name := range names {
+		uuid, err := getAliasUuid(client, name)
+		if errors.Is(err, errAliasNotExist) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		err = deleteAlias(client, uuid)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// filterAliasExport filters the specified raw alias export to the aliases with the specified names, keeping the export format so that it can be imported again.
+func filterAliasExport(raw string, names []string) (string, error) {
+	items, err := parseAliasExport(raw)
+	if err != nil {
+		return "", err
+	}
+
+	filtered := make(map[string]json.RawMessage)
+	for name, item := range items {
+		if slices.Contains(names, name) {
+			filtered[item.Uuid] = item.Raw
+		}
+	}
+
+	var export aliasExportDocument
+	export.Aliases.Alias = filtered
+
+	result, err := json.Marshal(export)
+	if err != nil {
+		return "", err
+	}
+
+	return string(result), nil
+}
+
+// aliasExportItem is a single alias of an OPNsense alias export, kept in its raw format.
+type aliasExportItem struct {
+	Uuid string
+	Raw  json.RawMessage
+}
+
+// parseAliasExport parses the specified OPNsense alias export document. Returns the aliases of the document keyed by name.
+func parseAliasExport(doc string) (map[string]aliasExportItem, error) {
+	var export struct {
+		Aliases struct {
+			Alias map[string]json.RawMessage `json:"alias"`
+		} `json:"aliases"`
+	}
+	err := json.Unmarshal([]byte(doc), &export)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make(map[string]aliasExportItem)
+	for uuid, raw := range export.Aliases.Alias {
+		var aliasName struct {
+			Name string `json:"name"`
+		}
+		err = json.Unmarshal(raw, &aliasName)
+		if err != nil {
+			return nil, fmt.Errorf("alias `%s`: %s", uuid, err)
+		}
+
+		if aliasName.Name == "" {
+			return nil, fmt.Errorf("alias `%s` has no name", uuid)
+		}
+
+		if _, exists := items[aliasName.Name]; exists {
+			return nil, fmt.Errorf("alias `%s` is defined more than once", aliasName.Name)
+		}
+
+		items[aliasName.Name] = aliasExportItem{Uuid: uuid, Raw: raw}
+	}
+
+	return items, nil
+}
+
+// aliasExportItemsEqual checks whether the specified raw aliases of an OPNsense alias export describe the same alias.
+func aliasExportItemsEqual(a aliasExportItem, b aliasExportItem) bool {
+	aliasA, errA := aliasExportItemToAlias(a.Raw)
+	aliasB, errB := aliasExportItemToAlias(b.Raw)
+	if errA != nil || errB != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(aliasA, aliasB)
+}
+
+// aliasExportItemToAlias converts a raw alias of an OPNsense alias export to an alias object.
+func aliasExportItemToAlias(raw json.RawMessage) (alias, error) {
+	var item exportAliasItem
+	err := json.Unmarshal(raw, &item)
+	if err != nil {
+		return alias{}, err
+	}
+
+	return exportAliasItemToAlias(item), nil
+}
+
+// exportAliasItemToAlias converts an alias of the OPNsense alias export to an alias object.
+func exportAliasItemToAlias(item exportAliasItem) alias {
+	protos := splitAliasList(item.Proto, ",")
+	sort.Strings(protos)
+
+	return alias{
+		Enabled:     uint8(item.Enabled) == 1,
+		Name:        item.Name,
+		Type:        item.Type,
+		Counters:    uint8(item.Counters) == 1,
+		UpdateFreq:  float64(item.UpdateFreq),
+		Description: item.Description,
+		Proto:       protos,
+		Categories:  setFromSlice(splitAliasList(item.Categories, ",")),
+		Content:     setFromSlice(splitAliasList(item.Content, "\n")),
+		Interface:   item.Interface,
+	}
+}
+
+// aliasBundleMemberToAlias converts an alias bundle member with the specified name to an alias object.
+func aliasBundleMemberToAlias(ctx context.Context, name string, member aliasBundleMemberModel) (alias, diag.Diagnostics) {
+	var diagnostics diag.Diagnostics
+
+	content, diags := utils.SetTerraformToGo(ctx, member.Content)
+	diagnostics.Append(diags...)
+
+	protoSet, diags := utils.SetTerraformToGo(ctx, member.Proto)
+	diagnostics.Append(diags...)
+
+	protos := []string{}
+	for _, proto := range protoSet.Elements() {
+		protos = append(protos, strings.Replace(proto, "ip", "IP", 1))
+	}
+
+	return alias{
+		Enabled:     member.Enabled.ValueBool(),
+		Name:        name,
+		Type:        member.Type.ValueString(),
+		Counters:    member.Counters.ValueBool(),
+		UpdateFreq:  member.UpdateFreq.ValueFloat64(),
+		Description: member.Description.ValueString(),
+		Proto:       protos,
+		Categories:  utils.NewSet(),
+		Content:     content,
+		Interface:   member.Interface.ValueString(),
+	}, diagnostics
+}
+
+// aliasToAliasBundleMember converts an alias object to an alias bundle member.
+func aliasToAliasBundleMember(ctx context.Context, alias alias) (aliasBundleMemberModel, diag.Diagnostics) {
+	var diagnostics diag.Diagnostics
+
+	content, diags := utils.SetGoToTerraform(ctx, alias.Content)
+	diagnostics.Append(diags...)
+
+	protos := utils.NewSet()
+	for _, proto := range alias.Proto {
+		protos.Add(strings.ToLower(proto))
+	}
+	proto, diags := utils.SetGoToTerraform(ctx, protos)
+	diagnostics.Append(diags...)
+
+	return aliasBundleMemberModel{
+		Enabled:     types.BoolValue(alias.Enabled),
+		Type:        types.StringValue(alias.Type),
+		Counters:    types.BoolValue(alias.Counters),
+		UpdateFreq:  types.Float64Value(alias.UpdateFreq),
+		Description: types.StringValue(alias.Description),
+		Proto:       proto,
+		Content:     content,
+		Interface:   types.StringValue(alias.Interface),
+	}, diagnostics
+}
+
 // createAlias creates an alias based on the specified plan.
 func createAlias(ctx context.Context, client *opnsense.Client, plan aliasResourceModel) (alias, diag.Diagnostics) {
 	var diagnostics diag.Diagnostics
@@ -112,10 +844,10 @@ func createAlias(ctx context.Context, client *opnsense.Client, plan aliasResourc
 
 	tflog.Debug(ctx, "Successfully verified categories", map[string]any{"success": true})
 
-	// Verify interface (if type is dynipv6)
-	if plan.Type.Equal(types.StringValue("dynipv6")) {
+	// Verify interface (if type is dynipv6host)
+	if plan.Type.Equal(types.StringValue("dynipv6host")) {
 		if plan.Interface.ValueString() == "" {
-			diagnostics.AddAttributeError(path.Root("interface"), "Missing Required Attribute", "The `interface` attribute must be set when `type` is set to `dynipv6`")
+			diagnostics.AddAttributeError(path.Root("interface"), "Missing Required Attribute", "The `interface` attribute must be set when `type` is set to `dynipv6host`")
 		}
 
 		tflog.Debug(ctx, "Verifying interface", map[string]any{
@@ -185,6 +917,10 @@ func createAlias(ctx context.Context, client *opnsense.Client, plan aliasResourc
 	content, diags := utils.SetTerraformToGo(ctx, plan.Content)
 	diagnostics.Append(diags...)
 
+	// Verify referenced aliases
+	referencedAliases, diags := resolveAliasReferences(ctx, client, plan.Name.ValueString(), plan.Type.ValueString(), content.Elements())
+	diagnostics.Append(diags...)
+
 	alias := alias{
 		Enabled:     plan.Enabled.ValueBool(),
 		Name:        plan.Name.ValueString(),
@@ -196,6 +932,8 @@ func createAlias(ctx context.Context, client *opnsense.Client, plan aliasResourc
 		Categories:  categoryUuids,
 		Content:     content,
 		Interface:   plan.Interface.ValueString(),
+
+		ReferencedAliases: referencedAliases,
 	}
 
 	tflog.Debug(ctx, fmt.Sprintf("Successfully created %s object from plan", aliasResourceName), map[string]any{"success": true})
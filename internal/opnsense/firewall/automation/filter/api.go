@@ -5,7 +5,9 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"strconv"
 	"strings"
+	"time"
 
 	"terraform-provider-opnsense/internal/opnsense"
 	"terraform-provider-opnsense/internal/opnsense/firewall"
@@ -14,22 +16,47 @@ import (
 )
 
 const (
-	addAutomationFilterCommand         opnsense.Command = "add_rule"
-	getAutomationFilterCommand         opnsense.Command = "get_rule"
-	setAutomationFilterCommand         opnsense.Command = "set_rule"
-	deleteAutomationFilterCommand      opnsense.Command = "del_rule"
-	applyAutomationFilterConfigCommand opnsense.Command = "apply"
+	addAutomationFilterCommand            opnsense.Command = "add_rule"
+	getAutomationFilterCommand            opnsense.Command = "get_rule"
+	setAutomationFilterCommand            opnsense.Command = "set_rule"
+	deleteAutomationFilterCommand         opnsense.Command = "del_rule"
+	applyAutomationFilterConfigCommand    opnsense.Command = "apply"
+	savepointAutomationFilterCommand      opnsense.Command = "savepoint"
+	cancelRollbackAutomationFilterCommand opnsense.Command = "cancelRollback"
+	revertAutomationFilterCommand         opnsense.Command = "revert"
+	getAutomationFilterSettingsCommand    opnsense.Command = "get"
+	searchAutomationFilterCommand         opnsense.Command = "search_rule"
+)
+
+const (
+	// Number of attempts to reach the OPNsense API after a safe apply.
+	safeApplyReachabilityAttempts int = 3
+	// Delay between attempts to reach the OPNsense API after a safe apply.
+	safeApplyReachabilityDelay time.Duration = 5 * time.Second
 )
 
 // HTTP request bodies
 
+type searchAutomationFilterRequestBody struct {
+	Current      int32    `json:"current"`
+	RowCount     int32    `json:"rowCount"`
+	SearchPhrase string   `json:"searchPhrase"`
+	Sort         struct{} `json:"sort"`
+}
+
 type automationFilterRuleHttpBody struct {
 	Rule automationFilterRuleRequest `json:"rule"`
 }
 
+type automationFilterSequenceHttpBody struct {
+	Rule struct {
+		Sequence int32 `json:"sequence"`
+	} `json:"rule"`
+}
+
 type automationFilterRuleRequest struct {
 	Enabled         uint8  `json:"enabled"`
-	Sequence        int32  `json:"sequence"`
+	Sequence        *int32 `json:"sequence,omitempty"`
 	Action          string `json:"action"`
 	Quick           uint8  `json:"quick"`
 	Interfaces      string `json:"interface"`
@@ -46,10 +73,45 @@ type automationFilterRuleRequest struct {
 	Log             uint8  `json:"log"`
 	Categories      string `json:"categories"`
 	Description     string `json:"description"`
+
+	// Advanced options
+	TcpFlags                 string                  `json:"tcpflags1"`
+	TcpFlagsOutOf            string                  `json:"tcpflags2"`
+	StateType                string                  `json:"statetype"`
+	StateTimeout             opnsense.Pint32AsString `json:"statetimeout"`
+	MaxStates                opnsense.Pint32AsString `json:"max"`
+	MaxSourceNodes           opnsense.Pint32AsString `json:"max-src-nodes"`
+	MaxSourceStates          opnsense.Pint32AsString `json:"max-src-states"`
+	MaxSourceConnections     opnsense.Pint32AsString `json:"max-src-conn"`
+	MaxNewConnections        opnsense.Pint32AsString `json:"max-src-conn-rate"`
+	MaxNewConnectionsSeconds opnsense.Pint32AsString `json:"max-src-conn-rates"`
+	IcmpTypes                string                  `json:"icmptype"`
+	Icmp6Types               string                  `json:"icmp6-type"`
+	AllowOptions             uint8                   `json:"allowopts"`
+	Tag                      string                  `json:"tag"`
+	Tagged                   string                  `json:"tagged"`
+	ReplyTo                  string                  `json:"reply-to"`
+	Priority                 opnsense.Pint32AsString `json:"prio"`
+	SetPriority              opnsense.Pint32AsString `json:"set-prio"`
+	SetPriorityLow           opnsense.Pint32AsString `json:"set-prio-low"`
+	Schedule                 string                  `json:"sched"`
+	NoSync                   uint8                   `json:"nosync"`
 }
 
 // HTTP response types
 
+type searchAutomationFilterResponse struct {
+	Rows []struct {
+		Uuid        string `json:"uuid"`
+		Sequence    int32  `json:"sequence,string"`
+		Description string `json:"description"`
+	} `json:"rows"`
+}
+
+type savepointAutomationFilterResponse struct {
+	Revision string `json:"revision"`
+}
+
 type getAutomationFilterResponse struct {
 	Rule automationFilterRuleResponse `json:"rule"`
 }
@@ -94,16 +156,86 @@ type automationFilterRuleResponse struct {
 		Selected uint8  `json:"selected"`
 	} `json:"categories"`
 	Description string `json:"description"`
+
+	// Advanced options
+	TcpFlags                 automationFilterOptions `json:"tcpflags1"`
+	TcpFlagsOutOf            automationFilterOptions `json:"tcpflags2"`
+	StateType                automationFilterOptions `json:"statetype"`
+	StateTimeout             opnsense.Pint32AsString `json:"statetimeout"`
+	MaxStates                opnsense.Pint32AsString `json:"max"`
+	MaxSourceNodes           opnsense.Pint32AsString `json:"max-src-nodes"`
+	MaxSourceStates          opnsense.Pint32AsString `json:"max-src-states"`
+	MaxSourceConnections     opnsense.Pint32AsString `json:"max-src-conn"`
+	MaxNewConnections        opnsense.Pint32AsString `json:"max-src-conn-rate"`
+	MaxNewConnectionsSeconds opnsense.Pint32AsString `json:"max-src-conn-rates"`
+	IcmpTypes                automationFilterOptions `json:"icmptype"`
+	Icmp6Types               automationFilterOptions `json:"icmp6-type"`
+	AllowOptions             uint8                   `json:"allowopts,string"`
+	Tag                      string                  `json:"tag"`
+	Tagged                   string                  `json:"tagged"`
+	ReplyTo                  automationFilterOptions `json:"reply-to"`
+	Priority                 automationFilterOptions `json:"prio"`
+	SetPriority              automationFilterOptions `json:"set-prio"`
+	SetPriorityLow           automationFilterOptions `json:"set-prio-low"`
+	Schedule                 automationFilterOptions `json:"sched"`
+	NoSync                   uint8                   `json:"nosync,string"`
+}
+
+// automationFilterOptions describes an option field in OPNsense HTTP responses.
+type automationFilterOptions map[string]struct {
+	Value    string `json:"value"`
+	Selected uint8  `json:"selected"`
+}
+
+// selected returns the first selected option, or an empty string if none is selected.
+func (o automationFilterOptions) selected() string {
+	for name, value := range o {
+		if value.Selected == 1 {
+			return name
+		}
+	}
+	return ""
+}
+
+// selectedSet returns all selected options, ignoring the empty option.
+func (o automationFilterOptions) selectedSet() *utils.Set {
+	set := utils.NewSet()
+	for name, value := range o {
+		if value.Selected == 1 && name != "" {
+			set.Add(name)
+		}
+	}
+	return set
+}
+
+// selectedInt32 returns the first selected option as a number, or -1 if none is selected.
+func (o automationFilterOptions) selectedInt32() (int32, error) {
+	selected := o.selected()
+	if selected == "" {
+		return -1, nil
+	}
+
+	value, err := strconv.ParseInt(selected, 10, 32)
+	if err != nil {
+		return -1, err
+	}
+	return int32(value), nil
 }
 
 // Helper functions
 
 // automationFilterToHttpBody converts a automation filter rule object to a automationFilterToHttpBody object for sending to the OPNsense API.
+// A sequence of 0 is not sent, leaving the sequence of an existing rule unchanged.
 func automationFilterToHttpBody(automationFilter automationFilter) automationFilterRuleHttpBody {
+	var sequence *int32
+	if automationFilter.Sequence > 0 {
+		sequence = &automationFilter.Sequence
+	}
+
 	return automationFilterRuleHttpBody{
 		Rule: automationFilterRuleRequest{
 			Enabled:         utils.BoolToInt(automationFilter.Enabled),
-			Sequence:        automationFilter.Sequence,
+			Sequence:        sequence,
 			Action:          automationFilter.Action,
 			Quick:           utils.BoolToInt(automationFilter.Quick),
 			Interfaces:      strings.Join(automationFilter.Interfaces.Elements(), ","),
@@ -120,6 +252,28 @@ func automationFilterToHttpBody(automationFilter automationFilter) automationFil
 			Log:             utils.BoolToInt(automationFilter.Log),
 			Categories:      strings.Join(automationFilter.Categories.Elements(), ","),
 			Description:     automationFilter.Description,
+
+			TcpFlags:                 strings.Join(automationFilter.TcpFlags.Elements(), ","),
+			TcpFlagsOutOf:            strings.Join(automationFilter.TcpFlagsOutOf.Elements(), ","),
+			StateType:                automationFilter.StateType,
+			StateTimeout:             opnsense.Pint32AsString(automationFilter.StateTimeout),
+			MaxStates:                opnsense.Pint32AsString(automationFilter.MaxStates),
+			MaxSourceNodes:           opnsense.Pint32AsString(automationFilter.MaxSourceNodes),
+			MaxSourceStates:          opnsense.Pint32AsString(automationFilter.MaxSourceStates),
+			MaxSourceConnections:     opnsense.Pint32AsString(automationFilter.MaxSourceConnections),
+			MaxNewConnections:        opnsense.Pint32AsString(automationFilter.MaxNewConnections),
+			MaxNewConnectionsSeconds: opnsense.Pint32AsString(automationFilter.MaxNewConnectionsSeconds),
+			IcmpTypes:                strings.Join(automationFilter.IcmpTypes.Elements(), ","),
+			Icmp6Types:               strings.Join(automationFilter.Icmp6Types.Elements(), ","),
+			AllowOptions:             utils.BoolToInt(automationFilter.AllowOptions),
+			Tag:                      automationFilter.Tag,
+			Tagged:                   automationFilter.Tagged,
+			ReplyTo:                  automationFilter.ReplyTo,
+			Priority:                 opnsense.Pint32AsString(automationFilter.Priority),
+			SetPriority:              opnsense.Pint32AsString(automationFilter.SetPriority),
+			SetPriorityLow:           opnsense.Pint32AsString(automationFilter.SetPriorityLow),
+			Schedule:                 automationFilter.Schedule,
+			NoSync:                   utils.BoolToInt(automationFilter.NoSync),
 		},
 	}
 }
@@ -243,6 +397,27 @@ func getAutomationFilterRule(client *opnsense.Client, uuid string) (*automationF
 		}
 	}
 
+	// State type defaults to `keep` when not set
+	stateType := response.Rule.StateType.selected()
+	if stateType == "" {
+		stateType = "keep"
+	}
+
+	priority, err := response.Rule.Priority.selectedInt32()
+	if err != nil {
+		return nil, fmt.Errorf("Get %s error: failed to parse priority - %s", resourceName, err)
+	}
+
+	setPriority, err := response.Rule.SetPriority.selectedInt32()
+	if err != nil {
+		return nil, fmt.Errorf("Get %s error: failed to parse set priority - %s", resourceName, err)
+	}
+
+	setPriorityLow, err := response.Rule.SetPriorityLow.selectedInt32()
+	if err != nil {
+		return nil, fmt.Errorf("Get %s error: failed to parse set priority low - %s", resourceName, err)
+	}
+
 	return &automationFilter{
 		Enabled:         response.Rule.Enabled == 1,
 		Sequence:        response.Rule.Sequence,
@@ -262,15 +437,170 @@ func getAutomationFilterRule(client *opnsense.Client, uuid string) (*automationF
 		Log:             response.Rule.Log == 1,
 		Categories:      categories,
 		Description:     response.Rule.Description,
+
+		TcpFlags:                 response.Rule.TcpFlags.selectedSet(),
+		TcpFlagsOutOf:            response.Rule.TcpFlagsOutOf.selectedSet(),
+		StateType:                stateType,
+		StateTimeout:             int32(response.Rule.StateTimeout),
+		MaxStates:                int32(response.Rule.MaxStates),
+		MaxSourceNodes:           int32(response.Rule.MaxSourceNodes),
+		MaxSourceStates:          int32(response.Rule.MaxSourceStates),
+		MaxSourceConnections:     int32(response.Rule.MaxSourceConnections),
+		MaxNewConnections:        int32(response.Rule.MaxNewConnections),
+		MaxNewConnectionsSeconds: int32(response.Rule.MaxNewConnectionsSeconds),
+		IcmpTypes:                response.Rule.IcmpTypes.selectedSet(),
+		Icmp6Types:               response.Rule.Icmp6Types.selectedSet(),
+		AllowOptions:             response.Rule.AllowOptions == 1,
+		Tag:                      response.Rule.Tag,
+		Tagged:                   response.Rule.Tagged,
+		ReplyTo:                  response.Rule.ReplyTo.selected(),
+		Priority:                 priority,
+		SetPriority:              setPriority,
+		SetPriorityLow:           setPriorityLow,
+		Schedule:                 response.Rule.Schedule.selected(),
+		NoSync:                   response.Rule.NoSync == 1,
 	}, nil
 }
 
+// searchAutomationFilterRuleRows searches the automation filter rules on the OPNsense firewall, returning the matching rows.
+func searchAutomationFilterRuleRows(client *opnsense.Client, searchPhrase string) (*searchAutomationFilterResponse, error) {
+	path := fmt.Sprintf("%s/%s/%s", firewall.Module, filterController, searchAutomationFilterCommand)
+
+	body := searchAutomationFilterRequestBody{
+		SearchPhrase: searchPhrase,
+		RowCount:     -1,
+	}
+
+	reqBody, err := json.Marshal(body)
+	if err != nil {
+		return nil, fmt.Errorf("Search %s error: failed to marshal json body - %s", resourceName, err)
+	}
+
+	httpResp, err := client.DoRequest(http.MethodPost, path, reqBody)
+	if err != nil {
+		return nil, fmt.Errorf("OPNsense client error: %s", err)
+	}
+
+	if httpResp.StatusCode != 200 {
+		return nil, fmt.Errorf("Search %s error (http): abnormal status code %d in HTTP response. Please contact the provider for assistance", resourceName, httpResp.StatusCode)
+	}
+
+	var response searchAutomationFilterResponse
+	err = json.NewDecoder(httpResp.Body).Decode(&response)
+	if err != nil {
+		return nil, fmt.Errorf("Search %s error (http): %s", resourceName, err)
+	}
+
+	return &response, nil
+}
+
+// searchAutomationFilterRules searches the OPNsense firewall for automation filter rules with a description matching the search phrase. Returns the UUIDs & descriptions of the matching rules.
+func searchAutomationFilterRules(client *opnsense.Client, searchPhrase string) (map[string]string, error) {
+	response, err := searchAutomationFilterRuleRows(client, searchPhrase)
+	if err != nil {
+		return nil, err
+	}
+
+	rules := make(map[string]string, len(response.Rows))
+	for _, row := range response.Rows {
+		rules[row.Uuid] = row.Description
+	}
+
+	return rules, nil
+}
+
+// getAutomationFilterRuleSequences gets the sequence of every automation filter rule on the OPNsense firewall with a
+// single search, keyed by UUID.
+func getAutomationFilterRuleSequences(client *opnsense.Client) (map[string]int32, error) {
+	response, err := searchAutomationFilterRuleRows(client, "")
+	if err != nil {
+		return nil, err
+	}
+
+	sequences := make(map[string]int32, len(response.Rows))
+	for _, row := range response.Rows {
+		sequences[row.Uuid] = row.Sequence
+	}
+
+	return sequences, nil
+}
+
+// getAutomationFilterRulesetRules gets the automation filter rules owned by a ruleset from the OPNsense firewall, sorted by sequence. Returns the UUIDs & the rules.
+func getAutomationFilterRulesetRules(client *opnsense.Client, name string) ([]string, []automationFilter, error) {
+	descriptions, err := searchAutomationFilterRules(client, getAutomationFilterRulesetMarkerPrefix(name))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var uuids []string
+	rules := make(map[string]automationFilter)
+	for uuid, description := range descriptions {
+		if _, _, owned := parseAutomationFilterRulesetMarker(name, description); !owned {
+			continue
+		}
+
+		rule, err := getAutomationFilterRule(client, uuid)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		uuids = append(uuids, uuid)
+		rules[uuid] = *rule
+	}
+
+	sortAutomationFilterRuleUuids(uuids, rules)
+
+	sorted := make([]automationFilter, len(uuids))
+	for index, uuid := range uuids {
+		sorted[index] = rules[uuid]
+	}
+
+	return uuids, sorted, nil
+}
+
+// getAutomationFilterOrderRules gets the UUIDs of the automation filter rules from the OPNsense firewall, sorted by
+// sequence. When an interface is specified, only the rules applying to this interface are returned.
+func getAutomationFilterOrderRules(client *opnsense.Client, iface string) ([]string, error) {
+	sequences, err := getAutomationFilterRuleSequences(client)
+	if err != nil {
+		return nil, err
+	}
+
+	var uuids []string
+	rules := make(map[string]automationFilter)
+	for uuid, sequence := range sequences {
+		// The interfaces are not part of the search results, the rule is only read when scoped to an interface
+		if iface != "" {
+			rule, err := getAutomationFilterRule(client, uuid)
+			if err != nil {
+				return nil, err
+			}
+
+			if !rule.Interfaces.Contains(iface) {
+				continue
+			}
+		}
+
+		uuids = append(uuids, uuid)
+		rules[uuid] = automationFilter{Sequence: sequence}
+	}
+
+	sortAutomationFilterRuleUuids(uuids, rules)
+
+	return uuids, nil
+}
+
 // setAutomationFilterRule updates an existing automation filter rule on the OPNsense firewall with a matching UUID.
 func setAutomationFilterRule(client *opnsense.Client, automationFilter automationFilter, uuid string) error {
+	// Generate API body from automation filter rule object
+	return postAutomationFilterRule(client, automationFilterToHttpBody(automationFilter), uuid)
+}
+
+// postAutomationFilterRule sends the specified body to update an existing automation filter rule on the OPNsense
+// firewall with a matching UUID. Fields missing from the body are left unchanged.
+func postAutomationFilterRule(client *opnsense.Client, body any, uuid string) error {
 	path := fmt.Sprintf("%s/%s/%s/%s", firewall.Module, filterController, setAutomationFilterCommand, uuid)
 
-	// Generate API body from automation filter rule object
-	body := automationFilterToHttpBody(automationFilter)
 	reqBody, err := json.Marshal(body)
 	if err != nil {
 		return fmt.Errorf("Set %s error: failed to marshal json body - %s", resourceName, err)
@@ -298,6 +628,14 @@ func setAutomationFilterRule(client *opnsense.Client, automationFilter automatio
 	return nil
 }
 
+// setAutomationFilterRuleSequence updates the sequence of an existing automation filter rule on the OPNsense firewall
+// with a matching UUID, leaving the other fields unchanged.
+func setAutomationFilterRuleSequence(client *opnsense.Client, uuid string, sequence int32) error {
+	var body automationFilterSequenceHttpBody
+	body.Rule.Sequence = sequence
+	return postAutomationFilterRule(client, body, uuid)
+}
+
 // deleteAutomationFilterRule removes an existing automation filter rule from the OPNsense firewall with a matching UUID.
 func deleteAutomationFilterRule(client *opnsense.Client, uuid string) error {
 	path := fmt.Sprintf("%s/%s/%s/%s", firewall.Module, filterController, deleteAutomationFilterCommand, uuid)
@@ -359,3 +697,123 @@ func applyAutomationFilterConfig(client *opnsense.Client) error {
 	}
 	return nil
 }
+
+// createAutomationFilterSavepoint creates a savepoint of the filter configuration on the OPNsense firewall. Returns the revision of the savepoint.
+func createAutomationFilterSavepoint(client *opnsense.Client) (string, error) {
+	path := fmt.Sprintf("%s/%s/%s", firewall.Module, filterController, savepointAutomationFilterCommand)
+
+	// Generate empty body
+	reqBody, err := json.Marshal(nil)
+	if err != nil {
+		return "", fmt.Errorf("Savepoint error: failed to marshal json body - %s", err)
+	}
+
+	httpResp, err := client.DoRequest(http.MethodPost, path, reqBody)
+	if err != nil {
+		return "", fmt.Errorf("OPNsense client error: %s", err)
+	}
+
+	if httpResp.StatusCode != 200 {
+		return "", fmt.Errorf("Savepoint error (http): abnormal status code %d in HTTP response. Please contact the provider for assistance", httpResp.StatusCode)
+	}
+
+	var resp savepointAutomationFilterResponse
+	err = json.NewDecoder(httpResp.Body).Decode(&resp)
+	if err != nil {
+		return "", fmt.Errorf("Savepoint error (http): failed to decode http response - %s", err)
+	}
+
+	if resp.Revision == "" {
+		return "", fmt.Errorf("Savepoint error: failed to create savepoint on OPNsense. Please contact the provider maintainers for assistance")
+	}
+	return resp.Revision, nil
+}
+
+// doAutomationFilterRevisionCommand sends a filter command bound to a savepoint revision to the OPNsense firewall.
+func doAutomationFilterRevisionCommand(client *opnsense.Client, command opnsense.Command, revision string) error {
+	path := fmt.Sprintf("%s/%s/%s/%s", firewall.Module, filterController, command, revision)
+
+	// Generate empty body
+	reqBody, err := json.Marshal(nil)
+	if err != nil {
+		return fmt.Errorf("%s error: failed to marshal json body - %s", command, err)
+	}
+
+	httpResp, err := client.DoRequest(http.MethodPost, path, reqBody)
+	if err != nil {
+		return fmt.Errorf("OPNsense client error: %s", err)
+	}
+
+	if httpResp.StatusCode != 200 {
+		return fmt.Errorf("%s error (http): abnormal status code %d in HTTP response. Please contact the provider for assistance", command, httpResp.StatusCode)
+	}
+
+	var resp opnsense.OpnsenseApplyConfigResponse
+	err = json.NewDecoder(httpResp.Body).Decode(&resp)
+	if err != nil {
+		return fmt.Errorf("%s error (http): failed to decode http response - %s", command, err)
+	}
+
+	if strings.Trim(strings.ToLower(resp.Status), "\n") != "ok" {
+		return fmt.Errorf("%s error: failed to run command for revision %s on OPNsense. Please contact the provider maintainers for assistance", command, revision)
+	}
+	return nil
+}
+
+// checkAutomationFilterReachable checks whether the OPNsense API can still be reached by the provider.
+func checkAutomationFilterReachable(client *opnsense.Client) error {
+	path := fmt.Sprintf("%s/%s/%s", firewall.Module, filterController, getAutomationFilterSettingsCommand)
+
+	var lastErr error
+	for attempt := 1; attempt <= safeApplyReachabilityAttempts; attempt++ {
+		httpResp, err := client.DoRequest(http.MethodGet, path, nil)
+		if err == nil && httpResp.StatusCode == 200 {
+			return nil
+		}
+
+		if err != nil {
+			lastErr = err
+		} else {
+			lastErr = fmt.Errorf("abnormal status code %d in HTTP response", httpResp.StatusCode)
+		}
+
+		if attempt < safeApplyReachabilityAttempts {
+			time.Sleep(safeApplyReachabilityDelay)
+		}
+	}
+
+	return fmt.Errorf("OPNsense API unreachable after applying configuration - %s", lastErr)
+}
+
+// safeApplyAutomationFilterConfig applies the automation filter configuration on the OPNsense firewall with automatic rollback.
+// The savepoint revision must be created before the configuration is modified. The rollback is only cancelled once the
+// OPNsense API is reachable again, otherwise OPNsense reverts to the savepoint by itself after its rollback timeout.
+func safeApplyAutomationFilterConfig(client *opnsense.Client, revision string) error {
+	err := doAutomationFilterRevisionCommand(client, applyAutomationFilterConfigCommand, revision)
+	if err != nil {
+		// Revert to savepoint straight away, ignore errors as OPNsense rolls back by itself
+		_ = doAutomationFilterRevisionCommand(client, revertAutomationFilterCommand, revision)
+		return fmt.Errorf("Safe apply error: failed to apply configuration, reverted to savepoint %s - %s", revision, err)
+	}
+
+	err = checkAutomationFilterReachable(client)
+	if err != nil {
+		return fmt.Errorf("Safe apply error: %s. OPNsense will automatically roll back to savepoint %s", err, revision)
+	}
+
+	err = doAutomationFilterRevisionCommand(client, cancelRollbackAutomationFilterCommand, revision)
+	if err != nil {
+		return fmt.Errorf("Safe apply error: %s. OPNsense will automatically roll back to savepoint %s", err, revision)
+	}
+	return nil
+}
+
+// getAutomationFilterRuleContentHash gets the content hash of the filter rule from OPNsense.
+func getAutomationFilterRuleContentHash(client *opnsense.Client, uuid string) (string, error) {
+	object, err := getAutomationFilterRule(client, uuid)
+	if err != nil {
+		return "", err
+	}
+
+	return utils.ContentHash(object)
+}
@@ -33,25 +33,46 @@ type automationFilterDataSource struct {
 
 // automationFilterDataSourceModel describes the resource data model.
 type automationFilterDataSourceModel struct {
-	Id              types.String `tfsdk:"id"`
-	Enabled         types.Bool   `tfsdk:"enabled"`
-	Sequence        types.Int32  `tfsdk:"sequence"`
-	Action          types.String `tfsdk:"action"`
-	Quick           types.Bool   `tfsdk:"quick"`
-	Interfaces      types.Set    `tfsdk:"interfaces"`
-	Direction       types.String `tfsdk:"direction"`
-	IpVersion       types.String `tfsdk:"ip_version"`
-	Protocol        types.String `tfsdk:"protocol"`
-	Source          types.String `tfsdk:"source"`
-	SourceNot       types.Bool   `tfsdk:"source_not"`
-	SourcePort      types.String `tfsdk:"source_port"`
-	Destination     types.String `tfsdk:"destination"`
-	DestinationNot  types.Bool   `tfsdk:"destination_not"`
-	DestinationPort types.String `tfsdk:"destination_port"`
-	Gateway         types.String `tfsdk:"gateway"`
-	Log             types.Bool   `tfsdk:"log"`
-	Categories      types.Set    `tfsdk:"categories"`
-	Description     types.String `tfsdk:"description"`
+	Id                       types.String `tfsdk:"id"`
+	Enabled                  types.Bool   `tfsdk:"enabled"`
+	Sequence                 types.Int32  `tfsdk:"sequence"`
+	Action                   types.String `tfsdk:"action"`
+	Quick                    types.Bool   `tfsdk:"quick"`
+	Interfaces               types.Set    `tfsdk:"interfaces"`
+	Direction                types.String `tfsdk:"direction"`
+	IpVersion                types.String `tfsdk:"ip_version"`
+	Protocol                 types.String `tfsdk:"protocol"`
+	Source                   types.String `tfsdk:"source"`
+	SourceNot                types.Bool   `tfsdk:"source_not"`
+	SourcePort               types.String `tfsdk:"source_port"`
+	Destination              types.String `tfsdk:"destination"`
+	DestinationNot           types.Bool   `tfsdk:"destination_not"`
+	DestinationPort          types.String `tfsdk:"destination_port"`
+	Gateway                  types.String `tfsdk:"gateway"`
+	Log                      types.Bool   `tfsdk:"log"`
+	Categories               types.Set    `tfsdk:"categories"`
+	Description              types.String `tfsdk:"description"`
+	TcpFlags                 types.Set    `tfsdk:"tcp_flags"`
+	TcpFlagsOutOf            types.Set    `tfsdk:"tcp_flags_out_of"`
+	StateType                types.String `tfsdk:"state_type"`
+	StateTimeout             types.Int32  `tfsdk:"state_timeout"`
+	MaxStates                types.Int32  `tfsdk:"max_states"`
+	MaxSourceNodes           types.Int32  `tfsdk:"max_source_nodes"`
+	MaxSourceStates          types.Int32  `tfsdk:"max_source_states"`
+	MaxSourceConnections     types.Int32  `tfsdk:"max_source_connections"`
+	MaxNewConnections        types.Int32  `tfsdk:"max_new_connections"`
+	MaxNewConnectionsSeconds types.Int32  `tfsdk:"max_new_connections_seconds"`
+	IcmpTypes                types.Set    `tfsdk:"icmp_types"`
+	Icmp6Types               types.Set    `tfsdk:"icmp6_types"`
+	AllowOptions             types.Bool   `tfsdk:"allow_options"`
+	Tag                      types.String `tfsdk:"tag"`
+	Tagged                   types.String `tfsdk:"tagged"`
+	ReplyTo                  types.String `tfsdk:"reply_to"`
+	Priority                 types.Int32  `tfsdk:"priority"`
+	SetPriority              types.Int32  `tfsdk:"set_priority"`
+	SetPriorityLow           types.Int32  `tfsdk:"set_priority_low"`
+	Schedule                 types.String `tfsdk:"schedule"`
+	NoSync                   types.Bool   `tfsdk:"no_sync"`
 }
 
 // Metadata returns the data source type name.
@@ -142,6 +163,94 @@ func (d *automationFilterDataSource) Schema(ctx context.Context, req datasource.
 				Computed:    true,
 				Description: "Description to identify this rule.",
 			},
+			"tcp_flags": schema.SetAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "TCP flags that must be set for this rule to match.",
+			},
+			"tcp_flags_out_of": schema.SetAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "TCP flags that are checked for this rule to match.",
+			},
+			"state_type": schema.StringAttribute{
+				Computed:    true,
+				Description: "State tracking mechanism used by this rule.",
+			},
+			"state_timeout": schema.Int32Attribute{
+				Computed:            true,
+				MarkdownDescription: "State timeout in seconds. `-1` means the default is used.",
+			},
+			"max_states": schema.Int32Attribute{
+				Computed:            true,
+				MarkdownDescription: "Maximum number of state entries this rule can create. `-1` means no limit.",
+			},
+			"max_source_nodes": schema.Int32Attribute{
+				Computed:            true,
+				MarkdownDescription: "Maximum number of source addresses that can simultaneously have state table entries. `-1` means no limit.",
+			},
+			"max_source_states": schema.Int32Attribute{
+				Computed:            true,
+				MarkdownDescription: "Maximum number of state entries per source address. `-1` means no limit.",
+			},
+			"max_source_connections": schema.Int32Attribute{
+				Computed:            true,
+				MarkdownDescription: "Maximum number of simultaneous TCP connections a single host can make. `-1` means no limit.",
+			},
+			"max_new_connections": schema.Int32Attribute{
+				Computed:            true,
+				MarkdownDescription: "Maximum number of new connections per host within `max_new_connections_seconds`. `-1` means no limit.",
+			},
+			"max_new_connections_seconds": schema.Int32Attribute{
+				Computed:            true,
+				MarkdownDescription: "Number of seconds in which `max_new_connections` are counted. `-1` means no limit.",
+			},
+			"icmp_types": schema.SetAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "ICMP types this rule applies to.",
+			},
+			"icmp6_types": schema.SetAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "ICMPv6 types this rule applies to.",
+			},
+			"allow_options": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether packets with IP options are allowed to pass.",
+			},
+			"tag": schema.StringAttribute{
+				Computed:    true,
+				Description: "Tag set on packets matching this rule.",
+			},
+			"tagged": schema.StringAttribute{
+				Computed:    true,
+				Description: "Tag packets must be marked with to match this rule.",
+			},
+			"reply_to": schema.StringAttribute{
+				Computed:    true,
+				Description: "Gateway to reply to. An empty value uses the default behaviour.",
+			},
+			"priority": schema.Int32Attribute{
+				Computed:            true,
+				MarkdownDescription: "Priority packets must have to match this rule. `-1` means any priority.",
+			},
+			"set_priority": schema.Int32Attribute{
+				Computed:            true,
+				MarkdownDescription: "Priority assigned to packets matching this rule. `-1` means the priority is kept.",
+			},
+			"set_priority_low": schema.Int32Attribute{
+				Computed:            true,
+				MarkdownDescription: "Priority assigned to TCP ACK & low delay packets matching this rule. `-1` means `set_priority` is used.",
+			},
+			"schedule": schema.StringAttribute{
+				Computed:    true,
+				Description: "Name of the schedule during which this rule is active. An empty value means always active.",
+			},
+			"no_sync": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether this rule is excluded from XMLRPC configuration synchronisation.",
+			},
 		},
 	}
 }
@@ -222,6 +331,41 @@ func (d *automationFilterDataSource) Read(ctx context.Context, req datasource.Re
 
 	data.Description = types.StringValue(rule.Description)
 
+	tcpFlags, diags := utils.SetGoToTerraform(ctx, rule.TcpFlags)
+	resp.Diagnostics.Append(diags...)
+	data.TcpFlags = tcpFlags
+
+	tcpFlagsOutOf, diags := utils.SetGoToTerraform(ctx, rule.TcpFlagsOutOf)
+	resp.Diagnostics.Append(diags...)
+	data.TcpFlagsOutOf = tcpFlagsOutOf
+
+	data.StateType = types.StringValue(rule.StateType)
+	data.StateTimeout = types.Int32Value(rule.StateTimeout)
+	data.MaxStates = types.Int32Value(rule.MaxStates)
+	data.MaxSourceNodes = types.Int32Value(rule.MaxSourceNodes)
+	data.MaxSourceStates = types.Int32Value(rule.MaxSourceStates)
+	data.MaxSourceConnections = types.Int32Value(rule.MaxSourceConnections)
+	data.MaxNewConnections = types.Int32Value(rule.MaxNewConnections)
+	data.MaxNewConnectionsSeconds = types.Int32Value(rule.MaxNewConnectionsSeconds)
+
+	icmpTypes, diags := utils.SetGoToTerraform(ctx, rule.IcmpTypes)
+	resp.Diagnostics.Append(diags...)
+	data.IcmpTypes = icmpTypes
+
+	icmp6Types, diags := utils.SetGoToTerraform(ctx, rule.Icmp6Types)
+	resp.Diagnostics.Append(diags...)
+	data.Icmp6Types = icmp6Types
+
+	data.AllowOptions = types.BoolValue(rule.AllowOptions)
+	data.Tag = types.StringValue(rule.Tag)
+	data.Tagged = types.StringValue(rule.Tagged)
+	data.ReplyTo = types.StringValue(rule.ReplyTo)
+	data.Priority = types.Int32Value(rule.Priority)
+	data.SetPriority = types.Int32Value(rule.SetPriority)
+	data.SetPriorityLow = types.Int32Value(rule.SetPriorityLow)
+	data.Schedule = types.StringValue(rule.Schedule)
+	data.NoSync = types.BoolValue(rule.NoSync)
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -0,0 +1,449 @@
+package filter
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-opnsense/internal/opnsense"
+	"terraform-provider-opnsense/internal/opnsense/firewall"
+	"terraform-provider-opnsense/internal/opnsense/firewall/automation"
+	"terraform-provider-opnsense/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &automationFilterOrderResource{}
+	_ resource.ResourceWithConfigure   = &automationFilterOrderResource{}
+	_ resource.ResourceWithImportState = &automationFilterOrderResource{}
+	_ resource.ResourceWithModifyPlan  = &automationFilterOrderResource{}
+)
+
+// NewAutomationFilterOrderResource is a helper function to simplify the provider implementation.
+func NewAutomationFilterOrderResource() resource.Resource {
+	return &automationFilterOrderResource{}
+}
+
+// automationFilterOrderResource defines the resource implementation.
+type automationFilterOrderResource struct {
+	client *opnsense.Client
+}
+
+// automationFilterOrderResourceModel describes the resource data model.
+type automationFilterOrderResourceModel struct {
+	Id        types.String `tfsdk:"id"`
+	Interface types.String `tfsdk:"interface"`
+	Rules     types.List   `tfsdk:"rules"`
+	Start     types.Int32  `tfsdk:"start"`
+	Gap       types.Int32  `tfsdk:"gap"`
+	Sequences types.Map    `tfsdk:"sequences"`
+}
+
+// Metadata returns the resource type name.
+func (r *automationFilterOrderResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_%s_%s_%s_order", req.ProviderTypeName, firewall.TypeName, automation.AutomationController, filterController)
+}
+
+// Schema defines the schema for the resource.
+func (r *automationFilterOrderResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Orders automation filter rules by assigning their `sequence` based on their position in a list. This resource owns the `sequence` of the ordered rules, which should not set `sequence` themselves. Removing this resource leaves the sequences of the rules unchanged.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: fmt.Sprintf("Identifier of the %s. Equal to the interface when scoped, otherwise `all`.", orderResourceName),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"interface": schema.StringAttribute{
+				Optional:    true,
+				Description: "Scope the ordering to a single interface (e.g `lan`, `opt1`). All ordered rules must apply to this interface, which is verified whenever a rule is moved.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"rules": schema.ListAttribute{
+				Required:    true,
+				ElementType: types.StringType,
+				Description: "Identifiers of the automation filter rules in the order they should be evaluated (first rule first).",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+				},
+			},
+			"start": schema.Int32Attribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Sequence assigned to the first rule. Defaults to `100`.",
+				Validators:          []validator.Int32{int32validator.Between(1, int32(maxSequence))},
+				Default:             int32default.StaticInt32(100),
+			},
+			"gap": schema.Int32Attribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Difference between the sequences of consecutive rules. Leaves room to insert rules without reordering. Defaults to `100`.",
+				Validators:          []validator.Int32{int32validator.Between(1, int32(maxSequence))},
+				Default:             int32default.StaticInt32(100),
+			},
+			"sequences": schema.MapAttribute{
+				Computed:    true,
+				ElementType: types.Int32Type,
+				Description: "Sequence of each rule on OPNsense, keyed by rule identifier. Reports drift when the rules are reordered outside of Terraform.",
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *automationFilterOrderResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*opnsense.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *opnsense.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// ModifyPlan calculates the planned sequences of the rules.
+func (r *automationFilterOrderResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to calculate when the resource is destroyed
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan automationFilterOrderResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Sequences are unknown until all inputs are known
+	if plan.Rules.IsUnknown() || plan.Start.IsUnknown() || plan.Gap.IsUnknown() {
+		plan.Sequences = types.MapUnknown(types.Int32Type)
+		resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+		return
+	}
+
+	var rules []types.String
+	resp.Diagnostics.Append(plan.Rules.ElementsAs(ctx, &rules, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, rule := range rules {
+		if rule.IsUnknown() {
+			plan.Sequences = types.MapUnknown(types.Int32Type)
+			resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+			return
+		}
+	}
+
+	// Verify the last sequence does not exceed the maximum
+	last := int64(plan.Start.ValueInt32()) + int64(len(rules)-1)*int64(plan.Gap.ValueInt32())
+	if last > maxSequence {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("gap"),
+			"Invalid Attribute Combination",
+			fmt.Sprintf("The sequence of the last rule (%d) exceeds the maximum sequence of %d. Lower `start` or `gap`.", last, maxSequence),
+		)
+		return
+	}
+
+	sequences, diags := types.MapValueFrom(ctx, types.Int32Type, getAutomationFilterOrderSequences(utils.StringListTerraformToGo(rules), plan.Start.ValueInt32(), plan.Gap.ValueInt32()))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Sequences = sequences
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *automationFilterOrderResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, fmt.Sprintf("Creating %s", orderResourceName))
+
+	// Read Terraform plan data into the model
+	var plan automationFilterOrderResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set rule sequences on OPNsense
+	resp.Diagnostics.Append(r.setSequences(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update plan ID field
+	plan.Id = types.StringValue("all")
+	if !plan.Interface.IsNull() {
+		plan.Id = plan.Interface
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Successfully created %s", orderResourceName))
+}
+
+// Read resource information.
+func (r *automationFilterOrderResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, fmt.Sprintf("Reading %s", orderResourceName))
+
+	// Read Terraform prior state data into the model
+	var state automationFilterOrderResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var rules []string
+	resp.Diagnostics.Append(state.Rules.ElementsAs(ctx, &rules, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get the sequence of each rule
+	tflog.Debug(ctx, fmt.Sprintf("Getting %s information", orderResourceName), map[string]any{"rules": rules})
+
+	existing, err := getAutomationFilterRuleSequences(r.client)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Read %s error", orderResourceName), fmt.Sprintf("%s", err))
+		return
+	}
+
+	var remaining []string
+	sequences := make(map[string]int32, len(rules))
+	for _, uuid := range rules {
+		// Rules removed from OPNsense are dropped from the state, so the next plan restores the ordering
+		sequence, exists := existing[uuid]
+		if !exists {
+			tflog.Debug(ctx, fmt.Sprintf("Removing missing %s from state", resourceName), map[string]any{"uuid": uuid})
+			continue
+		}
+
+		remaining = append(remaining, uuid)
+		sequences[uuid] = sequence
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Successfully got %s information", orderResourceName), map[string]any{"success": true})
+
+	// Overwite items with refreshed state
+	rulesValue, diags := types.ListValueFrom(ctx, types.StringType, remaining)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.Rules = rulesValue
+
+	sequencesValue, diags := types.MapValueFrom(ctx, types.Int32Type, sequences)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.Sequences = sequencesValue
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Successfully read %s", orderResourceName))
+}
+
+// Update updates the resource on OPNsense and the Terraform state.
+func (r *automationFilterOrderResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, fmt.Sprintf("Updating %s", orderResourceName))
+
+	// Read Terraform plan data into the model
+	var plan automationFilterOrderResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set rule sequences on OPNsense
+	resp.Diagnostics.Append(r.setSequences(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Successfully updated %s", orderResourceName))
+}
+
+// Delete removes the resource from the Terraform state. The sequences of the rules are left unchanged on OPNsense.
+func (r *automationFilterOrderResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, fmt.Sprintf("Deleting %s", orderResourceName))
+	tflog.Info(ctx, fmt.Sprintf("Successfully deleted %s", orderResourceName))
+}
+
+// ImportState imports the resource from OPNsense and enables Terraform to begin managing the resource. The import ID is
+// either an interface (e.g `lan`) or `all`, the rules are imported in their current order using the default `start` &
+// `gap`.
+func (r *automationFilterOrderResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Info(ctx, fmt.Sprintf("Importing %s", orderResourceName))
+
+	iface := req.ID
+	if iface == "all" {
+		iface = ""
+	}
+
+	// Get the current order of the rules
+	rules, err := getAutomationFilterOrderRules(r.client, iface)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Import %s error", orderResourceName), fmt.Sprintf("%s", err))
+		return
+	}
+
+	if len(rules) == 0 {
+		resp.Diagnostics.AddError(fmt.Sprintf("Import %s error", orderResourceName), fmt.Sprintf("No %s found to import for `%s`.", resourceName, req.ID))
+		return
+	}
+
+	rulesValue, diags := types.ListValueFrom(ctx, types.StringType, rules)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state := automationFilterOrderResourceModel{
+		Id:        types.StringValue(req.ID),
+		Interface: types.StringNull(),
+		Rules:     rulesValue,
+		Start:     types.Int32Value(100),
+		Gap:       types.Int32Value(100),
+		Sequences: types.MapNull(types.Int32Type),
+	}
+	if iface != "" {
+		state.Interface = types.StringValue(iface)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Successfully imported %s", orderResourceName))
+}
+
+// setSequences assigns the planned sequences to the rules on OPNsense and applies the configuration.
+func (r *automationFilterOrderResource) setSequences(ctx context.Context, plan automationFilterOrderResourceModel) diag.Diagnostics {
+	var diagnostics diag.Diagnostics
+
+	sequences := make(map[string]int32)
+	diagnostics.Append(plan.Sequences.ElementsAs(ctx, &sequences, false)...)
+
+	var rules []string
+	diagnostics.Append(plan.Rules.ElementsAs(ctx, &rules, false)...)
+	if diagnostics.HasError() {
+		return diagnostics
+	}
+
+	current, err := getAutomationFilterRuleSequences(r.client)
+	if err != nil {
+		diagnostics.AddError(fmt.Sprintf("Set %s error", orderResourceName), fmt.Sprintf("%s", err))
+		return diagnostics
+	}
+
+	// Only update rules with a different sequence
+	changed := false
+	for _, uuid := range rules {
+		sequence, exists := current[uuid]
+		if !exists {
+			diagnostics.AddAttributeError(
+				path.Root("rules"),
+				fmt.Sprintf("Set %s error", orderResourceName),
+				fmt.Sprintf("The %s with uuid `%s` does not exist.", resourceName, uuid),
+			)
+			return diagnostics
+		}
+
+		if sequence == sequences[uuid] {
+			continue
+		}
+
+		// The interfaces are not part of the search results, the rule is only read when it is moved
+		if !plan.Interface.IsNull() {
+			rule, err := getAutomationFilterRule(r.client, uuid)
+			if err != nil {
+				diagnostics.AddError(fmt.Sprintf("Set %s error", orderResourceName), fmt.Sprintf("%s", err))
+				return diagnostics
+			}
+
+			if !rule.Interfaces.Contains(plan.Interface.ValueString()) {
+				diagnostics.AddAttributeError(
+					path.Root("rules"),
+					fmt.Sprintf("Set %s error", orderResourceName),
+					fmt.Sprintf("The %s with uuid `%s` does not apply to interface `%s`.", resourceName, uuid, plan.Interface.ValueString()),
+				)
+				return diagnostics
+			}
+		}
+
+		tflog.Debug(ctx, fmt.Sprintf("Setting %s sequence on OPNsense", resourceName), map[string]any{"uuid": uuid, "sequence": sequences[uuid]})
+
+		err = setAutomationFilterRuleSequence(r.client, uuid, sequences[uuid])
+		if err != nil {
+			diagnostics.AddError(fmt.Sprintf("Set %s error", orderResourceName), fmt.Sprintf("%s", err))
+			return diagnostics
+		}
+		changed = true
+	}
+
+	if !changed {
+		return diagnostics
+	}
+
+	// Apply configuration on OPNsense
+	tflog.Debug(ctx, "Applying configuration on OPNsense")
+
+	err = applyAutomationFilterConfig(r.client)
+	if err != nil {
+		diagnostics.AddWarning(fmt.Sprintf("Set %s error", orderResourceName), fmt.Sprintf("%s", err))
+	} else {
+		tflog.Debug(ctx, "Successfully applied configuration on OPNsense", map[string]any{"success": true})
+	}
+
+	return diagnostics
+}
@@ -0,0 +1,122 @@
+package filter_test
+
+import (
+	"regexp"
+	"terraform-provider-opnsense/internal/acctest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccAutomationFilterOrderResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccAutomationFilterOrderResourceConfig,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("opnsense_firewall_automation_filter_order.test_acc_resource_filter_order", tfjsonpath.New("id"), knownvalue.StringExact("lan")),
+					statecheck.ExpectKnownValue("opnsense_firewall_automation_filter_order.test_acc_resource_filter_order", tfjsonpath.New("start"), knownvalue.Int32Exact(100)),
+					statecheck.ExpectKnownValue("opnsense_firewall_automation_filter_order.test_acc_resource_filter_order", tfjsonpath.New("gap"), knownvalue.Int32Exact(100)),
+				},
+			},
+			// ImportState testing
+			{
+				ResourceName:            "opnsense_firewall_automation_filter_order.test_acc_resource_filter_order",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"rules", "sequences"},
+			},
+			// Update and Read testing
+			{
+				Config: testAccAutomationFilterOrderResourceConfig_modified,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("opnsense_firewall_automation_filter_order.test_acc_resource_filter_order", tfjsonpath.New("start"), knownvalue.Int32Exact(1000)),
+					statecheck.ExpectKnownValue("opnsense_firewall_automation_filter_order.test_acc_resource_filter_order", tfjsonpath.New("gap"), knownvalue.Int32Exact(10)),
+				},
+			},
+			// Refresh testing
+			{
+				RefreshState: true,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("opnsense_firewall_automation_filter.test_acc_resource_filter_first", "sequence", "1010"),
+					resource.TestCheckResourceAttr("opnsense_firewall_automation_filter.test_acc_resource_filter_second", "sequence", "1000"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAutomationFilterOrderResource_invalidConfig(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAutomationFilterOrderResourceConfig_maxSequence,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+		},
+	})
+}
+
+// testAccAutomationFilterOrderResourceConfig defines an automation filter rule order resource for two rules.
+const testAccAutomationFilterOrderResourceConfig = `
+	resource "opnsense_firewall_automation_filter" "test_acc_resource_filter_first" {
+		interfaces = ["lan"]
+		description = "first automation filter rule for terraform order testing"
+	}
+
+	resource "opnsense_firewall_automation_filter" "test_acc_resource_filter_second" {
+		interfaces = ["lan"]
+		description = "second automation filter rule for terraform order testing"
+	}
+
+	resource "opnsense_firewall_automation_filter_order" "test_acc_resource_filter_order" {
+		interface = "lan"
+		rules = [
+			opnsense_firewall_automation_filter.test_acc_resource_filter_first.id,
+			opnsense_firewall_automation_filter.test_acc_resource_filter_second.id,
+		]
+	}
+`
+
+// testAccAutomationFilterOrderResourceConfig_modified defines an automation filter rule order resource with reversed rules.
+const testAccAutomationFilterOrderResourceConfig_modified = `
+	resource "opnsense_firewall_automation_filter" "test_acc_resource_filter_first" {
+		interfaces = ["lan"]
+		description = "first automation filter rule for terraform order testing"
+	}
+
+	resource "opnsense_firewall_automation_filter" "test_acc_resource_filter_second" {
+		interfaces = ["lan"]
+		description = "second automation filter rule for terraform order testing"
+	}
+
+	resource "opnsense_firewall_automation_filter_order" "test_acc_resource_filter_order" {
+		interface = "lan"
+		start = 1000
+		gap = 10
+		rules = [
+			opnsense_firewall_automation_filter.test_acc_resource_filter_second.id,
+			opnsense_firewall_automation_filter.test_acc_resource_filter_first.id,
+		]
+	}
+`
+
+// testAccAutomationFilterOrderResourceConfig_maxSequence defines an automation filter rule order resource exceeding the maximum sequence.
+const testAccAutomationFilterOrderResourceConfig_maxSequence = `
+	resource "opnsense_firewall_automation_filter_order" "test_acc_resource_invalid" {
+		start = 999999
+		rules = [
+			"00000000-0000-0000-0000-000000000001",
+			"00000000-0000-0000-0000-000000000002",
+		]
+	}
+`
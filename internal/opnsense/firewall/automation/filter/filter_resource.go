@@ -7,12 +7,15 @@ import (
 	"strings"
 	"time"
 
+	"terraform-provider-opnsense/internal/customtypes"
 	"terraform-provider-opnsense/internal/opnsense"
 	"terraform-provider-opnsense/internal/opnsense/firewall"
 	"terraform-provider-opnsense/internal/opnsense/firewall/automation"
+	"terraform-provider-opnsense/internal/opnsense/firewall/states"
 	"terraform-provider-opnsense/internal/utils"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -20,6 +23,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
@@ -32,9 +36,11 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &automationFilterResource{}
-	_ resource.ResourceWithConfigure   = &automationFilterResource{}
-	_ resource.ResourceWithImportState = &automationFilterResource{}
+	_ resource.Resource                   = &automationFilterResource{}
+	_ resource.ResourceWithConfigure      = &automationFilterResource{}
+	_ resource.ResourceWithImportState    = &automationFilterResource{}
+	_ resource.ResourceWithUpgradeState   = &automationFilterResource{}
+	_ resource.ResourceWithValidateConfig = &automationFilterResource{}
 )
 
 // NewAutomationFilterResource is a helper function to simplify the provider implementation.
@@ -49,26 +55,50 @@ type automationFilterResource struct {
 
 // automationFilterResourceModel describes the resource data model.
 type automationFilterResourceModel struct {
-	Id              types.String `tfsdk:"id"`
-	LastUpdated     types.String `tfsdk:"last_updated"`
-	Enabled         types.Bool   `tfsdk:"enabled"`
-	Sequence        types.Int32  `tfsdk:"sequence"`
-	Action          types.String `tfsdk:"action"`
-	Quick           types.Bool   `tfsdk:"quick"`
-	Interfaces      types.Set    `tfsdk:"interfaces"`
-	Direction       types.String `tfsdk:"direction"`
-	IpVersion       types.String `tfsdk:"ip_version"`
-	Protocol        types.String `tfsdk:"protocol"`
-	Source          types.String `tfsdk:"source"`
-	SourceNot       types.Bool   `tfsdk:"source_not"`
-	SourcePort      types.String `tfsdk:"source_port"`
-	Destination     types.String `tfsdk:"destination"`
-	DestinationNot  types.Bool   `tfsdk:"destination_not"`
-	DestinationPort types.String `tfsdk:"destination_port"`
-	Gateway         types.String `tfsdk:"gateway"`
-	Log             types.Bool   `tfsdk:"log"`
-	Categories      types.Set    `tfsdk:"categories"`
-	Description     types.String `tfsdk:"description"`
+	Id                       types.String                           `tfsdk:"id"`
+	LastUpdated              types.String                           `tfsdk:"last_updated"`
+	ContentHash              types.String                           `tfsdk:"content_hash"`
+	Enabled                  types.Bool                             `tfsdk:"enabled"`
+	Sequence                 types.Int32                            `tfsdk:"sequence"`
+	Action                   types.String                           `tfsdk:"action"`
+	Quick                    types.Bool                             `tfsdk:"quick"`
+	Interfaces               types.Set                              `tfsdk:"interfaces"`
+	Direction                types.String                           `tfsdk:"direction"`
+	IpVersion                types.String                           `tfsdk:"ip_version"`
+	Protocol                 customtypes.CaseInsensitiveStringValue `tfsdk:"protocol"`
+	Source                   customtypes.NetworkValue               `tfsdk:"source"`
+	SourceNot                types.Bool                             `tfsdk:"source_not"`
+	SourcePort               customtypes.PortValue                  `tfsdk:"source_port"`
+	Destination              customtypes.NetworkValue               `tfsdk:"destination"`
+	DestinationNot           types.Bool                             `tfsdk:"destination_not"`
+	DestinationPort          customtypes.PortValue                  `tfsdk:"destination_port"`
+	Gateway                  types.String                           `tfsdk:"gateway"`
+	Log                      types.Bool                             `tfsdk:"log"`
+	Categories               types.Set                              `tfsdk:"categories"`
+	Description              types.String                           `tfsdk:"description"`
+	TcpFlags                 types.Set                              `tfsdk:"tcp_flags"`
+	TcpFlagsOutOf            types.Set                              `tfsdk:"tcp_flags_out_of"`
+	StateType                types.String                           `tfsdk:"state_type"`
+	StateTimeout             types.Int32                            `tfsdk:"state_timeout"`
+	MaxStates                types.Int32                            `tfsdk:"max_states"`
+	MaxSourceNodes           types.Int32                            `tfsdk:"max_source_nodes"`
+	MaxSourceStates          types.Int32                            `tfsdk:"max_source_states"`
+	MaxSourceConnections     types.Int32                            `tfsdk:"max_source_connections"`
+	MaxNewConnections        types.Int32                            `tfsdk:"max_new_connections"`
+	MaxNewConnectionsSeconds types.Int32                            `tfsdk:"max_new_connections_seconds"`
+	IcmpTypes                types.Set                              `tfsdk:"icmp_types"`
+	Icmp6Types               types.Set                              `tfsdk:"icmp6_types"`
+	AllowOptions             types.Bool                             `tfsdk:"allow_options"`
+	Tag                      types.String                           `tfsdk:"tag"`
+	Tagged                   types.String                           `tfsdk:"tagged"`
+	ReplyTo                  types.String                           `tfsdk:"reply_to"`
+	Priority                 types.Int32                            `tfsdk:"priority"`
+	SetPriority              types.Int32                            `tfsdk:"set_priority"`
+	SetPriorityLow           types.Int32                            `tfsdk:"set_priority_low"`
+	Schedule                 types.String                           `tfsdk:"schedule"`
+	NoSync                   types.Bool                             `tfsdk:"no_sync"`
+	SafeApply                types.Bool                             `tfsdk:"safe_apply"`
+	KillStatesOnChange       types.Bool                             `tfsdk:"kill_states_on_change"`
 }
 
 // Metadata returns the resource type name.
@@ -81,6 +111,7 @@ func (r *automationFilterResource) Schema(ctx context.Context, req resource.Sche
 	emptySet, _ := basetypes.NewSetValue(types.StringType, []attr.Value{})
 
 	resp.Schema = schema.Schema{
+		Version:     1,
 		Description: "Controls the stateful packet filter, which can be used to restrict or allow traffic from and/or to specific networks as well as influence how traffic should be forwarded.",
 
 		Attributes: map[string]schema.Attribute{
@@ -92,8 +123,13 @@ func (r *automationFilterResource) Schema(ctx context.Context, req resource.Sche
 				},
 			},
 			"last_updated": schema.StringAttribute{
+				Computed:           true,
+				Description:        fmt.Sprintf("DateTime when the %s was last updated.", resourceName),
+				DeprecationMessage: "Use `content_hash` to detect changes to this resource instead. This attribute will be removed in a future release.",
+			},
+			"content_hash": schema.StringAttribute{
 				Computed:    true,
-				Description: fmt.Sprintf("DateTime when the %s was last updated.", resourceName),
+				Description: fmt.Sprintf("Hash of the %s configuration on OPNsense. Changes whenever the configuration is modified, including changes made outside of Terraform.", resourceName),
 			},
 			"enabled": schema.BoolAttribute{
 				Optional:            true,
@@ -104,9 +140,11 @@ func (r *automationFilterResource) Schema(ctx context.Context, req resource.Sche
 			"sequence": schema.Int32Attribute{
 				Optional:            true,
 				Computed:            true,
-				MarkdownDescription: "Order in which multiple matching rules are evaluated and applied (lowest first). Defaults to `1`.",
+				MarkdownDescription: "Order in which multiple matching rules are evaluated and applied (lowest first). When not set, the rule is created with sequence `1` and its sequence is left unchanged afterwards, e.g. to be managed by `opnsense_firewall_automation_filter_order`.",
 				Validators:          []validator.Int32{int32validator.Between(1, 999999)},
-				Default:             int32default.StaticInt32(1),
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+				},
 			},
 			"action": schema.StringAttribute{
 				Optional: true,
@@ -176,8 +214,9 @@ func (r *automationFilterResource) Schema(ctx context.Context, req resource.Sche
 				Default: stringdefault.StaticString("ipv4"),
 			},
 			"protocol": schema.StringAttribute{
-				Optional: true,
-				Computed: true,
+				CustomType: customtypes.CaseInsensitiveStringType{},
+				Optional:   true,
+				Computed:   true,
 				MarkdownDescription: fmt.Sprintf(
 					"The applicable protocol for this rule. Must be one of: %s. Defaults to `any`.", strings.Join(
 						// Surround each type with backticks (`)
@@ -194,6 +233,7 @@ func (r *automationFilterResource) Schema(ctx context.Context, req resource.Sche
 				Default: stringdefault.StaticString("any"),
 			},
 			"source": schema.StringAttribute{
+				CustomType:          customtypes.NetworkType{},
 				Optional:            true,
 				Computed:            true,
 				MarkdownDescription: "Source IP or network. Can be a single network/host, alias or predefined network. For interface addresses, add `ip` to the end of the interface name (e.g `opt1ip`). Defaults to `any`",
@@ -206,12 +246,14 @@ func (r *automationFilterResource) Schema(ctx context.Context, req resource.Sche
 				Default:             booldefault.StaticBool(false),
 			},
 			"source_port": schema.StringAttribute{
+				CustomType:          customtypes.PortType{},
 				Optional:            true,
 				Computed:            true,
-				MarkdownDescription: "Source port number or well known name (`imap`, `imaps`, `http`, `https`, ...), for ranges use a dash.",
+				MarkdownDescription: "[Only for `tcp` & `udp` protocols] Source port number or well known name (`imap`, `imaps`, `http`, `https`, ...), for ranges use a dash.",
 				Default:             stringdefault.StaticString(""),
 			},
 			"destination": schema.StringAttribute{
+				CustomType:          customtypes.NetworkType{},
 				Optional:            true,
 				Computed:            true,
 				MarkdownDescription: "Destination IP or network. Can be a single network/host, alias or predefined network. For interface addresses, add `ip` to the end of the interface name (e.g `opt1ip`). Defaults to `any`",
@@ -224,9 +266,10 @@ func (r *automationFilterResource) Schema(ctx context.Context, req resource.Sche
 				Default:             booldefault.StaticBool(false),
 			},
 			"destination_port": schema.StringAttribute{
+				CustomType:          customtypes.PortType{},
 				Optional:            true,
 				Computed:            true,
-				MarkdownDescription: "Destination port number or well known name (`imap`, `imaps`, `http`, `https`, ...), for ranges use a dash.",
+				MarkdownDescription: "[Only for `tcp` & `udp` protocols] Destination port number or well known name (`imap`, `imaps`, `http`, `https`, ...), for ranges use a dash.",
 				Default:             stringdefault.StaticString(""),
 			},
 			"gateway": schema.StringAttribute{
@@ -254,10 +297,276 @@ func (r *automationFilterResource) Schema(ctx context.Context, req resource.Sche
 				Description: "Description to identify this rule.",
 				Default:     stringdefault.StaticString(""),
 			},
+			"tcp_flags": schema.SetAttribute{
+				Optional:    true,
+				Computed:    true,
+				ElementType: types.StringType,
+				MarkdownDescription: fmt.Sprintf(
+					"[Only for `tcp` protocol] TCP flags that must be set for this rule to match. Must be one of: %s.", strings.Join(
+						// Surround each type with backticks (`)
+						utils.SliceMap(getTcpFlags(), func(value string) string {
+							return fmt.Sprintf("`%s`", value)
+						}),
+						", ",
+					),
+				),
+				Validators: []validator.Set{
+					// Values must be one of the listed values
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(getTcpFlags()...)),
+				},
+				Default: setdefault.StaticValue(emptySet),
+			},
+			"tcp_flags_out_of": schema.SetAttribute{
+				Optional:    true,
+				Computed:    true,
+				ElementType: types.StringType,
+				MarkdownDescription: fmt.Sprintf(
+					"[Only for `tcp` protocol] TCP flags that are checked for this rule to match, out of which `tcp_flags` must be set. Must be one of: %s.", strings.Join(
+						// Surround each type with backticks (`)
+						utils.SliceMap(getTcpFlags(), func(value string) string {
+							return fmt.Sprintf("`%s`", value)
+						}),
+						", ",
+					),
+				),
+				Validators: []validator.Set{
+					// Values must be one of the listed values
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(getTcpFlags()...)),
+				},
+				Default: setdefault.StaticValue(emptySet),
+			},
+			"state_type": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				MarkdownDescription: fmt.Sprintf(
+					"State tracking mechanism to use. Must be one of: %s. Defaults to `keep`", strings.Join(
+						// Surround each type with backticks (`)
+						utils.SliceMap(getStateTypes(), func(value string) string {
+							return fmt.Sprintf("`%s`", value)
+						}),
+						", ",
+					),
+				),
+				Validators: []validator.String{
+					// Type must be one of the listed values
+					stringvalidator.OneOf(getStateTypes()...),
+				},
+				Default: stringdefault.StaticString("keep"),
+			},
+			"state_timeout": schema.Int32Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "State timeout in seconds (TCP only), leave empty for defaults.",
+				Validators:  []validator.Int32{int32validator.AtLeast(1)},
+				Default:     int32default.StaticInt32(-1),
+			},
+			"max_states": schema.Int32Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Maximum number of state entries this rule can create, leave empty for no limit.",
+				Validators:  []validator.Int32{int32validator.AtLeast(1)},
+				Default:     int32default.StaticInt32(-1),
+			},
+			"max_source_nodes": schema.Int32Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Maximum number of source addresses that can simultaneously have state table entries, leave empty for no limit.",
+				Validators:  []validator.Int32{int32validator.AtLeast(1)},
+				Default:     int32default.StaticInt32(-1),
+			},
+			"max_source_states": schema.Int32Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Maximum number of state entries per source address, leave empty for no limit.",
+				Validators:  []validator.Int32{int32validator.AtLeast(1)},
+				Default:     int32default.StaticInt32(-1),
+			},
+			"max_source_connections": schema.Int32Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Maximum number of simultaneous TCP connections which have completed the 3-way handshake a single host can make, leave empty for no limit.",
+				Validators:  []validator.Int32{int32validator.AtLeast(1)},
+				Default:     int32default.StaticInt32(-1),
+			},
+			"max_new_connections": schema.Int32Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Maximum number of new connections per host within `max_new_connections_seconds` (TCP only), leave empty for no limit.",
+				Validators:  []validator.Int32{int32validator.AtLeast(1)},
+				Default:     int32default.StaticInt32(-1),
+			},
+			"max_new_connections_seconds": schema.Int32Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Number of seconds in which `max_new_connections` are counted (TCP only), leave empty for no limit.",
+				Validators:  []validator.Int32{int32validator.AtLeast(1)},
+				Default:     int32default.StaticInt32(-1),
+			},
+			"icmp_types": schema.SetAttribute{
+				Optional:    true,
+				Computed:    true,
+				ElementType: types.StringType,
+				MarkdownDescription: fmt.Sprintf(
+					"[Only for `icmp` protocol] ICMP types this rule applies to, leave empty for all types. Must be one of: %s.", strings.Join(
+						// Surround each type with backticks (`)
+						utils.SliceMap(getIcmpTypes(), func(value string) string {
+							return fmt.Sprintf("`%s`", value)
+						}),
+						", ",
+					),
+				),
+				Validators: []validator.Set{
+					// Values must be one of the listed values
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(getIcmpTypes()...)),
+				},
+				Default: setdefault.StaticValue(emptySet),
+			},
+			"icmp6_types": schema.SetAttribute{
+				Optional:    true,
+				Computed:    true,
+				ElementType: types.StringType,
+				MarkdownDescription: fmt.Sprintf(
+					"[Only for `ipv6-icmp` protocol] ICMPv6 types this rule applies to, leave empty for all types. Must be one of: %s.", strings.Join(
+						// Surround each type with backticks (`)
+						utils.SliceMap(getIcmp6Types(), func(value string) string {
+							return fmt.Sprintf("`%s`", value)
+						}),
+						", ",
+					),
+				),
+				Validators: []validator.Set{
+					// Values must be one of the listed values
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(getIcmp6Types()...)),
+				},
+				Default: setdefault.StaticValue(emptySet),
+			},
+			"allow_options": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Whether packets with IP options are allowed to pass. Defaults to `false`.",
+				Default:             booldefault.StaticBool(false),
+			},
+			"tag": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Mark packets matching this rule with a tag, which can be used to match on in other rules.",
+				Default:     stringdefault.StaticString(""),
+			},
+			"tagged": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Only match packets marked with this tag by another rule.",
+				Default:     stringdefault.StaticString(""),
+			},
+			"reply_to": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Gateway to reply to, overriding the gateway of the interface. Leave empty for the default behaviour.",
+				Default:     stringdefault.StaticString(""),
+			},
+			"priority": schema.Int32Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "[Only for `pass` action] Only match packets with this priority (0-7), leave empty to match any priority.",
+				Validators:  []validator.Int32{int32validator.Between(0, 7)},
+				Default:     int32default.StaticInt32(-1),
+			},
+			"set_priority": schema.Int32Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "[Only for `pass` action] Priority (0-7) assigned to packets matching this rule, leave empty to keep the priority.",
+				Validators:  []validator.Int32{int32validator.Between(0, 7)},
+				Default:     int32default.StaticInt32(-1),
+			},
+			"set_priority_low": schema.Int32Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "[Only for `pass` action] Priority (0-7) assigned to TCP ACK packets or packets with a low delay type of service (ToS) matching this rule, leave empty to use `set_priority`.",
+				Validators:  []validator.Int32{int32validator.Between(0, 7)},
+				Default:     int32default.StaticInt32(-1),
+			},
+			"schedule": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Name of the schedule during which this rule is active. Leave empty to always be active.",
+				Default:     stringdefault.StaticString(""),
+			},
+			"no_sync": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Whether to exclude this rule from XMLRPC configuration synchronisation to a HA peer. Defaults to `false`.",
+				Default:             booldefault.StaticBool(false),
+			},
+			"safe_apply": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Whether to apply changes with automatic rollback. When enabled, a savepoint is created before the rule is changed and the rollback is only cancelled once the OPNsense API is reachable again. If the provider loses access to OPNsense, the firewall reverts to the savepoint by itself after its rollback timeout. Defaults to `false`.",
+				Default:             booldefault.StaticBool(false),
+			},
+			"kill_states_on_change": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Whether to remove the existing firewall states created by this rule after the rule was changed. Without this, connections established before the change keep matching the old rule until their states expire. Defaults to `false`.",
+				Default:             booldefault.StaticBool(false),
+			},
 		},
 	}
 }
 
+// ValidateConfig validates the resource configuration for cross-attribute constraints.
+func (r *automationFilterResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config automationFilterResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Skip validation if the protocol is not yet known
+	if config.Protocol.IsUnknown() {
+		return
+	}
+
+	// Protocol defaults to `any` when not configured
+	protocol := "any"
+	if !config.Protocol.IsNull() {
+		protocol = config.Protocol.ValueString()
+	}
+
+	// Ports are only applicable to the TCP & UDP protocols
+	if !firewall.ProtocolSupportsPorts(protocol) {
+		ports := map[string]customtypes.PortValue{
+			"source_port":      config.SourcePort,
+			"destination_port": config.DestinationPort,
+		}
+
+		for name, value := range ports {
+			if !value.IsNull() && !value.IsUnknown() && value.ValueString() != "" {
+				resp.Diagnostics.AddAttributeError(path.Root(name), "Invalid Attribute Combination", fmt.Sprintf("The `%s` attribute is only applicable when `protocol` is set to `tcp` or `udp`, got: `%s`.", name, protocol))
+			}
+		}
+	}
+
+	// TCP flags & ICMP types are only applicable to their protocol
+	protocolOptions := map[string]struct {
+		protocol string
+		value    types.Set
+	}{
+		"tcp_flags":        {"tcp", config.TcpFlags},
+		"tcp_flags_out_of": {"tcp", config.TcpFlagsOutOf},
+		"icmp_types":       {"icmp", config.IcmpTypes},
+		"icmp6_types":      {"ipv6-icmp", config.Icmp6Types},
+	}
+
+	for name, option := range protocolOptions {
+		if strings.EqualFold(protocol, option.protocol) || option.value.IsNull() || option.value.IsUnknown() {
+			continue
+		}
+		if len(option.value.Elements()) > 0 {
+			resp.Diagnostics.AddAttributeError(path.Root(name), "Invalid Attribute Combination", fmt.Sprintf("The `%s` attribute is only applicable when `protocol` is set to `%s`, got: `%s`.", name, option.protocol, protocol))
+		}
+	}
+}
+
 // Configure adds the provider configured client to the resource.
 func (r *automationFilterResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Add a nil check when handling ProviderData because Terraform
@@ -289,6 +598,11 @@ func (r *automationFilterResource) Create(ctx context.Context, req resource.Crea
 		return
 	}
 
+	// Rules without a configured sequence are created with the lowest sequence
+	if plan.Sequence.IsUnknown() {
+		plan.Sequence = types.Int32Value(1)
+	}
+
 	// Create automation filter rule object
 	automationFilter, diags := createAutomationFilter(ctx, r.client, plan)
 	resp.Diagnostics.Append(diags...)
@@ -296,6 +610,21 @@ func (r *automationFilterResource) Create(ctx context.Context, req resource.Crea
 		return
 	}
 
+	// Create savepoint before modifying the filter configuration, so a failed apply can roll back the change
+	var revision string
+	var err error
+	if plan.SafeApply.ValueBool() {
+		tflog.Debug(ctx, "Creating savepoint on OPNsense")
+
+		revision, err = createAutomationFilterSavepoint(r.client)
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Create %s entry error", resourceName), fmt.Sprintf("%s", err))
+			return
+		}
+
+		tflog.Debug(ctx, "Successfully created savepoint on OPNsense", map[string]any{"revision": revision})
+	}
+
 	// Create automation filter rule on OPNsense
 	tflog.Debug(ctx, fmt.Sprintf("Creating %s on OPNsense", resourceName), map[string]any{fmt.Sprintf("%s", resourceName): automationFilter})
 
@@ -308,15 +637,32 @@ func (r *automationFilterResource) Create(ctx context.Context, req resource.Crea
 	}
 
 	// Apply configuration on OPNsense
-	tflog.Debug(ctx, "Applying configuration on OPNsense")
-
-	err = applyAutomationFilterConfig(r.client)
-	if err != nil {
-		resp.Diagnostics.AddWarning(fmt.Sprintf("Create %s entry error", resourceName), fmt.Sprintf("%s", err))
+	tflog.Debug(ctx, "Applying configuration on OPNsense", map[string]any{"safe_apply": plan.SafeApply.ValueBool()})
+
+	if plan.SafeApply.ValueBool() {
+		err = safeApplyAutomationFilterConfig(r.client, revision)
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Create %s entry error", resourceName), fmt.Sprintf("%s", err))
+
+			// Save the ID so the rule is tainted rather than orphaned, in case it is not rolled back
+			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), uuid)...)
+			return
+		}
+		tflog.Debug(ctx, "Successfully applied configuration on OPNsense with automatic rollback", map[string]any{"success": true})
 	} else {
-		tflog.Debug(ctx, "Successfully applied configuration on OPNsense", map[string]any{"success": true})
+		err = applyAutomationFilterConfig(r.client)
+		if err != nil {
+			resp.Diagnostics.AddWarning(fmt.Sprintf("Create %s entry error", resourceName), fmt.Sprintf("%s", err))
+		} else {
+			tflog.Debug(ctx, "Successfully applied configuration on OPNsense", map[string]any{"success": true})
+		}
 	}
 
+	// Get content hash from OPNsense
+	contentHash, err := getAutomationFilterRuleContentHash(r.client, uuid)
+	plan.ContentHash, diags = utils.ContentHashValue(fmt.Sprintf("Create %s error", resourceName), contentHash, err)
+	resp.Diagnostics.Append(diags...)
+
 	// Update plan ID & last_updated fields
 	plan.Id = types.StringValue(uuid)
 	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
@@ -357,6 +703,11 @@ func (r *automationFilterResource) Read(ctx context.Context, req resource.ReadRe
 	tflog.Debug(ctx, fmt.Sprintf("Successfully got %s information", resourceName), map[string]any{"success": true})
 
 	// Overwite items with refreshed state
+	contentHash, err := utils.ContentHash(rule)
+	contentHashValue, diags := utils.ContentHashValue(fmt.Sprintf("Read %s error", resourceName), contentHash, err)
+	resp.Diagnostics.Append(diags...)
+	state.ContentHash = contentHashValue
+
 	state.Enabled = types.BoolValue(rule.Enabled)
 	state.Sequence = types.Int32Value(rule.Sequence)
 	state.Action = types.StringValue(rule.Action)
@@ -368,13 +719,13 @@ func (r *automationFilterResource) Read(ctx context.Context, req resource.ReadRe
 
 	state.Direction = types.StringValue(rule.Direction)
 	state.IpVersion = types.StringValue(rule.IpVersion)
-	state.Protocol = types.StringValue(rule.Protocol)
-	state.Source = types.StringValue(rule.Source)
+	state.Protocol = customtypes.NewCaseInsensitiveStringValue(rule.Protocol)
+	state.Source = customtypes.NewNetworkValue(rule.Source)
 	state.SourceNot = types.BoolValue(rule.SourceNot)
-	state.SourcePort = types.StringValue(rule.SourcePort)
-	state.Destination = types.StringValue(rule.Destination)
+	state.SourcePort = customtypes.NewPortValue(rule.SourcePort)
+	state.Destination = customtypes.NewNetworkValue(rule.Destination)
 	state.DestinationNot = types.BoolValue(rule.DestinationNot)
-	state.DestinationPort = types.StringValue(rule.DestinationPort)
+	state.DestinationPort = customtypes.NewPortValue(rule.DestinationPort)
 	state.Gateway = types.StringValue(rule.Gateway)
 	state.Log = types.BoolValue(rule.Log)
 
@@ -384,6 +735,51 @@ func (r *automationFilterResource) Read(ctx context.Context, req resource.ReadRe
 
 	state.Description = types.StringValue(rule.Description)
 
+	tcpFlags, diags := utils.SetGoToTerraform(ctx, rule.TcpFlags)
+	resp.Diagnostics.Append(diags...)
+	state.TcpFlags = tcpFlags
+
+	tcpFlagsOutOf, diags := utils.SetGoToTerraform(ctx, rule.TcpFlagsOutOf)
+	resp.Diagnostics.Append(diags...)
+	state.TcpFlagsOutOf = tcpFlagsOutOf
+
+	state.StateType = types.StringValue(rule.StateType)
+	state.StateTimeout = types.Int32Value(rule.StateTimeout)
+	state.MaxStates = types.Int32Value(rule.MaxStates)
+	state.MaxSourceNodes = types.Int32Value(rule.MaxSourceNodes)
+	state.MaxSourceStates = types.Int32Value(rule.MaxSourceStates)
+	state.MaxSourceConnections = types.Int32Value(rule.MaxSourceConnections)
+	state.MaxNewConnections = types.Int32Value(rule.MaxNewConnections)
+	state.MaxNewConnectionsSeconds = types.Int32Value(rule.MaxNewConnectionsSeconds)
+
+	icmpTypes, diags := utils.SetGoToTerraform(ctx, rule.IcmpTypes)
+	resp.Diagnostics.Append(diags...)
+	state.IcmpTypes = icmpTypes
+
+	icmp6Types, diags := utils.SetGoToTerraform(ctx, rule.Icmp6Types)
+	resp.Diagnostics.Append(diags...)
+	state.Icmp6Types = icmp6Types
+
+	state.AllowOptions = types.BoolValue(rule.AllowOptions)
+	state.Tag = types.StringValue(rule.Tag)
+	state.Tagged = types.StringValue(rule.Tagged)
+	state.ReplyTo = types.StringValue(rule.ReplyTo)
+	state.Priority = types.Int32Value(rule.Priority)
+	state.SetPriority = types.Int32Value(rule.SetPriority)
+	state.SetPriorityLow = types.Int32Value(rule.SetPriorityLow)
+	state.Schedule = types.StringValue(rule.Schedule)
+	state.NoSync = types.BoolValue(rule.NoSync)
+
+	// safe_apply is a provider-side setting, default it when absent (e.g. after import)
+	if state.SafeApply.IsNull() {
+		state.SafeApply = types.BoolValue(false)
+	}
+
+	// kill_states_on_change is a provider-side setting, default it when absent (e.g. after import)
+	if state.KillStatesOnChange.IsNull() {
+		state.KillStatesOnChange = types.BoolValue(false)
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -422,10 +818,35 @@ func (r *automationFilterResource) Update(ctx context.Context, req resource.Upda
 		return
 	}
 
+	// Leave the sequence unchanged when it is not configured, e.g. when it is managed by an order resource
+	var sequence types.Int32
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("sequence"), &sequence)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if sequence.IsNull() {
+		rule.Sequence = 0
+	}
+
+	// Create savepoint before modifying the filter configuration, so a failed apply can roll back the change
+	var revision string
+	var err error
+	if plan.SafeApply.ValueBool() {
+		tflog.Debug(ctx, "Creating savepoint on OPNsense")
+
+		revision, err = createAutomationFilterSavepoint(r.client)
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Update %s error", resourceName), fmt.Sprintf("%s", err))
+			return
+		}
+
+		tflog.Debug(ctx, "Successfully created savepoint on OPNsense", map[string]any{"revision": revision})
+	}
+
 	// Update automation filter rule on OPNsense
 	tflog.Debug(ctx, fmt.Sprintf("Updating %s on OPNsense", resourceName), map[string]any{fmt.Sprintf("%s", resourceName): rule})
 
-	err := setAutomationFilterRule(r.client, rule, state.Id.ValueString())
+	err = setAutomationFilterRule(r.client, rule, state.Id.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(fmt.Sprintf("Update %s error", resourceName), fmt.Sprintf("%s", err))
 	}
@@ -434,15 +855,41 @@ func (r *automationFilterResource) Update(ctx context.Context, req resource.Upda
 	}
 
 	// Apply configuration on OPNsense
-	tflog.Debug(ctx, "Applying configuration on OPNsense")
-
-	err = applyAutomationFilterConfig(r.client)
-	if err != nil {
-		resp.Diagnostics.AddWarning(fmt.Sprintf("Update %s error", resourceName), fmt.Sprintf("%s", err))
+	tflog.Debug(ctx, "Applying configuration on OPNsense", map[string]any{"safe_apply": plan.SafeApply.ValueBool()})
+
+	if plan.SafeApply.ValueBool() {
+		err = safeApplyAutomationFilterConfig(r.client, revision)
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Update %s error", resourceName), fmt.Sprintf("%s", err))
+			return
+		}
+		tflog.Debug(ctx, "Successfully applied configuration on OPNsense with automatic rollback", map[string]any{"success": true})
 	} else {
-		tflog.Debug(ctx, "Successfully applied configuration on OPNsense", map[string]any{"success": true})
+		err = applyAutomationFilterConfig(r.client)
+		if err != nil {
+			resp.Diagnostics.AddWarning(fmt.Sprintf("Update %s error", resourceName), fmt.Sprintf("%s", err))
+		} else {
+			tflog.Debug(ctx, "Successfully applied configuration on OPNsense", map[string]any{"success": true})
+		}
 	}
 
+	// Kill states created by the rule on OPNsense
+	if plan.KillStatesOnChange.ValueBool() && automationFilterRuleChanged(plan, state) {
+		tflog.Debug(ctx, fmt.Sprintf("Killing states of %s on OPNsense", resourceName), map[string]any{"label": state.Id.ValueString()})
+
+		killed, err := states.KillRuleStates(r.client, state.Id.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddWarning(fmt.Sprintf("Update %s error", resourceName), fmt.Sprintf("Unable to kill states: %s", err))
+		} else {
+			tflog.Debug(ctx, fmt.Sprintf("Successfully killed states of %s on OPNsense", resourceName), map[string]any{"killed": killed})
+		}
+	}
+
+	// Get content hash from OPNsense
+	contentHash, err := getAutomationFilterRuleContentHash(r.client, state.Id.ValueString())
+	plan.ContentHash, diags = utils.ContentHashValue(fmt.Sprintf("Update %s error", resourceName), contentHash, err)
+	resp.Diagnostics.Append(diags...)
+
 	// Update last_updated field (if change detected)
 	if !(reflect.DeepEqual(plan, state)) {
 		plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
@@ -468,10 +915,25 @@ func (r *automationFilterResource) Delete(ctx context.Context, req resource.Dele
 		return
 	}
 
+	// Create savepoint before modifying the filter configuration, so a failed apply can roll back the change
+	var revision string
+	var err error
+	if state.SafeApply.ValueBool() {
+		tflog.Debug(ctx, "Creating savepoint on OPNsense")
+
+		revision, err = createAutomationFilterSavepoint(r.client)
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Delete %s error", resourceName), fmt.Sprintf("%s", err))
+			return
+		}
+
+		tflog.Debug(ctx, "Successfully created savepoint on OPNsense", map[string]any{"revision": revision})
+	}
+
 	// Delete automation filter rule on OPNsense
 	tflog.Debug(ctx, fmt.Sprintf("Deleting %s on OPNsense", resourceName), map[string]any{"uuid": state.Id.ValueString()})
 
-	err := deleteAutomationFilterRule(r.client, state.Id.ValueString())
+	err = deleteAutomationFilterRule(r.client, state.Id.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(fmt.Sprintf("Delete %s error", resourceName), fmt.Sprintf("%s", err))
 	}
@@ -480,13 +942,23 @@ func (r *automationFilterResource) Delete(ctx context.Context, req resource.Dele
 	}
 
 	// Apply configuration on OPNsense
-	tflog.Debug(ctx, "Applying configuration on OPNsense")
-
-	err = applyAutomationFilterConfig(r.client)
-	if err != nil {
-		resp.Diagnostics.AddWarning(fmt.Sprintf("Delete %s error", resourceName), fmt.Sprintf("%s", err))
+	tflog.Debug(ctx, "Applying configuration on OPNsense", map[string]any{"safe_apply": state.SafeApply.ValueBool()})
+
+	if state.SafeApply.ValueBool() {
+		// The rule is kept in state on failure, as rolling back to the savepoint restores it
+		err = safeApplyAutomationFilterConfig(r.client, revision)
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Delete %s error", resourceName), fmt.Sprintf("%s", err))
+			return
+		}
+		tflog.Debug(ctx, "Successfully applied configuration on OPNsense with automatic rollback", map[string]any{"success": true})
 	} else {
-		tflog.Debug(ctx, "Successfully applied configuration on OPNsense", map[string]any{"success": true})
+		err = applyAutomationFilterConfig(r.client)
+		if err != nil {
+			resp.Diagnostics.AddWarning(fmt.Sprintf("Delete %s error", resourceName), fmt.Sprintf("%s", err))
+		} else {
+			tflog.Debug(ctx, "Successfully applied configuration on OPNsense", map[string]any{"success": true})
+		}
 	}
 
 	if resp.Diagnostics.HasError() {
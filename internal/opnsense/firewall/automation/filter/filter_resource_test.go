@@ -1,6 +1,7 @@
 package filter_test
 
 import (
+	"regexp"
 	"terraform-provider-opnsense/internal/acctest"
 	"testing"
 
@@ -148,6 +149,8 @@ func TestAccAutomationFilterResource_default(t *testing.T) {
 					statecheck.ExpectKnownValue("opnsense_firewall_automation_filter.test_acc_resource_filter", tfjsonpath.New("log"), knownvalue.Bool(false)),
 					statecheck.ExpectKnownValue("opnsense_firewall_automation_filter.test_acc_resource_filter", tfjsonpath.New("categories"), knownvalue.ListExact([]knownvalue.Check{})),
 					statecheck.ExpectKnownValue("opnsense_firewall_automation_filter.test_acc_resource_filter", tfjsonpath.New("description"), knownvalue.StringExact("")),
+					statecheck.ExpectKnownValue("opnsense_firewall_automation_filter.test_acc_resource_filter", tfjsonpath.New("safe_apply"), knownvalue.Bool(false)),
+					statecheck.ExpectKnownValue("opnsense_firewall_automation_filter.test_acc_resource_filter", tfjsonpath.New("kill_states_on_change"), knownvalue.Bool(false)),
 				},
 			},
 			// ImportState testing
@@ -161,6 +164,123 @@ func TestAccAutomationFilterResource_default(t *testing.T) {
 	})
 }
 
+func TestAccAutomationFilterResource_safeApply(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccAutomationFilterResourceConfig_safeApply,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("opnsense_firewall_automation_filter.test_acc_resource_filter", tfjsonpath.New("description"), knownvalue.StringExact("automation filter rule for terraform safe apply testing")),
+					statecheck.ExpectKnownValue("opnsense_firewall_automation_filter.test_acc_resource_filter", tfjsonpath.New("safe_apply"), knownvalue.Bool(true)),
+				},
+			},
+			// ImportState testing
+			{
+				ResourceName:            "opnsense_firewall_automation_filter.test_acc_resource_filter",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated", "safe_apply"},
+			},
+		},
+	})
+}
+
+func TestAccAutomationFilterResource_killStatesOnChange(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccAutomationFilterResourceConfig_killStatesOnChange,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("opnsense_firewall_automation_filter.test_acc_resource_filter", tfjsonpath.New("action"), knownvalue.StringExact("pass")),
+					statecheck.ExpectKnownValue("opnsense_firewall_automation_filter.test_acc_resource_filter", tfjsonpath.New("kill_states_on_change"), knownvalue.Bool(true)),
+				},
+			},
+			// Update and Read testing
+			{
+				Config: testAccAutomationFilterResourceConfig_killStatesOnChangeUpdate,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("opnsense_firewall_automation_filter.test_acc_resource_filter", tfjsonpath.New("action"), knownvalue.StringExact("block")),
+					statecheck.ExpectKnownValue("opnsense_firewall_automation_filter.test_acc_resource_filter", tfjsonpath.New("kill_states_on_change"), knownvalue.Bool(true)),
+				},
+			},
+			// ImportState testing
+			{
+				ResourceName:            "opnsense_firewall_automation_filter.test_acc_resource_filter",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated", "kill_states_on_change"},
+			},
+		},
+	})
+}
+
+func TestAccAutomationFilterResource_advanced(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccAutomationFilterResourceConfig_advanced,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("opnsense_firewall_automation_filter.test_acc_resource_filter", tfjsonpath.New("state_type"), knownvalue.StringExact("sloppy")),
+					statecheck.ExpectKnownValue("opnsense_firewall_automation_filter.test_acc_resource_filter", tfjsonpath.New("state_timeout"), knownvalue.Int32Exact(3600)),
+					statecheck.ExpectKnownValue("opnsense_firewall_automation_filter.test_acc_resource_filter", tfjsonpath.New("max_states"), knownvalue.Int32Exact(1000)),
+					statecheck.ExpectKnownValue("opnsense_firewall_automation_filter.test_acc_resource_filter", tfjsonpath.New("max_source_nodes"), knownvalue.Int32Exact(100)),
+					statecheck.ExpectKnownValue("opnsense_firewall_automation_filter.test_acc_resource_filter", tfjsonpath.New("max_source_states"), knownvalue.Int32Exact(10)),
+					statecheck.ExpectKnownValue("opnsense_firewall_automation_filter.test_acc_resource_filter", tfjsonpath.New("max_source_connections"), knownvalue.Int32Exact(20)),
+					statecheck.ExpectKnownValue("opnsense_firewall_automation_filter.test_acc_resource_filter", tfjsonpath.New("max_new_connections"), knownvalue.Int32Exact(15)),
+					statecheck.ExpectKnownValue("opnsense_firewall_automation_filter.test_acc_resource_filter", tfjsonpath.New("max_new_connections_seconds"), knownvalue.Int32Exact(5)),
+					statecheck.ExpectKnownValue("opnsense_firewall_automation_filter.test_acc_resource_filter", tfjsonpath.New("tcp_flags"), knownvalue.ListExact([]knownvalue.Check{
+						knownvalue.StringExact("syn"),
+					})),
+					statecheck.ExpectKnownValue("opnsense_firewall_automation_filter.test_acc_resource_filter", tfjsonpath.New("tcp_flags_out_of"), knownvalue.ListExact([]knownvalue.Check{
+						knownvalue.StringExact("ack"),
+						knownvalue.StringExact("syn"),
+					})),
+					statecheck.ExpectKnownValue("opnsense_firewall_automation_filter.test_acc_resource_filter", tfjsonpath.New("allow_options"), knownvalue.Bool(true)),
+					statecheck.ExpectKnownValue("opnsense_firewall_automation_filter.test_acc_resource_filter", tfjsonpath.New("tag"), knownvalue.StringExact("test_acc_tag")),
+					statecheck.ExpectKnownValue("opnsense_firewall_automation_filter.test_acc_resource_filter", tfjsonpath.New("tagged"), knownvalue.StringExact("test_acc_tagged")),
+					statecheck.ExpectKnownValue("opnsense_firewall_automation_filter.test_acc_resource_filter", tfjsonpath.New("set_priority"), knownvalue.Int32Exact(5)),
+					statecheck.ExpectKnownValue("opnsense_firewall_automation_filter.test_acc_resource_filter", tfjsonpath.New("no_sync"), knownvalue.Bool(true)),
+				},
+			},
+			// ImportState testing
+			{
+				ResourceName:            "opnsense_firewall_automation_filter.test_acc_resource_filter",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+		},
+	})
+}
+
+func TestAccAutomationFilterResource_invalidConfig(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAutomationFilterResourceConfig_port_protocol,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+			{
+				Config:      testAccAutomationFilterResourceConfig_tcpFlags_protocol,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+		},
+	})
+}
+
 // testAccAutomationFilterResourceConfig defines an automation filter rule resource.
 const testAccAutomationFilterResourceConfig = `
 	resource "opnsense_firewall_automation_filter" "test_acc_resource_filter" {
@@ -248,3 +368,71 @@ const testAccAutomationFilterResourceConfig_defaults = `
 	resource "opnsense_firewall_automation_filter" "test_acc_resource_filter" {
 	}
 `
+
+// testAccAutomationFilterResourceConfig_safeApply defines an automation filter rule resource applied with automatic rollback.
+const testAccAutomationFilterResourceConfig_safeApply = `
+	resource "opnsense_firewall_automation_filter" "test_acc_resource_filter" {
+		action = "block"
+		description = "automation filter rule for terraform safe apply testing"
+		safe_apply = true
+	}
+`
+
+// testAccAutomationFilterResourceConfig_killStatesOnChange defines an automation filter rule resource killing its states on change.
+const testAccAutomationFilterResourceConfig_killStatesOnChange = `
+	resource "opnsense_firewall_automation_filter" "test_acc_resource_filter" {
+		action = "pass"
+		destination = "198.51.100.0/24"
+		description = "automation filter rule for terraform kill states testing"
+		kill_states_on_change = true
+	}
+`
+
+// testAccAutomationFilterResourceConfig_killStatesOnChangeUpdate changes the action of the automation filter rule resource killing its states on change.
+const testAccAutomationFilterResourceConfig_killStatesOnChangeUpdate = `
+	resource "opnsense_firewall_automation_filter" "test_acc_resource_filter" {
+		action = "block"
+		destination = "198.51.100.0/24"
+		description = "automation filter rule for terraform kill states testing"
+		kill_states_on_change = true
+	}
+`
+
+// testAccAutomationFilterResourceConfig_advanced defines an automation filter rule resource with advanced options.
+const testAccAutomationFilterResourceConfig_advanced = `
+	resource "opnsense_firewall_automation_filter" "test_acc_resource_filter" {
+		protocol = "tcp"
+		description = "automation filter rule for terraform advanced options testing"
+		tcp_flags = ["syn"]
+		tcp_flags_out_of = ["syn", "ack"]
+		state_type = "sloppy"
+		state_timeout = 3600
+		max_states = 1000
+		max_source_nodes = 100
+		max_source_states = 10
+		max_source_connections = 20
+		max_new_connections = 15
+		max_new_connections_seconds = 5
+		allow_options = true
+		tag = "test_acc_tag"
+		tagged = "test_acc_tagged"
+		set_priority = 5
+		no_sync = true
+	}
+`
+
+// testAccAutomationFilterResourceConfig_tcpFlags_protocol defines an automation filter resource with TCP flags on a protocol other than TCP.
+const testAccAutomationFilterResourceConfig_tcpFlags_protocol = `
+	resource "opnsense_firewall_automation_filter" "test_acc_resource_invalid" {
+		protocol = "udp"
+		tcp_flags = ["syn"]
+	}
+`
+
+// testAccAutomationFilterResourceConfig_port_protocol defines an automation filter resource with a source port on a protocol without ports.
+const testAccAutomationFilterResourceConfig_port_protocol = `
+	resource "opnsense_firewall_automation_filter" "test_acc_resource_invalid" {
+		protocol = "icmp"
+		source_port = "55"
+	}
+`
@@ -0,0 +1,99 @@
+package filter
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-opnsense/internal/customtypes"
+	"terraform-provider-opnsense/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// automationFilterResourceModelV0 describes the resource data model of schema version 0.
+type automationFilterResourceModelV0 struct {
+	Id              *string  `json:"id"`
+	LastUpdated     *string  `json:"last_updated"`
+	Enabled         *bool    `json:"enabled"`
+	Sequence        *int32   `json:"sequence"`
+	Action          *string  `json:"action"`
+	Quick           *bool    `json:"quick"`
+	Interfaces      []string `json:"interfaces"`
+	Direction       *string  `json:"direction"`
+	IpVersion       *string  `json:"ip_version"`
+	Protocol        *string  `json:"protocol"`
+	Source          *string  `json:"source"`
+	SourceNot       *bool    `json:"source_not"`
+	SourcePort      *string  `json:"source_port"`
+	Destination     *string  `json:"destination"`
+	DestinationNot  *bool    `json:"destination_not"`
+	DestinationPort *string  `json:"destination_port"`
+	Gateway         *string  `json:"gateway"`
+	Log             *bool    `json:"log"`
+	Categories      []string `json:"categories"`
+	Description     *string  `json:"description"`
+}
+
+// UpgradeState upgrades the resource state from prior schema versions to the current schema version.
+func (r *automationFilterResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {StateUpgrader: upgradeAutomationFilterStateV0},
+	}
+}
+
+// upgradeAutomationFilterStateV0 upgrades the resource state from schema version 0.
+func upgradeAutomationFilterStateV0(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	tflog.Info(ctx, fmt.Sprintf("Upgrading %s state from version 0", resourceName))
+
+	var priorState automationFilterResourceModelV0
+	err := utils.DecodeRawState(req, &priorState)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Upgrade %s state error", resourceName), fmt.Sprintf("%s", err))
+		return
+	}
+
+	interfaces, diags := utils.StringSetOrDefault(ctx, priorState.Interfaces, []string{})
+	resp.Diagnostics.Append(diags...)
+
+	categories, diags := utils.StringSetOrDefault(ctx, priorState.Categories, []string{})
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state := automationFilterResourceModel{
+		Id:                 utils.StringOrNull(priorState.Id),
+		LastUpdated:        utils.StringOrNull(priorState.LastUpdated),
+		Enabled:            utils.BoolOrDefault(priorState.Enabled, true),
+		Sequence:           utils.Int32OrDefault(priorState.Sequence, 1),
+		Action:             utils.StringOrDefault(priorState.Action, "pass"),
+		Quick:              utils.BoolOrDefault(priorState.Quick, true),
+		Interfaces:         interfaces,
+		Direction:          utils.StringOrDefault(priorState.Direction, "in"),
+		IpVersion:          utils.StringOrDefault(priorState.IpVersion, "ipv4"),
+		Protocol:           customtypes.CaseInsensitiveStringValue{StringValue: utils.StringOrDefault(priorState.Protocol, "any")},
+		Source:             customtypes.NetworkValue{StringValue: utils.StringOrDefault(priorState.Source, "any")},
+		SourceNot:          utils.BoolOrDefault(priorState.SourceNot, false),
+		SourcePort:         customtypes.PortValue{StringValue: utils.StringOrDefault(priorState.SourcePort, "")},
+		Destination:        customtypes.NetworkValue{StringValue: utils.StringOrDefault(priorState.Destination, "any")},
+		DestinationNot:     utils.BoolOrDefault(priorState.DestinationNot, false),
+		DestinationPort:    customtypes.PortValue{StringValue: utils.StringOrDefault(priorState.DestinationPort, "")},
+		Gateway:            utils.StringOrDefault(priorState.Gateway, ""),
+		Log:                utils.BoolOrDefault(priorState.Log, false),
+		Categories:         categories,
+		Description:        utils.StringOrDefault(priorState.Description, ""),
+		SafeApply:          types.BoolValue(false),
+		KillStatesOnChange: types.BoolValue(false),
+	}
+	setAutomationFilterAdvancedDefaults(&state)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Successfully upgraded %s state from version 0", resourceName))
+}
@@ -0,0 +1,35 @@
+package filter_test
+
+import (
+	"terraform-provider-opnsense/internal/acctest"
+	"terraform-provider-opnsense/internal/opnsense/firewall/automation/filter"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestAutomationFilterResource_upgradeState(t *testing.T) {
+	acctest.RunStateUpgradeTests(t, []acctest.StateUpgradeTestCase{
+		{
+			Name:      "v0 defaults",
+			Resource:  filter.NewAutomationFilterResource(),
+			Version:   0,
+			StateFile: "testdata/filter_resource_v0.json",
+			Expected: map[string]attr.Value{
+				"enabled":          types.BoolValue(true),
+				"sequence":         types.Int32Value(1),
+				"action":           types.StringValue("pass"),
+				"quick":            types.BoolValue(true),
+				"direction":        types.StringValue("in"),
+				"ip_version":       types.StringValue("ipv4"),
+				"protocol":         types.StringValue("any"),
+				"source":           types.StringValue("any"),
+				"destination_port": types.StringValue(""),
+				"description":      types.StringValue(""),
+				"state_type":       types.StringValue("keep"),
+				"max_states":       types.Int32Value(-1),
+			},
+		},
+	})
+}
@@ -0,0 +1,733 @@
+package filter
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"terraform-provider-opnsense/internal/customtypes"
+	"terraform-provider-opnsense/internal/opnsense"
+	"terraform-provider-opnsense/internal/opnsense/firewall"
+	"terraform-provider-opnsense/internal/opnsense/firewall/automation"
+	"terraform-provider-opnsense/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                   = &automationFilterRulesetResource{}
+	_ resource.ResourceWithConfigure      = &automationFilterRulesetResource{}
+	_ resource.ResourceWithImportState    = &automationFilterRulesetResource{}
+	_ resource.ResourceWithValidateConfig = &automationFilterRulesetResource{}
+)
+
+// NewAutomationFilterRulesetResource is a helper function to simplify the provider implementation.
+func NewAutomationFilterRulesetResource() resource.Resource {
+	return &automationFilterRulesetResource{}
+}
+
+// automationFilterRulesetResource defines the resource implementation.
+type automationFilterRulesetResource struct {
+	client *opnsense.Client
+}
+
+// automationFilterRulesetResourceModel describes the resource data model.
+type automationFilterRulesetResourceModel struct {
+	Id      types.String `tfsdk:"id"`
+	Name    types.String `tfsdk:"name"`
+	Start   types.Int32  `tfsdk:"start"`
+	Gap     types.Int32  `tfsdk:"gap"`
+	Rules   types.List   `tfsdk:"rules"`
+	RuleIds types.List   `tfsdk:"rule_ids"`
+}
+
+// automationFilterRulesetRuleModel describes the data model of a rule in the ruleset.
+type automationFilterRulesetRuleModel struct {
+	Key             types.String                           `tfsdk:"key"`
+	Enabled         types.Bool                             `tfsdk:"enabled"`
+	Action          types.String                           `tfsdk:"action"`
+	Quick           types.Bool                             `tfsdk:"quick"`
+	Interfaces      types.Set                              `tfsdk:"interfaces"`
+	Direction       types.String                           `tfsdk:"direction"`
+	IpVersion       types.String                           `tfsdk:"ip_version"`
+	Protocol        customtypes.CaseInsensitiveStringValue `tfsdk:"protocol"`
+	Source          customtypes.NetworkValue               `tfsdk:"source"`
+	SourceNot       types.Bool                             `tfsdk:"source_not"`
+	SourcePort      customtypes.PortValue                  `tfsdk:"source_port"`
+	Destination     customtypes.NetworkValue               `tfsdk:"destination"`
+	DestinationNot  types.Bool                             `tfsdk:"destination_not"`
+	DestinationPort customtypes.PortValue                  `tfsdk:"destination_port"`
+	Gateway         types.String                           `tfsdk:"gateway"`
+	Log             types.Bool                             `tfsdk:"log"`
+	Categories      types.Set                              `tfsdk:"categories"`
+	Description     types.String                           `tfsdk:"description"`
+}
+
+// getAutomationFilterRulesetRuleAttrTypes returns the attribute types of a rule in the ruleset.
+func getAutomationFilterRulesetRuleAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"key":              types.StringType,
+		"enabled":          types.BoolType,
+		"action":           types.StringType,
+		"quick":            types.BoolType,
+		"interfaces":       types.SetType{ElemType: types.StringType},
+		"direction":        types.StringType,
+		"ip_version":       types.StringType,
+		"protocol":         customtypes.CaseInsensitiveStringType{},
+		"source":           customtypes.NetworkType{},
+		"source_not":       types.BoolType,
+		"source_port":      customtypes.PortType{},
+		"destination":      customtypes.NetworkType{},
+		"destination_not":  types.BoolType,
+		"destination_port": customtypes.PortType{},
+		"gateway":          types.StringType,
+		"log":              types.BoolType,
+		"categories":       types.SetType{ElemType: types.StringType},
+		"description":      types.StringType,
+	}
+}
+
+// Metadata returns the resource type name.
+func (r *automationFilterRulesetResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_%s_%s_%s_ruleset", req.ProviderTypeName, firewall.TypeName, automation.AutomationController, filterController)
+}
+
+// Schema defines the schema for the resource.
+func (r *automationFilterRulesetResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	emptySet, _ := basetypes.NewSetValue(types.StringType, []attr.Value{})
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages an ordered list of automation filter rules as a single policy. The rules owned by the ruleset are marked by prefixing their description with the ruleset name & the rule key in brackets (e.g. `[name:key] description`). Rules are matched by key, then created, updated, deleted & reordered to match the list, after which the configuration is applied once. Advanced options of the rules (e.g. state or traffic shaping settings) are not managed by the ruleset and are left unchanged.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: fmt.Sprintf("Identifier of the %s. Equal to the name.", rulesetResourceName),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the ruleset. Used to mark the rules owned by the ruleset, changing it forces a new resource.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.RegexMatches(regexp.MustCompile(`^[^\[\]]+$`), "must not contain brackets"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"start": schema.Int32Attribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Sequence assigned to the first rule. Defaults to `100`.",
+				Validators:          []validator.Int32{int32validator.Between(1, int32(maxSequence))},
+				Default:             int32default.StaticInt32(100),
+			},
+			"gap": schema.Int32Attribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Difference between the sequences of consecutive rules. Defaults to `100`.",
+				Validators:          []validator.Int32{int32validator.Between(1, int32(maxSequence))},
+				Default:             int32default.StaticInt32(100),
+			},
+			"rules": schema.ListNestedAttribute{
+				Required:    true,
+				Description: "The rules of the ruleset in the order they should be evaluated (first rule first).",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"key": schema.StringAttribute{
+							Required:    true,
+							Description: "Unique key identifying the rule within the ruleset. Used to match the rule on OPNsense, changing it replaces the rule.",
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(rulesetKeyRegex, "must only contain letters, digits, dots, dashes & underscores"),
+							},
+						},
+						"enabled": schema.BoolAttribute{
+							Optional:            true,
+							Computed:            true,
+							MarkdownDescription: "Whether the rule is enabled. Defaults to `true`.",
+							Default:             booldefault.StaticBool(true),
+						},
+						"action": schema.StringAttribute{
+							Optional: true,
+							Computed: true,
+							MarkdownDescription: fmt.Sprintf(
+								"Choose what to do with packets that match the criteria specified. Must be one of: %s. Defaults to `pass`.", strings.Join(
+									// Surround each type with backticks (`)
+									utils.SliceMap(getActions(), func(action string) string {
+										return fmt.Sprintf("`%s`", action)
+									}),
+									", ",
+								),
+							),
+							Validators: []validator.String{
+								// Type must be one of the listed values
+								stringvalidator.OneOf(getActions()...),
+							},
+							Default: stringdefault.StaticString("pass"),
+						},
+						"quick": schema.BoolAttribute{
+							Optional:            true,
+							Computed:            true,
+							MarkdownDescription: "If a packet matches a rule specifying quick, then that rule is considered the last matching rule and the specified action is taken. Defaults to `true`.",
+							Default:             booldefault.StaticBool(true),
+						},
+						"interfaces": schema.SetAttribute{
+							Optional:            true,
+							Computed:            true,
+							ElementType:         types.StringType,
+							MarkdownDescription: "Interfaces this rule applies to. Use the interface identifiers (e.g `lan`, `opt1`).",
+							Default:             setdefault.StaticValue(emptySet),
+						},
+						"direction": schema.StringAttribute{
+							Optional: true,
+							Computed: true,
+							MarkdownDescription: fmt.Sprintf(
+								"Direction of packet matching. Must be one of: %s. Defaults to `in`.", strings.Join(
+									// Surround each type with backticks (`)
+									utils.SliceMap(getDirections(), func(direction string) string {
+										return fmt.Sprintf("`%s`", direction)
+									}),
+									", ",
+								),
+							),
+							Validators: []validator.String{
+								// Type must be one of the listed values
+								stringvalidator.OneOf(getDirections()...),
+							},
+							Default: stringdefault.StaticString("in"),
+						},
+						"ip_version": schema.StringAttribute{
+							Optional: true,
+							Computed: true,
+							MarkdownDescription: fmt.Sprintf(
+								"The applicable ip version this for this rule. Must be one of: %s. Defaults to `ipv4`.", strings.Join(
+									// Surround each type with backticks (`)
+									utils.SliceMap(getIpVersions(), func(proto string) string {
+										return fmt.Sprintf("`%s`", proto)
+									}),
+									", ",
+								),
+							),
+							Validators: []validator.String{
+								// Type must be one of the listed values
+								stringvalidator.OneOf(getIpVersions()...),
+							},
+							Default: stringdefault.StaticString("ipv4"),
+						},
+						"protocol": schema.StringAttribute{
+							CustomType:          customtypes.CaseInsensitiveStringType{},
+							Optional:            true,
+							Computed:            true,
+							MarkdownDescription: "The applicable protocol for this rule. Accepts the same values as `opnsense_firewall_automation_filter`. Defaults to `any`.",
+							Validators: []validator.String{
+								// Type must be one of the listed values
+								stringvalidator.OneOf(getProtocols()...),
+							},
+							Default: stringdefault.StaticString("any"),
+						},
+						"source": schema.StringAttribute{
+							CustomType:          customtypes.NetworkType{},
+							Optional:            true,
+							Computed:            true,
+							MarkdownDescription: "Source IP or network. Can be a single network/host, alias or predefined network. Defaults to `any`.",
+							Default:             stringdefault.StaticString("any"),
+						},
+						"source_not": schema.BoolAttribute{
+							Optional:            true,
+							Computed:            true,
+							MarkdownDescription: "Whether the source matching should be inverted. Defaults to `false`.",
+							Default:             booldefault.StaticBool(false),
+						},
+						"source_port": schema.StringAttribute{
+							CustomType:          customtypes.PortType{},
+							Optional:            true,
+							Computed:            true,
+							MarkdownDescription: "[Only for `tcp` & `udp` protocols] Source port number or well known name, for ranges use a dash.",
+							Default:             stringdefault.StaticString(""),
+						},
+						"destination": schema.StringAttribute{
+							CustomType:          customtypes.NetworkType{},
+							Optional:            true,
+							Computed:            true,
+							MarkdownDescription: "Destination IP or network. Can be a single network/host, alias or predefined network. Defaults to `any`.",
+							Default:             stringdefault.StaticString("any"),
+						},
+						"destination_not": schema.BoolAttribute{
+							Optional:            true,
+							Computed:            true,
+							MarkdownDescription: "Whether the destination matching should be inverted. Defaults to `false`.",
+							Default:             booldefault.StaticBool(false),
+						},
+						"destination_port": schema.StringAttribute{
+							CustomType:          customtypes.PortType{},
+							Optional:            true,
+							Computed:            true,
+							MarkdownDescription: "[Only for `tcp` & `udp` protocols] Destination port number or well known name, for ranges use a dash.",
+							Default:             stringdefault.StaticString(""),
+						},
+						"gateway": schema.StringAttribute{
+							Optional:    true,
+							Computed:    true,
+							Description: "Choose a gateway to utilize policy based routing. Leave empty to use the system routing table.",
+							Default:     stringdefault.StaticString(""),
+						},
+						"log": schema.BoolAttribute{
+							Optional:            true,
+							Computed:            true,
+							MarkdownDescription: "Whether packets that are handled by this rule should be logged. Defaults to `false`.",
+							Default:             booldefault.StaticBool(false),
+						},
+						"categories": schema.SetAttribute{
+							Optional:    true,
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "The categories of the rule.",
+							Default:     setdefault.StaticValue(emptySet),
+						},
+						"description": schema.StringAttribute{
+							Optional:    true,
+							Computed:    true,
+							Description: "Description to identify this rule. Prefixed with the ruleset marker on OPNsense.",
+							Default:     stringdefault.StaticString(""),
+						},
+					},
+				},
+			},
+			"rule_ids": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Identifiers of the rules owned by the ruleset, in order.",
+			},
+		},
+	}
+}
+
+// ValidateConfig validates the resource configuration for cross-attribute constraints.
+func (r *automationFilterRulesetResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config automationFilterRulesetResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Skip validation if the rules are not yet known
+	if config.Rules.IsNull() || config.Rules.IsUnknown() {
+		return
+	}
+
+	var rules []automationFilterRulesetRuleModel
+	resp.Diagnostics.Append(config.Rules.ElementsAs(ctx, &rules, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Keys must be unique within the ruleset
+	keys := make(map[string]bool, len(rules))
+	for index, rule := range rules {
+		if rule.Key.IsNull() || rule.Key.IsUnknown() {
+			continue
+		}
+
+		if keys[rule.Key.ValueString()] {
+			resp.Diagnostics.AddAttributeError(path.Root("rules").AtListIndex(index).AtName("key"), "Duplicate Rule Key", fmt.Sprintf("The key `%s` is used by more than one rule of the ruleset.", rule.Key.ValueString()))
+		}
+		keys[rule.Key.ValueString()] = true
+	}
+
+	// Ports are only applicable to the TCP & UDP protocols
+	for index, rule := range rules {
+		if rule.Protocol.IsUnknown() {
+			continue
+		}
+
+		protocol := "any"
+		if !rule.Protocol.IsNull() {
+			protocol = rule.Protocol.ValueString()
+		}
+
+		if firewall.ProtocolSupportsPorts(protocol) {
+			continue
+		}
+
+		ports := map[string]customtypes.PortValue{
+			"source_port":      rule.SourcePort,
+			"destination_port": rule.DestinationPort,
+		}
+
+		for name, value := range ports {
+			if !value.IsNull() && !value.IsUnknown() && value.ValueString() != "" {
+				resp.Diagnostics.AddAttributeError(path.Root("rules").AtListIndex(index).AtName(name), "Invalid Attribute Combination", fmt.Sprintf("The `%s` attribute is only applicable when `protocol` is set to `tcp` or `udp`, got: `%s`.", name, protocol))
+			}
+		}
+	}
+
+	// Skip validation if the sequences are not yet known
+	if config.Start.IsUnknown() || config.Gap.IsUnknown() {
+		return
+	}
+
+	start, gap := int64(100), int64(100)
+	if !config.Start.IsNull() {
+		start = int64(config.Start.ValueInt32())
+	}
+	if !config.Gap.IsNull() {
+		gap = int64(config.Gap.ValueInt32())
+	}
+
+	// Verify the last sequence does not exceed the maximum
+	last := start + int64(len(rules)-1)*gap
+	if last > maxSequence {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("gap"),
+			"Invalid Attribute Combination",
+			fmt.Sprintf("The sequence of the last rule (%d) exceeds the maximum sequence of %d. Lower `start` or `gap`.", last, maxSequence),
+		)
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *automationFilterRulesetResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*opnsense.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *opnsense.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *automationFilterRulesetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, fmt.Sprintf("Creating %s", rulesetResourceName))
+
+	// Read Terraform plan data into the model
+	var plan automationFilterRulesetResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Synchronise ruleset rules on OPNsense
+	ruleIds, diags := r.syncRules(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update plan ID & rule IDs fields
+	plan.Id = plan.Name
+	plan.RuleIds = ruleIds
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Successfully created %s", rulesetResourceName))
+}
+
+// Read resource information.
+func (r *automationFilterRulesetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, fmt.Sprintf("Reading %s", rulesetResourceName))
+
+	// Read Terraform prior state data into the model
+	var state automationFilterRulesetResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get ruleset rules
+	tflog.Debug(ctx, fmt.Sprintf("Getting %s information", rulesetResourceName))
+	tflog.SetField(ctx, "name", state.Name.ValueString())
+
+	uuids, rules, err := getAutomationFilterRulesetRules(r.client, state.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Read %s error", rulesetResourceName), fmt.Sprintf("%s", err))
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Successfully got %s information", rulesetResourceName), map[string]any{"success": true})
+
+	// Remove ruleset from state if all rules were removed outside of Terraform
+	if len(uuids) == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Overwite items with refreshed state
+	rulesModel := make([]automationFilterRulesetRuleModel, len(rules))
+	for index, rule := range rules {
+		model, diags := automationFilterToRulesetRuleModel(ctx, state.Name.ValueString(), rule)
+		resp.Diagnostics.Append(diags...)
+		rulesModel[index] = model
+	}
+
+	rulesValue, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: getAutomationFilterRulesetRuleAttrTypes()}, rulesModel)
+	resp.Diagnostics.Append(diags...)
+	state.Rules = rulesValue
+
+	ruleIds, diags := types.ListValueFrom(ctx, types.StringType, uuids)
+	resp.Diagnostics.Append(diags...)
+	state.RuleIds = ruleIds
+
+	// Sequences default when absent (e.g. after import)
+	if state.Start.IsNull() {
+		state.Start = types.Int32Value(100)
+	}
+	if state.Gap.IsNull() {
+		state.Gap = types.Int32Value(100)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Successfully read %s", rulesetResourceName))
+}
+
+// Update updates the resource on OPNsense and the Terraform state.
+func (r *automationFilterRulesetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, fmt.Sprintf("Updating %s", rulesetResourceName))
+
+	// Read Terraform plan data into the model
+	var plan automationFilterRulesetResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Synchronise ruleset rules on OPNsense
+	ruleIds, diags := r.syncRules(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update rule IDs field
+	plan.RuleIds = ruleIds
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Successfully updated %s", rulesetResourceName))
+}
+
+// Delete removes the resource on OPNsense and from the Terraform state.
+func (r *automationFilterRulesetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, fmt.Sprintf("Deleting %s", rulesetResourceName))
+
+	// Read Terraform prior state data into the model
+	var state automationFilterRulesetResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete ruleset rules on OPNsense
+	tflog.Debug(ctx, fmt.Sprintf("Deleting %s on OPNsense", rulesetResourceName), map[string]any{"name": state.Name.ValueString()})
+
+	uuids, _, err := getAutomationFilterRulesetRules(r.client, state.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Delete %s error", rulesetResourceName), fmt.Sprintf("%s", err))
+		return
+	}
+
+	for _, uuid := range uuids {
+		err = deleteAutomationFilterRule(r.client, uuid)
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Delete %s error", rulesetResourceName), fmt.Sprintf("%s", err))
+			return
+		}
+	}
+
+	// Apply configuration on OPNsense
+	tflog.Debug(ctx, "Applying configuration on OPNsense")
+
+	err = applyAutomationFilterConfig(r.client)
+	if err != nil {
+		resp.Diagnostics.AddWarning(fmt.Sprintf("Delete %s error", rulesetResourceName), fmt.Sprintf("%s", err))
+	} else {
+		tflog.Debug(ctx, "Successfully applied configuration on OPNsense", map[string]any{"success": true})
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Successfully deleted %s", rulesetResourceName))
+}
+
+// ImportState imports the resource from OPNsense and enables Terraform to begin managing the resource.
+func (r *automationFilterRulesetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
+
+// syncRules creates, updates, deletes & reorders the rules owned by the ruleset to match the plan and applies the configuration once. Returns the UUIDs of the rules in order.
+func (r *automationFilterRulesetResource) syncRules(ctx context.Context, plan automationFilterRulesetResourceModel) (types.List, diag.Diagnostics) {
+	var diagnostics diag.Diagnostics
+	name := plan.Name.ValueString()
+
+	var rules []automationFilterRulesetRuleModel
+	diagnostics.Append(plan.Rules.ElementsAs(ctx, &rules, false)...)
+	if diagnostics.HasError() {
+		return types.ListNull(types.StringType), diagnostics
+	}
+
+	// Get the rules currently owned by the ruleset
+	ownedUuids, ownedRules, err := getAutomationFilterRulesetRules(r.client, name)
+	if err != nil {
+		diagnostics.AddError(fmt.Sprintf("Set %s error", rulesetResourceName), fmt.Sprintf("%s", err))
+		return types.ListNull(types.StringType), diagnostics
+	}
+
+	// Index the rules currently owned by the ruleset by key, keeping the first rule when a key is duplicated
+	owned := make(map[string]int, len(ownedUuids))
+	for index, rule := range ownedRules {
+		key, _, _ := parseAutomationFilterRulesetMarker(name, rule.Description)
+		if _, exists := owned[key]; !exists {
+			owned[key] = index
+		}
+	}
+
+	// Match rules by key, only update rules with a different configuration
+	changed := false
+	kept := make(map[string]bool, len(rules))
+	uuids := make([]string, len(rules))
+	for index, rule := range rules {
+		sequence := plan.Start.ValueInt32() + int32(index)*plan.Gap.ValueInt32()
+
+		desired, diags := createAutomationFilter(ctx, r.client, rulesetRuleToAutomationFilterModel(name, rule, sequence))
+		diagnostics.Append(diags...)
+		if diagnostics.HasError() {
+			return types.ListNull(types.StringType), diagnostics
+		}
+
+		ownedIndex, exists := owned[rule.Key.ValueString()]
+		if !exists {
+			tflog.Debug(ctx, fmt.Sprintf("Creating %s on OPNsense", resourceName), map[string]any{"key": rule.Key.ValueString(), "sequence": sequence})
+
+			uuid, err := addAutomationFilterRule(r.client, desired)
+			if err != nil {
+				diagnostics.AddError(fmt.Sprintf("Set %s error", rulesetResourceName), fmt.Sprintf("%s", err))
+				return types.ListNull(types.StringType), diagnostics
+			}
+
+			uuids[index] = uuid
+			changed = true
+			continue
+		}
+
+		uuids[index] = ownedUuids[ownedIndex]
+		kept[uuids[index]] = true
+
+		current, err := toAutomationFilterRequest(r.client, ownedRules[ownedIndex])
+		if err != nil {
+			diagnostics.AddError(fmt.Sprintf("Set %s error", rulesetResourceName), fmt.Sprintf("%s", err))
+			return types.ListNull(types.StringType), diagnostics
+		}
+
+		// Keep the advanced options of existing rules, as they are not managed by the ruleset
+		copyAutomationFilterAdvancedOptions(&desired, current)
+
+		desiredHash, err := utils.ContentHash(desired)
+		if err != nil {
+			diagnostics.AddError(fmt.Sprintf("Set %s error", rulesetResourceName), fmt.Sprintf("%s", err))
+			return types.ListNull(types.StringType), diagnostics
+		}
+
+		currentHash, err := utils.ContentHash(current)
+		if err != nil {
+			diagnostics.AddError(fmt.Sprintf("Set %s error", rulesetResourceName), fmt.Sprintf("%s", err))
+			return types.ListNull(types.StringType), diagnostics
+		}
+
+		if desiredHash == currentHash {
+			continue
+		}
+
+		tflog.Debug(ctx, fmt.Sprintf("Updating %s on OPNsense", resourceName), map[string]any{"uuid": uuids[index], "key": rule.Key.ValueString(), "sequence": sequence})
+
+		err = setAutomationFilterRule(r.client, desired, uuids[index])
+		if err != nil {
+			diagnostics.AddError(fmt.Sprintf("Set %s error", rulesetResourceName), fmt.Sprintf("%s", err))
+			return types.ListNull(types.StringType), diagnostics
+		}
+		changed = true
+	}
+
+	// Delete rules no longer part of the ruleset
+	for _, uuid := range ownedUuids {
+		if kept[uuid] {
+			continue
+		}
+
+		tflog.Debug(ctx, fmt.Sprintf("Deleting %s on OPNsense", resourceName), map[string]any{"uuid": uuid})
+
+		err = deleteAutomationFilterRule(r.client, uuid)
+		if err != nil {
+			diagnostics.AddError(fmt.Sprintf("Set %s error", rulesetResourceName), fmt.Sprintf("%s", err))
+			return types.ListNull(types.StringType), diagnostics
+		}
+		changed = true
+	}
+
+	// Apply configuration on OPNsense
+	if changed {
+		tflog.Debug(ctx, "Applying configuration on OPNsense")
+
+		err = applyAutomationFilterConfig(r.client)
+		if err != nil {
+			diagnostics.AddWarning(fmt.Sprintf("Set %s error", rulesetResourceName), fmt.Sprintf("%s", err))
+		} else {
+			tflog.Debug(ctx, "Successfully applied configuration on OPNsense", map[string]any{"success": true})
+		}
+	}
+
+	ruleIds, diags := types.ListValueFrom(ctx, types.StringType, uuids)
+	diagnostics.Append(diags...)
+
+	return ruleIds, diagnostics
+}
@@ -0,0 +1,158 @@
+package filter_test
+
+import (
+	"regexp"
+	"terraform-provider-opnsense/internal/acctest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/compare"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccAutomationFilterRulesetResource(t *testing.T) {
+	// Rules are matched by key, so inserting a rule must keep the identifier of the existing rules
+	dnsRuleId := statecheck.CompareValue(compare.ValuesSame())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccAutomationFilterRulesetResourceConfig,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("opnsense_firewall_automation_filter_ruleset.test_acc_resource_filter_ruleset", tfjsonpath.New("id"), knownvalue.StringExact("test_acc_ruleset")),
+					statecheck.ExpectKnownValue("opnsense_firewall_automation_filter_ruleset.test_acc_resource_filter_ruleset", tfjsonpath.New("rule_ids"), knownvalue.ListSizeExact(2)),
+					statecheck.ExpectKnownValue("opnsense_firewall_automation_filter_ruleset.test_acc_resource_filter_ruleset", tfjsonpath.New("rules").AtSliceIndex(0).AtMapKey("protocol"), knownvalue.StringExact("udp")),
+					statecheck.ExpectKnownValue("opnsense_firewall_automation_filter_ruleset.test_acc_resource_filter_ruleset", tfjsonpath.New("rules").AtSliceIndex(0).AtMapKey("description"), knownvalue.StringExact("allow dns")),
+					statecheck.ExpectKnownValue("opnsense_firewall_automation_filter_ruleset.test_acc_resource_filter_ruleset", tfjsonpath.New("rules").AtSliceIndex(1).AtMapKey("action"), knownvalue.StringExact("block")),
+					dnsRuleId.AddStateValue("opnsense_firewall_automation_filter_ruleset.test_acc_resource_filter_ruleset", tfjsonpath.New("rule_ids").AtSliceIndex(0)),
+				},
+			},
+			// ImportState testing
+			{
+				ResourceName:      "opnsense_firewall_automation_filter_ruleset.test_acc_resource_filter_ruleset",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccAutomationFilterRulesetResourceConfig_modified,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("opnsense_firewall_automation_filter_ruleset.test_acc_resource_filter_ruleset", tfjsonpath.New("rule_ids"), knownvalue.ListSizeExact(3)),
+					statecheck.ExpectKnownValue("opnsense_firewall_automation_filter_ruleset.test_acc_resource_filter_ruleset", tfjsonpath.New("rules").AtSliceIndex(0).AtMapKey("description"), knownvalue.StringExact("allow ntp")),
+					statecheck.ExpectKnownValue("opnsense_firewall_automation_filter_ruleset.test_acc_resource_filter_ruleset", tfjsonpath.New("rules").AtSliceIndex(1).AtMapKey("description"), knownvalue.StringExact("allow dns")),
+					statecheck.ExpectKnownValue("opnsense_firewall_automation_filter_ruleset.test_acc_resource_filter_ruleset", tfjsonpath.New("rules").AtSliceIndex(2).AtMapKey("log"), knownvalue.Bool(true)),
+					dnsRuleId.AddStateValue("opnsense_firewall_automation_filter_ruleset.test_acc_resource_filter_ruleset", tfjsonpath.New("rule_ids").AtSliceIndex(1)),
+				},
+			},
+		},
+	})
+}
+
+func TestAccAutomationFilterRulesetResource_invalidConfig(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAutomationFilterRulesetResourceConfig_port_protocol,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+			{
+				Config:      testAccAutomationFilterRulesetResourceConfig_duplicate_key,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Duplicate Rule Key`),
+			},
+		},
+	})
+}
+
+// testAccAutomationFilterRulesetResourceConfig defines an automation filter ruleset resource with two rules.
+const testAccAutomationFilterRulesetResourceConfig = `
+	resource "opnsense_firewall_automation_filter_ruleset" "test_acc_resource_filter_ruleset" {
+		name = "test_acc_ruleset"
+		rules = [
+			{
+				key = "dns"
+				interfaces = ["lan"]
+				protocol = "udp"
+				destination_port = "53"
+				description = "allow dns"
+			},
+			{
+				key = "block"
+				action = "block"
+				interfaces = ["lan"]
+				description = "block everything else"
+			},
+		]
+	}
+`
+
+// testAccAutomationFilterRulesetResourceConfig_modified defines an automation filter ruleset resource with an inserted & modified rule.
+const testAccAutomationFilterRulesetResourceConfig_modified = `
+	resource "opnsense_firewall_automation_filter_ruleset" "test_acc_resource_filter_ruleset" {
+		name = "test_acc_ruleset"
+		start = 10
+		gap = 10
+		rules = [
+			{
+				key = "ntp"
+				interfaces = ["lan"]
+				protocol = "udp"
+				destination_port = "123"
+				description = "allow ntp"
+			},
+			{
+				key = "dns"
+				interfaces = ["lan"]
+				protocol = "udp"
+				destination_port = "53"
+				description = "allow dns"
+			},
+			{
+				key = "block"
+				action = "block"
+				interfaces = ["lan"]
+				log = true
+				description = "block everything else"
+			},
+		]
+	}
+`
+
+// testAccAutomationFilterRulesetResourceConfig_port_protocol defines an automation filter ruleset resource with a port on a protocol without ports.
+const testAccAutomationFilterRulesetResourceConfig_port_protocol = `
+	resource "opnsense_firewall_automation_filter_ruleset" "test_acc_resource_invalid" {
+		name = "test_acc_invalid"
+		rules = [
+			{
+				key = "icmp"
+				protocol = "icmp"
+				destination_port = "53"
+			},
+		]
+	}
+`
+
+// testAccAutomationFilterRulesetResourceConfig_duplicate_key defines an automation filter ruleset resource with two rules sharing a key.
+const testAccAutomationFilterRulesetResourceConfig_duplicate_key = `
+	resource "opnsense_firewall_automation_filter_ruleset" "test_acc_resource_invalid" {
+		name = "test_acc_invalid"
+		rules = [
+			{
+				key = "dns"
+				protocol = "udp"
+			},
+			{
+				key = "dns"
+				protocol = "tcp"
+			},
+		]
+	}
+`
@@ -3,23 +3,37 @@ package filter
 import (
 	"context"
 	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
 	"strings"
+	"terraform-provider-opnsense/internal/customtypes"
 	"terraform-provider-opnsense/internal/opnsense"
 	"terraform-provider-opnsense/internal/opnsense/firewall/category"
 	"terraform-provider-opnsense/internal/opnsense/interfaces/overview"
 	"terraform-provider-opnsense/internal/opnsense/system/gateways"
 	"terraform-provider-opnsense/internal/utils"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 const (
 	filterController = "filter"
 
-	resourceName = "automation filter rule"
+	resourceName        = "automation filter rule"
+	orderResourceName   = "automation filter rule order"
+	rulesetResourceName = "automation filter ruleset"
+
+	// Highest sequence number accepted by OPNsense for an automation filter rule.
+	maxSequence int64 = 999999
 )
 
+// Key identifying a rule within a ruleset, carried in the ruleset marker of the rule description.
+var rulesetKeyRegex = regexp.MustCompile(`^[a-zA-Z0-9_.-]+$`)
+
 type automationFilter struct {
 	Enabled         bool
 	Sequence        int32
@@ -39,6 +53,29 @@ type automationFilter struct {
 	Log             bool
 	Categories      *utils.Set
 	Description     string
+
+	// Advanced options
+	TcpFlags                 *utils.Set
+	TcpFlagsOutOf            *utils.Set
+	StateType                string
+	StateTimeout             int32
+	MaxStates                int32
+	MaxSourceNodes           int32
+	MaxSourceStates          int32
+	MaxSourceConnections     int32
+	MaxNewConnections        int32
+	MaxNewConnectionsSeconds int32
+	IcmpTypes                *utils.Set
+	Icmp6Types               *utils.Set
+	AllowOptions             bool
+	Tag                      string
+	Tagged                   string
+	ReplyTo                  string
+	Priority                 int32
+	SetPriority              int32
+	SetPriorityLow           int32
+	Schedule                 string
+	NoSync                   bool
 }
 
 // Filter values
@@ -199,6 +236,79 @@ func getProtocols() []string {
 	}
 }
 
+func getTcpFlags() []string {
+	return []string{
+		"syn",
+		"ack",
+		"fin",
+		"rst",
+		"psh",
+		"urg",
+		"ece",
+		"cwr",
+	}
+}
+
+func getStateTypes() []string {
+	return []string{
+		"keep",
+		"sloppy",
+		"modulate",
+		"synproxy",
+		"none",
+	}
+}
+
+func getIcmpTypes() []string {
+	return []string{
+		"echoreq",
+		"echorep",
+		"unreach",
+		"squench",
+		"redir",
+		"althost",
+		"routeradv",
+		"routersol",
+		"timex",
+		"paramprob",
+		"timereq",
+		"timerep",
+		"inforeq",
+		"inforep",
+		"maskreq",
+		"maskrep",
+	}
+}
+
+func getIcmp6Types() []string {
+	return []string{
+		"unreach",
+		"toobig",
+		"timex",
+		"paramprob",
+		"echoreq",
+		"echorep",
+		"groupqry",
+		"listqry",
+		"grouprep",
+		"listenrep",
+		"routersol",
+		"routeradv",
+		"neighbrsol",
+		"neighbradv",
+		"redir",
+		"routrrenum",
+		"wrureq",
+		"wrurep",
+		"fqdnreq",
+		"fqdnrep",
+		"niqry",
+		"nirep",
+		"mtraceresp",
+		"mtrace",
+	}
+}
+
 // Ip version mappings
 const (
 	ipv4 string = "ipv4"
@@ -222,6 +332,215 @@ func getBidirectionalIpVersion() *utils.BidirectionalMap {
 
 // Helper functions
 
+// getAutomationFilterOrderSequences calculates the sequence of each rule based on its position in the list.
+func getAutomationFilterOrderSequences(rules []string, start int32, gap int32) map[string]int32 {
+	sequences := make(map[string]int32, len(rules))
+	for index, rule := range rules {
+		sequences[rule] = start + int32(index)*gap
+	}
+	return sequences
+}
+
+// sortAutomationFilterRuleUuids sorts the UUIDs by the sequence of their rule, using the UUID to keep the order stable.
+func sortAutomationFilterRuleUuids(uuids []string, rules map[string]automationFilter) {
+	sort.Slice(uuids, func(i, j int) bool {
+		if rules[uuids[i]].Sequence != rules[uuids[j]].Sequence {
+			return rules[uuids[i]].Sequence < rules[uuids[j]].Sequence
+		}
+		return uuids[i] < uuids[j]
+	})
+}
+
+// getAutomationFilterRulesetMarker returns the description prefix marking the rule with the specified key as owned by a
+// ruleset.
+func getAutomationFilterRulesetMarker(name string, key string) string {
+	return fmt.Sprintf("[%s:%s]", name, key)
+}
+
+// getAutomationFilterRulesetMarkerPrefix returns the description prefix shared by all the rules owned by a ruleset.
+func getAutomationFilterRulesetMarkerPrefix(name string) string {
+	return fmt.Sprintf("[%s:", name)
+}
+
+// addAutomationFilterRulesetMarker prefixes the description of a ruleset rule with the ruleset marker.
+func addAutomationFilterRulesetMarker(name string, key string, description string) string {
+	if description == "" {
+		return getAutomationFilterRulesetMarker(name, key)
+	}
+	return fmt.Sprintf("%s %s", getAutomationFilterRulesetMarker(name, key), description)
+}
+
+// parseAutomationFilterRulesetMarker parses the description of a rule owned by a ruleset. Returns the key & the
+// description without the marker, or false if the rule is not owned by the ruleset.
+func parseAutomationFilterRulesetMarker(name string, description string) (string, string, bool) {
+	rest, found := strings.CutPrefix(description, getAutomationFilterRulesetMarkerPrefix(name))
+	if !found {
+		return "", "", false
+	}
+
+	key, rest, found := strings.Cut(rest, "]")
+	if !found || !rulesetKeyRegex.MatchString(key) {
+		return "", "", false
+	}
+
+	if rest == "" {
+		return key, "", true
+	}
+
+	rest, found = strings.CutPrefix(rest, " ")
+	if !found {
+		return "", "", false
+	}
+
+	return key, rest, true
+}
+
+// setAutomationFilterAdvancedDefaults sets the advanced options of an automation filter resource model to their defaults.
+func setAutomationFilterAdvancedDefaults(model *automationFilterResourceModel) {
+	emptySet := types.SetValueMust(types.StringType, []attr.Value{})
+
+	model.TcpFlags = emptySet
+	model.TcpFlagsOutOf = emptySet
+	model.StateType = types.StringValue("keep")
+	model.StateTimeout = types.Int32Value(-1)
+	model.MaxStates = types.Int32Value(-1)
+	model.MaxSourceNodes = types.Int32Value(-1)
+	model.MaxSourceStates = types.Int32Value(-1)
+	model.MaxSourceConnections = types.Int32Value(-1)
+	model.MaxNewConnections = types.Int32Value(-1)
+	model.MaxNewConnectionsSeconds = types.Int32Value(-1)
+	model.IcmpTypes = emptySet
+	model.Icmp6Types = emptySet
+	model.AllowOptions = types.BoolValue(false)
+	model.Tag = types.StringValue("")
+	model.Tagged = types.StringValue("")
+	model.ReplyTo = types.StringValue("")
+	model.Priority = types.Int32Value(-1)
+	model.SetPriority = types.Int32Value(-1)
+	model.SetPriorityLow = types.Int32Value(-1)
+	model.Schedule = types.StringValue("")
+	model.NoSync = types.BoolValue(false)
+}
+
+// copyAutomationFilterAdvancedOptions copies the advanced options of the source rule to the destination rule.
+func copyAutomationFilterAdvancedOptions(destination *automationFilter, source automationFilter) {
+	destination.TcpFlags = source.TcpFlags
+	destination.TcpFlagsOutOf = source.TcpFlagsOutOf
+	destination.StateType = source.StateType
+	destination.StateTimeout = source.StateTimeout
+	destination.MaxStates = source.MaxStates
+	destination.MaxSourceNodes = source.MaxSourceNodes
+	destination.MaxSourceStates = source.MaxSourceStates
+	destination.MaxSourceConnections = source.MaxSourceConnections
+	destination.MaxNewConnections = source.MaxNewConnections
+	destination.MaxNewConnectionsSeconds = source.MaxNewConnectionsSeconds
+	destination.IcmpTypes = source.IcmpTypes
+	destination.Icmp6Types = source.Icmp6Types
+	destination.AllowOptions = source.AllowOptions
+	destination.Tag = source.Tag
+	destination.Tagged = source.Tagged
+	destination.ReplyTo = source.ReplyTo
+	destination.Priority = source.Priority
+	destination.SetPriority = source.SetPriority
+	destination.SetPriorityLow = source.SetPriorityLow
+	destination.Schedule = source.Schedule
+	destination.NoSync = source.NoSync
+}
+
+// automationFilterRuleChanged checks if the rule on OPNsense differs between the specified plan and state, ignoring provider-side settings.
+func automationFilterRuleChanged(plan automationFilterResourceModel, state automationFilterResourceModel) bool {
+	plan.LastUpdated, state.LastUpdated = types.StringNull(), types.StringNull()
+	plan.ContentHash, state.ContentHash = types.StringNull(), types.StringNull()
+	plan.SafeApply, state.SafeApply = types.BoolNull(), types.BoolNull()
+	plan.KillStatesOnChange, state.KillStatesOnChange = types.BoolNull(), types.BoolNull()
+
+	return !reflect.DeepEqual(plan, state)
+}
+
+// rulesetRuleToAutomationFilterModel converts a ruleset rule to an automation filter resource model with the specified sequence.
+func rulesetRuleToAutomationFilterModel(name string, rule automationFilterRulesetRuleModel, sequence int32) automationFilterResourceModel {
+	model := automationFilterResourceModel{
+		Enabled:         rule.Enabled,
+		Sequence:        types.Int32Value(sequence),
+		Action:          rule.Action,
+		Quick:           rule.Quick,
+		Interfaces:      rule.Interfaces,
+		Direction:       rule.Direction,
+		IpVersion:       rule.IpVersion,
+		Protocol:        rule.Protocol,
+		Source:          rule.Source,
+		SourceNot:       rule.SourceNot,
+		SourcePort:      rule.SourcePort,
+		Destination:     rule.Destination,
+		DestinationNot:  rule.DestinationNot,
+		DestinationPort: rule.DestinationPort,
+		Gateway:         rule.Gateway,
+		Log:             rule.Log,
+		Categories:      rule.Categories,
+		Description:     types.StringValue(addAutomationFilterRulesetMarker(name, rule.Key.ValueString(), rule.Description.ValueString())),
+	}
+
+	// Advanced options are not managed by rulesets, new rules use the defaults
+	setAutomationFilterAdvancedDefaults(&model)
+
+	return model
+}
+
+// automationFilterToRulesetRuleModel converts an automation filter rule owned by a ruleset to a ruleset rule model.
+func automationFilterToRulesetRuleModel(ctx context.Context, name string, rule automationFilter) (automationFilterRulesetRuleModel, diag.Diagnostics) {
+	var diagnostics diag.Diagnostics
+
+	interfaces, diags := utils.SetGoToTerraform(ctx, rule.Interfaces)
+	diagnostics.Append(diags...)
+
+	categories, diags := utils.SetGoToTerraform(ctx, rule.Categories)
+	diagnostics.Append(diags...)
+
+	key, description, _ := parseAutomationFilterRulesetMarker(name, rule.Description)
+
+	return automationFilterRulesetRuleModel{
+		Key:             types.StringValue(key),
+		Enabled:         types.BoolValue(rule.Enabled),
+		Action:          types.StringValue(rule.Action),
+		Quick:           types.BoolValue(rule.Quick),
+		Interfaces:      interfaces,
+		Direction:       types.StringValue(rule.Direction),
+		IpVersion:       types.StringValue(rule.IpVersion),
+		Protocol:        customtypes.NewCaseInsensitiveStringValue(rule.Protocol),
+		Source:          customtypes.NewNetworkValue(rule.Source),
+		SourceNot:       types.BoolValue(rule.SourceNot),
+		SourcePort:      customtypes.NewPortValue(rule.SourcePort),
+		Destination:     customtypes.NewNetworkValue(rule.Destination),
+		DestinationNot:  types.BoolValue(rule.DestinationNot),
+		DestinationPort: customtypes.NewPortValue(rule.DestinationPort),
+		Gateway:         types.StringValue(rule.Gateway),
+		Log:             types.BoolValue(rule.Log),
+		Categories:      categories,
+		Description:     types.StringValue(description),
+	}, diagnostics
+}
+
+// toAutomationFilterRequest converts an automation filter rule read from OPNsense to the values expected when setting it on OPNsense.
+func toAutomationFilterRequest(client *opnsense.Client, rule automationFilter) (automationFilter, error) {
+	ipVersion, exists := ipVersions.GetByKey(rule.IpVersion)
+	if !exists {
+		return rule, fmt.Errorf("Ip version `%s` not supported. Please contact the provider maintainers if you believe this should be supported.", rule.IpVersion)
+	}
+	rule.IpVersion = ipVersion
+
+	if rule.Protocol != "any" {
+		rule.Protocol = strings.ToUpper(rule.Protocol)
+	}
+
+	categoryUuids, err := category.GetCategoryUuids(client, rule.Categories)
+	if err != nil {
+		return rule, err
+	}
+	rule.Categories = categoryUuids
+
+	return rule, nil
+}
+
 // createAutomationFilter creates an automation filter object based on the specified plan.
 func createAutomationFilter(ctx context.Context, client *opnsense.Client, plan automationFilterResourceModel) (automationFilter, diag.Diagnostics) {
 	var diagnostics diag.Diagnostics
@@ -272,6 +591,34 @@ func createAutomationFilter(ctx context.Context, client *opnsense.Client, plan a
 
 	tflog.Debug(ctx, "Successfully verified categories", map[string]any{"success": true})
 
+	// Verify reply-to gateway
+	if plan.ReplyTo.ValueString() != "" {
+		tflog.Debug(ctx, "Verifying reply-to gateway", map[string]any{"gateways": plan.ReplyTo.ValueString()})
+
+		gatewayExists, err := gateways.VerifyGateway(client, plan.ReplyTo.ValueString())
+		if err != nil {
+			diagnostics.AddError(fmt.Sprintf("Create %s object error", resourceName), fmt.Sprintf("%s", err))
+		}
+		if !gatewayExists {
+			diagnostics.AddError(fmt.Sprintf("Create %s object error", resourceName), "Reply-to gateway does not exist. Please verify that the specified gateway exist on your OPNsense firewall")
+		}
+
+		tflog.Debug(ctx, "Successfully verified reply-to gateway", map[string]any{"success": true})
+	}
+
+	// TCP flags & ICMP types
+	tcpFlags, diags := utils.SetTerraformToGo(ctx, plan.TcpFlags)
+	diagnostics.Append(diags...)
+
+	tcpFlagsOutOf, diags := utils.SetTerraformToGo(ctx, plan.TcpFlagsOutOf)
+	diagnostics.Append(diags...)
+
+	icmpTypes, diags := utils.SetTerraformToGo(ctx, plan.IcmpTypes)
+	diagnostics.Append(diags...)
+
+	icmp6Types, diags := utils.SetTerraformToGo(ctx, plan.Icmp6Types)
+	diagnostics.Append(diags...)
+
 	// IpVersion
 	ipVersion, exists := ipVersions.GetByKey(plan.IpVersion.ValueString())
 	if !exists {
@@ -303,6 +650,28 @@ func createAutomationFilter(ctx context.Context, client *opnsense.Client, plan a
 		Log:             plan.Log.ValueBool(),
 		Categories:      categoryUuids,
 		Description:     plan.Description.ValueString(),
+
+		TcpFlags:                 tcpFlags,
+		TcpFlagsOutOf:            tcpFlagsOutOf,
+		StateType:                plan.StateType.ValueString(),
+		StateTimeout:             plan.StateTimeout.ValueInt32(),
+		MaxStates:                plan.MaxStates.ValueInt32(),
+		MaxSourceNodes:           plan.MaxSourceNodes.ValueInt32(),
+		MaxSourceStates:          plan.MaxSourceStates.ValueInt32(),
+		MaxSourceConnections:     plan.MaxSourceConnections.ValueInt32(),
+		MaxNewConnections:        plan.MaxNewConnections.ValueInt32(),
+		MaxNewConnectionsSeconds: plan.MaxNewConnectionsSeconds.ValueInt32(),
+		IcmpTypes:                icmpTypes,
+		Icmp6Types:               icmp6Types,
+		AllowOptions:             plan.AllowOptions.ValueBool(),
+		Tag:                      plan.Tag.ValueString(),
+		Tagged:                   plan.Tagged.ValueString(),
+		ReplyTo:                  plan.ReplyTo.ValueString(),
+		Priority:                 plan.Priority.ValueInt32(),
+		SetPriority:              plan.SetPriority.ValueInt32(),
+		SetPriorityLow:           plan.SetPriorityLow.ValueInt32(),
+		Schedule:                 plan.Schedule.ValueString(),
+		NoSync:                   plan.NoSync.ValueBool(),
 	}
 
 	tflog.Debug(ctx, fmt.Sprintf("Successfully created %s object from plan", resourceName), map[string]any{"success": true})
@@ -323,3 +323,13 @@ func applyAutomationSourceNatConfig(client *opnsense.Client) error {
 	}
 	return nil
 }
+
+// getAutomationSourceNatRuleContentHash gets the content hash of the source NAT rule from OPNsense.
+func getAutomationSourceNatRuleContentHash(client *opnsense.Client, uuid string) (string, error) {
+	object, err := getAutomationSourceNatRule(client, uuid)
+	if err != nil {
+		return "", err
+	}
+
+	return utils.ContentHash(object)
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 	"time"
 
+	"terraform-provider-opnsense/internal/customtypes"
 	"terraform-provider-opnsense/internal/opnsense"
 	"terraform-provider-opnsense/internal/opnsense/firewall"
 	"terraform-provider-opnsense/internal/opnsense/firewall/automation"
@@ -32,9 +33,11 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &automationSourceNatResource{}
-	_ resource.ResourceWithConfigure   = &automationSourceNatResource{}
-	_ resource.ResourceWithImportState = &automationSourceNatResource{}
+	_ resource.Resource                   = &automationSourceNatResource{}
+	_ resource.ResourceWithConfigure      = &automationSourceNatResource{}
+	_ resource.ResourceWithImportState    = &automationSourceNatResource{}
+	_ resource.ResourceWithUpgradeState   = &automationSourceNatResource{}
+	_ resource.ResourceWithValidateConfig = &automationSourceNatResource{}
 )
 
 // NewAutomationSourceNatResource is a helper function to simplify the provider implementation.
@@ -49,25 +52,26 @@ type automationSourceNatResource struct {
 
 // automationSourceNatResourceModel describes the resource data model.
 type automationSourceNatResourceModel struct {
-	Id              types.String `tfsdk:"id"`
-	LastUpdated     types.String `tfsdk:"last_updated"`
-	Enabled         types.Bool   `tfsdk:"enabled"`
-	NoNat           types.Bool   `tfsdk:"no_nat"`
-	Sequence        types.Int32  `tfsdk:"sequence"`
-	Interface       types.String `tfsdk:"interface"`
-	IpVersion       types.String `tfsdk:"ip_version"`
-	Protocol        types.String `tfsdk:"protocol"`
-	Source          types.String `tfsdk:"source"`
-	SourceNot       types.Bool   `tfsdk:"source_not"`
-	SourcePort      types.String `tfsdk:"source_port"`
-	Destination     types.String `tfsdk:"destination"`
-	DestinationNot  types.Bool   `tfsdk:"destination_not"`
-	DestinationPort types.String `tfsdk:"destination_port"`
-	Target          types.String `tfsdk:"target"`
-	TargetPort      types.String `tfsdk:"target_port"`
-	Log             types.Bool   `tfsdk:"log"`
-	Categories      types.Set    `tfsdk:"categories"`
-	Description     types.String `tfsdk:"description"`
+	Id              types.String                           `tfsdk:"id"`
+	LastUpdated     types.String                           `tfsdk:"last_updated"`
+	ContentHash     types.String                           `tfsdk:"content_hash"`
+	Enabled         types.Bool                             `tfsdk:"enabled"`
+	NoNat           types.Bool                             `tfsdk:"no_nat"`
+	Sequence        types.Int32                            `tfsdk:"sequence"`
+	Interface       types.String                           `tfsdk:"interface"`
+	IpVersion       types.String                           `tfsdk:"ip_version"`
+	Protocol        customtypes.CaseInsensitiveStringValue `tfsdk:"protocol"`
+	Source          customtypes.NetworkValue               `tfsdk:"source"`
+	SourceNot       types.Bool                             `tfsdk:"source_not"`
+	SourcePort      customtypes.PortValue                  `tfsdk:"source_port"`
+	Destination     customtypes.NetworkValue               `tfsdk:"destination"`
+	DestinationNot  types.Bool                             `tfsdk:"destination_not"`
+	DestinationPort customtypes.PortValue                  `tfsdk:"destination_port"`
+	Target          customtypes.NetworkValue               `tfsdk:"target"`
+	TargetPort      customtypes.PortValue                  `tfsdk:"target_port"`
+	Log             types.Bool                             `tfsdk:"log"`
+	Categories      types.Set                              `tfsdk:"categories"`
+	Description     types.String                           `tfsdk:"description"`
 }
 
 // Metadata returns the resource type name.
@@ -80,6 +84,7 @@ func (r *automationSourceNatResource) Schema(ctx context.Context, req resource.S
 	emptySet, _ := basetypes.NewSetValue(types.StringType, []attr.Value{})
 
 	resp.Schema = schema.Schema{
+		Version:     1,
 		Description: "When a client on an internal network makes an outbound request, the gateway will have to change the source IP to the external IP of the gateway, since the outside server will not be able to send an answer back otherwise. Source NAT is also known as Outbound NAT or Masquerading.",
 
 		Attributes: map[string]schema.Attribute{
@@ -91,8 +96,13 @@ func (r *automationSourceNatResource) Schema(ctx context.Context, req resource.S
 				},
 			},
 			"last_updated": schema.StringAttribute{
+				Computed:           true,
+				Description:        fmt.Sprintf("DateTime when the %s was last updated.", resourceName),
+				DeprecationMessage: "Use `content_hash` to detect changes to this resource instead. This attribute will be removed in a future release.",
+			},
+			"content_hash": schema.StringAttribute{
 				Computed:    true,
-				Description: fmt.Sprintf("DateTime when the %s was last updated.", resourceName),
+				Description: fmt.Sprintf("Hash of the %s configuration on OPNsense. Changes whenever the configuration is modified, including changes made outside of Terraform.", resourceName),
 			},
 			"enabled": schema.BoolAttribute{
 				Optional:            true,
@@ -136,8 +146,9 @@ func (r *automationSourceNatResource) Schema(ctx context.Context, req resource.S
 				Default: stringdefault.StaticString("ipv4"),
 			},
 			"protocol": schema.StringAttribute{
-				Optional: true,
-				Computed: true,
+				CustomType: customtypes.CaseInsensitiveStringType{},
+				Optional:   true,
+				Computed:   true,
 				MarkdownDescription: fmt.Sprintf(
 					"The applicable protocol for this rule. Must be one of: %s. Defaults to `any`.", strings.Join(
 						// Surround each type with backticks (`)
@@ -154,6 +165,7 @@ func (r *automationSourceNatResource) Schema(ctx context.Context, req resource.S
 				Default: stringdefault.StaticString("any"),
 			},
 			"source": schema.StringAttribute{
+				CustomType:          customtypes.NetworkType{},
 				Optional:            true,
 				Computed:            true,
 				MarkdownDescription: "Source IP or network. Can be a single network/host, alias or predefined network. For interface addresses, add `ip` to the end of the interface name (e.g `opt1ip`). Defaults to `any`",
@@ -166,12 +178,14 @@ func (r *automationSourceNatResource) Schema(ctx context.Context, req resource.S
 				Default:             booldefault.StaticBool(false),
 			},
 			"source_port": schema.StringAttribute{
+				CustomType:          customtypes.PortType{},
 				Optional:            true,
 				Computed:            true,
-				MarkdownDescription: "Source port number or well known name (`imap`, `imaps`, `http`, `https`, ...), for ranges use a dash.",
+				MarkdownDescription: "[Only for `tcp` & `udp` protocols] Source port number or well known name (`imap`, `imaps`, `http`, `https`, ...), for ranges use a dash.",
 				Default:             stringdefault.StaticString(""),
 			},
 			"destination": schema.StringAttribute{
+				CustomType:          customtypes.NetworkType{},
 				Optional:            true,
 				Computed:            true,
 				MarkdownDescription: "Destination IP or network. Can be a single network/host, alias or predefined network. For interface addresses, add `ip` to the end of the interface name (e.g `opt1ip`). Defaults to `any`",
@@ -184,19 +198,22 @@ func (r *automationSourceNatResource) Schema(ctx context.Context, req resource.S
 				Default:             booldefault.StaticBool(false),
 			},
 			"destination_port": schema.StringAttribute{
+				CustomType:          customtypes.PortType{},
 				Optional:            true,
 				Computed:            true,
-				MarkdownDescription: "Destination port number or well known name (`imap`, `imaps`, `http`, `https`, ...), for ranges use a dash.",
+				MarkdownDescription: "[Only for `tcp` & `udp` protocols] Destination port number or well known name (`imap`, `imaps`, `http`, `https`, ...), for ranges use a dash.",
 				Default:             stringdefault.StaticString(""),
 			},
 			"target": schema.StringAttribute{
+				CustomType:          customtypes.NetworkType{},
 				Required:            true,
 				MarkdownDescription: " Packets matching this rule will be mapped to this IP address or network. Can be a single network/host, alias or predefined network. For interface addresses, add `ip` to the end of the interface name (e.g `opt1ip`). Defaults to `any`",
 			},
 			"target_port": schema.StringAttribute{
+				CustomType:  customtypes.PortType{},
 				Optional:    true,
 				Computed:    true,
-				Description: "Target port number or well known name (`imap`, `imaps`, `http`, `https`, ...), for ranges use a dash.",
+				Description: "[Only for `tcp` & `udp` protocols] Target port number or well known name (`imap`, `imaps`, `http`, `https`, ...), for ranges use a dash.",
 				Default:     stringdefault.StaticString(""),
 			},
 			"log": schema.BoolAttribute{
@@ -222,6 +239,43 @@ func (r *automationSourceNatResource) Schema(ctx context.Context, req resource.S
 	}
 }
 
+// ValidateConfig validates the resource configuration for cross-attribute constraints.
+func (r *automationSourceNatResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config automationSourceNatResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Skip validation if the protocol is not yet known
+	if config.Protocol.IsUnknown() {
+		return
+	}
+
+	// Protocol defaults to `any` when not configured
+	protocol := "any"
+	if !config.Protocol.IsNull() {
+		protocol = config.Protocol.ValueString()
+	}
+
+	if firewall.ProtocolSupportsPorts(protocol) {
+		return
+	}
+
+	// Ports are only applicable to the TCP & UDP protocols
+	ports := map[string]customtypes.PortValue{
+		"source_port":      config.SourcePort,
+		"destination_port": config.DestinationPort,
+		"target_port":      config.TargetPort,
+	}
+
+	for name, value := range ports {
+		if !value.IsNull() && !value.IsUnknown() && value.ValueString() != "" {
+			resp.Diagnostics.AddAttributeError(path.Root(name), "Invalid Attribute Combination", fmt.Sprintf("The `%s` attribute is only applicable when `protocol` is set to `tcp` or `udp`, got: `%s`.", name, protocol))
+		}
+	}
+}
+
 // Configure adds the provider configured client to the resource.
 func (r *automationSourceNatResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Add a nil check when handling ProviderData because Terraform
@@ -281,6 +335,11 @@ func (r *automationSourceNatResource) Create(ctx context.Context, req resource.C
 		tflog.Debug(ctx, "Successfully applied configuration on OPNsense", map[string]any{"success": true})
 	}
 
+	// Get content hash from OPNsense
+	contentHash, err := getAutomationSourceNatRuleContentHash(r.client, uuid)
+	plan.ContentHash, diags = utils.ContentHashValue(fmt.Sprintf("Create %s error", resourceName), contentHash, err)
+	resp.Diagnostics.Append(diags...)
+
 	// Update plan ID & last_updated fields
 	plan.Id = types.StringValue(uuid)
 	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
@@ -321,20 +380,25 @@ func (r *automationSourceNatResource) Read(ctx context.Context, req resource.Rea
 	tflog.Debug(ctx, fmt.Sprintf("Successfully got %s information", resourceName), map[string]any{"success": true})
 
 	// Overwite items with refreshed state
+	contentHash, err := utils.ContentHash(rule)
+	contentHashValue, diags := utils.ContentHashValue(fmt.Sprintf("Read %s error", resourceName), contentHash, err)
+	resp.Diagnostics.Append(diags...)
+	state.ContentHash = contentHashValue
+
 	state.Enabled = types.BoolValue(rule.Enabled)
 	state.NoNat = types.BoolValue(rule.NoNat)
 	state.Sequence = types.Int32Value(rule.Sequence)
 	state.Interface = types.StringValue(rule.Interface)
 	state.IpVersion = types.StringValue(rule.IpVersion)
-	state.Protocol = types.StringValue(rule.Protocol)
-	state.Source = types.StringValue(rule.Source)
+	state.Protocol = customtypes.NewCaseInsensitiveStringValue(rule.Protocol)
+	state.Source = customtypes.NewNetworkValue(rule.Source)
 	state.SourceNot = types.BoolValue(rule.SourceNot)
-	state.SourcePort = types.StringValue(rule.SourcePort)
-	state.Destination = types.StringValue(rule.Destination)
+	state.SourcePort = customtypes.NewPortValue(rule.SourcePort)
+	state.Destination = customtypes.NewNetworkValue(rule.Destination)
 	state.DestinationNot = types.BoolValue(rule.DestinationNot)
-	state.DestinationPort = types.StringValue(rule.DestinationPort)
-	state.Target = types.StringValue(rule.Target)
-	state.TargetPort = types.StringValue(rule.TargetPort)
+	state.DestinationPort = customtypes.NewPortValue(rule.DestinationPort)
+	state.Target = customtypes.NewNetworkValue(rule.Target)
+	state.TargetPort = customtypes.NewPortValue(rule.TargetPort)
 	state.Log = types.BoolValue(rule.Log)
 
 	categories, diags := utils.SetGoToTerraform(ctx, rule.Categories)
@@ -402,6 +466,11 @@ func (r *automationSourceNatResource) Update(ctx context.Context, req resource.U
 		tflog.Debug(ctx, "Successfully applied configuration on OPNsense", map[string]any{"success": true})
 	}
 
+	// Get content hash from OPNsense
+	contentHash, err := getAutomationSourceNatRuleContentHash(r.client, state.Id.ValueString())
+	plan.ContentHash, diags = utils.ContentHashValue(fmt.Sprintf("Update %s error", resourceName), contentHash, err)
+	resp.Diagnostics.Append(diags...)
+
 	// Update last_updated field (if change detected)
 	if !(reflect.DeepEqual(plan, state)) {
 		plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
@@ -1,6 +1,7 @@
 package sourcenat_test
 
 import (
+	"regexp"
 	"testing"
 
 	"terraform-provider-opnsense/internal/acctest"
@@ -153,6 +154,20 @@ func TestAccAutomationSourceNatResource_default(t *testing.T) {
 	})
 }
 
+func TestAccAutomationSourceNatResource_invalidConfig(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAutomationSourceNatResourceConfig_port_protocol,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+		},
+	})
+}
+
 // testAccAutomationSourceNatResourceConfig defines an automation source nat resource.
 const testAccAutomationSourceNatResourceConfig = `
 	resource "opnsense_firewall_automation_source_nat" "test_acc_resource_source_nat" {
@@ -234,3 +249,12 @@ const testAccAutomationSourceNatResourceConfig_default = `
 		target 					 = "perm_test_acc_alias"
 	}
 `
+
+// testAccAutomationSourceNatResourceConfig_port_protocol defines an automation source nat resource with a target port on a protocol without ports.
+const testAccAutomationSourceNatResourceConfig_port_protocol = `
+	resource "opnsense_firewall_automation_source_nat" "test_acc_resource_invalid" {
+		interface = "wan"
+		target = "wanip"
+		target_port = "55"
+	}
+`
@@ -0,0 +1,90 @@
+package sourcenat
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-opnsense/internal/customtypes"
+	"terraform-provider-opnsense/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// automationSourceNatResourceModelV0 describes the resource data model of schema version 0.
+type automationSourceNatResourceModelV0 struct {
+	Id              *string  `json:"id"`
+	LastUpdated     *string  `json:"last_updated"`
+	Enabled         *bool    `json:"enabled"`
+	NoNat           *bool    `json:"no_nat"`
+	Sequence        *int32   `json:"sequence"`
+	Interface       *string  `json:"interface"`
+	IpVersion       *string  `json:"ip_version"`
+	Protocol        *string  `json:"protocol"`
+	Source          *string  `json:"source"`
+	SourceNot       *bool    `json:"source_not"`
+	SourcePort      *string  `json:"source_port"`
+	Destination     *string  `json:"destination"`
+	DestinationNot  *bool    `json:"destination_not"`
+	DestinationPort *string  `json:"destination_port"`
+	Target          *string  `json:"target"`
+	TargetPort      *string  `json:"target_port"`
+	Log             *bool    `json:"log"`
+	Categories      []string `json:"categories"`
+	Description     *string  `json:"description"`
+}
+
+// UpgradeState upgrades the resource state from prior schema versions to the current schema version.
+func (r *automationSourceNatResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {StateUpgrader: upgradeAutomationSourceNatStateV0},
+	}
+}
+
+// upgradeAutomationSourceNatStateV0 upgrades the resource state from schema version 0.
+func upgradeAutomationSourceNatStateV0(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	tflog.Info(ctx, fmt.Sprintf("Upgrading %s state from version 0", resourceName))
+
+	var priorState automationSourceNatResourceModelV0
+	err := utils.DecodeRawState(req, &priorState)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Upgrade %s state error", resourceName), fmt.Sprintf("%s", err))
+		return
+	}
+
+	categories, diags := utils.StringSetOrDefault(ctx, priorState.Categories, []string{})
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state := automationSourceNatResourceModel{
+		Id:              utils.StringOrNull(priorState.Id),
+		LastUpdated:     utils.StringOrNull(priorState.LastUpdated),
+		Enabled:         utils.BoolOrDefault(priorState.Enabled, true),
+		NoNat:           utils.BoolOrDefault(priorState.NoNat, false),
+		Sequence:        utils.Int32OrDefault(priorState.Sequence, 1),
+		Interface:       utils.StringOrNull(priorState.Interface),
+		IpVersion:       utils.StringOrDefault(priorState.IpVersion, "ipv4"),
+		Protocol:        customtypes.CaseInsensitiveStringValue{StringValue: utils.StringOrDefault(priorState.Protocol, "any")},
+		Source:          customtypes.NetworkValue{StringValue: utils.StringOrDefault(priorState.Source, "any")},
+		SourceNot:       utils.BoolOrDefault(priorState.SourceNot, false),
+		SourcePort:      customtypes.PortValue{StringValue: utils.StringOrDefault(priorState.SourcePort, "")},
+		Destination:     customtypes.NetworkValue{StringValue: utils.StringOrDefault(priorState.Destination, "any")},
+		DestinationNot:  utils.BoolOrDefault(priorState.DestinationNot, false),
+		DestinationPort: customtypes.PortValue{StringValue: utils.StringOrDefault(priorState.DestinationPort, "")},
+		Target:          customtypes.NetworkValue{StringValue: utils.StringOrNull(priorState.Target)},
+		TargetPort:      customtypes.PortValue{StringValue: utils.StringOrDefault(priorState.TargetPort, "")},
+		Log:             utils.BoolOrDefault(priorState.Log, false),
+		Categories:      categories,
+		Description:     utils.StringOrDefault(priorState.Description, ""),
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Successfully upgraded %s state from version 0", resourceName))
+}
@@ -0,0 +1,30 @@
+package sourcenat_test
+
+import (
+	"terraform-provider-opnsense/internal/acctest"
+	"terraform-provider-opnsense/internal/opnsense/firewall/automation/sourcenat"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestAutomationSourceNatResource_upgradeState(t *testing.T) {
+	acctest.RunStateUpgradeTests(t, []acctest.StateUpgradeTestCase{
+		{
+			Name:      "v0 defaults",
+			Resource:  sourcenat.NewAutomationSourceNatResource(),
+			Version:   0,
+			StateFile: "testdata/sourcenat_resource_v0.json",
+			Expected: map[string]attr.Value{
+				"enabled":     types.BoolValue(true),
+				"no_nat":      types.BoolValue(false),
+				"sequence":    types.Int32Value(1),
+				"ip_version":  types.StringValue("ipv4"),
+				"protocol":    types.StringValue("any"),
+				"target_port": types.StringValue(""),
+				"log":         types.BoolValue(false),
+			},
+		},
+	})
+}
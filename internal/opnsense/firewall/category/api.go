@@ -32,6 +32,12 @@ type categoryHttpBody struct {
 	Category categoryRequest `json:"category"`
 }
 
+type searchCategoryUsageRequestBody struct {
+	Current      int32  `json:"current"`
+	RowCount     int32  `json:"rowCount"`
+	SearchPhrase string `json:"searchPhrase"`
+}
+
 type categoryRequest struct {
 	Name  string `json:"name"`
 	Auto  uint8  `json:"auto"`
@@ -51,6 +57,15 @@ type getCategoryResponse struct {
 	Category categoryType `json:"category"`
 }
 
+type searchCategoryUsageResponse struct {
+	Rows []map[string]any `json:"rows"`
+}
+
+type categoryOptions map[string]struct {
+	Value    string `json:"value"`
+	Selected uint8  `json:"selected"`
+}
+
 type categoryType struct {
 	Uuid  string `json:"uuid"`
 	Name  string `json:"name"`
@@ -242,3 +257,158 @@ func deleteCategory(client *opnsense.Client, uuid string) error {
 	}
 	return nil
 }
+
+// searchCategoryUsage searches the OPNsense firewall for objects of the specified source referencing the category with a matching uuid or name.
+// Sources whose API is not available on the OPNsense firewall are skipped.
+func searchCategoryUsage(client *opnsense.Client, source categoryUsageSource, uuid string, name string) ([]categoryUsage, error) {
+	path := fmt.Sprintf("%s/%s/%s", firewall.Module, source.Controller, source.SearchCommand)
+
+	reqBody, err := json.Marshal(searchCategoryUsageRequestBody{Current: 1, RowCount: -1})
+	if err != nil {
+		return nil, fmt.Errorf("Search %s usage error: failed to marshal json body - %s", resourceName, err)
+	}
+
+	httpResp, err := client.DoRequest(http.MethodPost, path, reqBody)
+	if err != nil {
+		return nil, fmt.Errorf("OPNsense client error: %s", err)
+	}
+
+	switch httpResp.StatusCode {
+	case 200:
+	case 404:
+		return []categoryUsage{}, nil
+	default:
+		return nil, fmt.Errorf("Search %s usage error (http): abnormal status code %d in HTTP response. Please contact the provider for assistance", resourceName, httpResp.StatusCode)
+	}
+
+	var response searchCategoryUsageResponse
+	err = json.NewDecoder(httpResp.Body).Decode(&response)
+	if err != nil {
+		return nil, fmt.Errorf("Search %s usage error (http): %s", resourceName, err)
+	}
+
+	usage := []categoryUsage{}
+	for _, row := range response.Rows {
+		// Depending on the OPNsense version, search results contain either the category uuids or names
+		categories, _ := row[source.Field].(string)
+		referenced := false
+		for _, value := range strings.Split(categories, ",") {
+			value = strings.TrimSpace(value)
+			if value != "" && (value == uuid || value == name) {
+				referenced = true
+				break
+			}
+		}
+		if !referenced {
+			continue
+		}
+
+		id, _ := row["uuid"].(string)
+		description, _ := row[source.DescriptionField].(string)
+		usage = append(usage, categoryUsage{
+			Type:        source.Type,
+			Id:          id,
+			Description: description,
+		})
+	}
+
+	return usage, nil
+}
+
+// removeCategoryFromObject removes the category with a matching uuid from the object of the specified source with a matching object uuid.
+func removeCategoryFromObject(client *opnsense.Client, source categoryUsageSource, objectUuid string, uuid string) error {
+	path := fmt.Sprintf("%s/%s/%s/%s", firewall.Module, source.Controller, source.GetCommand, objectUuid)
+
+	httpResp, err := client.DoRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return fmt.Errorf("OPNsense client error: %s", err)
+	}
+
+	if httpResp.StatusCode != 200 {
+		return fmt.Errorf("Detach %s error (http): abnormal status code %d in HTTP response. Please contact the provider for assistance", resourceName, httpResp.StatusCode)
+	}
+
+	var getResponse map[string]map[string]json.RawMessage
+	err = json.NewDecoder(httpResp.Body).Decode(&getResponse)
+	if err != nil {
+		return fmt.Errorf("Detach %s error (http): %s", resourceName, err)
+	}
+
+	var options categoryOptions
+	if raw, exists := getResponse[source.Object][source.Field]; exists {
+		err = json.Unmarshal(raw, &options)
+		if err != nil {
+			return fmt.Errorf("Detach %s error (http): %s", resourceName, err)
+		}
+	}
+
+	// Keep all other selected categories
+	categories := utils.NewSet()
+	for categoryUuid, option := range options {
+		if option.Selected == 1 && categoryUuid != uuid {
+			categories.Add(categoryUuid)
+		}
+	}
+
+	// Only the category field is sent, OPNsense keeps all other fields of the object
+	path = fmt.Sprintf("%s/%s/%s/%s", firewall.Module, source.Controller, source.SetCommand, objectUuid)
+	reqBody, err := json.Marshal(map[string]map[string]string{
+		source.Object: {source.Field: strings.Join(categories.Elements(), ",")},
+	})
+	if err != nil {
+		return fmt.Errorf("Detach %s error: failed to marshal json body - %s", resourceName, err)
+	}
+
+	httpResp, err = client.DoRequest(http.MethodPost, path, reqBody)
+	if err != nil {
+		return fmt.Errorf("OPNsense client error: %s", err)
+	}
+
+	if httpResp.StatusCode != 200 {
+		return fmt.Errorf("Detach %s error (http): abnormal status code %d in HTTP response. Please contact the provider for assistance", resourceName, httpResp.StatusCode)
+	}
+
+	var response opnsense.OpnsenseAddItemResponse
+	err = json.NewDecoder(httpResp.Body).Decode(&response)
+	if err != nil {
+		return fmt.Errorf("Detach %s error (http): failed to decode http response - %s", resourceName, err)
+	}
+
+	if strings.ToLower(response.Result) == "failed" {
+		return fmt.Errorf("Detach %[1]s error: failed to remove %[1]s from %s `%s` on OPNsense - failed validations:\n%s", resourceName, source.Type, objectUuid, opnsense.ValidationsToString(response.Validations))
+	}
+
+	return nil
+}
+
+// applyCategoryUsageSource applies the configuration of the objects of the specified source on the OPNsense firewall.
+func applyCategoryUsageSource(client *opnsense.Client, source categoryUsageSource) error {
+	path := fmt.Sprintf("%s/%s/%s", firewall.Module, source.Controller, source.ApplyCommand)
+
+	// Generate empty body
+	reqBody, err := json.Marshal(nil)
+	if err != nil {
+		return fmt.Errorf("Apply %s configuration error: failed to marshal json body - %s", source.Type, err)
+	}
+
+	httpResp, err := client.DoRequest(http.MethodPost, path, reqBody)
+	if err != nil {
+		return fmt.Errorf("OPNsense client error: %s", err)
+	}
+
+	if httpResp.StatusCode != 200 {
+		return fmt.Errorf("Apply %s configuration error (http): abnormal status code %d in HTTP response. Please contact the provider for assistance", source.Type, httpResp.StatusCode)
+	}
+
+	return nil
+}
+
+// getCategoryContentHash gets the content hash of the category from OPNsense.
+func getCategoryContentHash(client *opnsense.Client, uuid string) (string, error) {
+	object, err := GetCategory(client, uuid)
+	if err != nil {
+		return "", err
+	}
+
+	return utils.ContentHash(object)
+}
@@ -3,8 +3,11 @@ package category
 import (
 	"context"
 	"fmt"
+	"strings"
+	"terraform-provider-opnsense/internal/customtypes"
 	"terraform-provider-opnsense/internal/opnsense"
 	"terraform-provider-opnsense/internal/opnsense/firewall"
+	"terraform-provider-opnsense/internal/utils"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -33,10 +36,12 @@ type categoryDataSource struct {
 
 // categoryDataSourceModel describes the data source data model.
 type categoryDataSourceModel struct {
-	Id    types.String `tfsdk:"id"`
-	Name  types.String `tfsdk:"name"`
-	Auto  types.Bool   `tfsdk:"auto"`
-	Color types.String `tfsdk:"color"`
+	Id       types.String         `tfsdk:"id"`
+	Name     types.String         `tfsdk:"name"`
+	Auto     types.Bool           `tfsdk:"auto"`
+	Color    types.String         `tfsdk:"color"`
+	ColorHex types.String         `tfsdk:"color_hex"`
+	Usage    []categoryUsageModel `tfsdk:"usage"`
 }
 
 // Metadata returns the data source type name.
@@ -69,8 +74,38 @@ func (d *categoryDataSource) Schema(ctx context.Context, req datasource.SchemaRe
 				Description: "Whether the category is automatically added (i.e will be removed when unused).",
 			},
 			"color": schema.StringAttribute{
-				Computed:    true,
-				Description: "The hex color code to be used for the category tag.",
+				Computed:            true,
+				MarkdownDescription: "The hex color code to be used for the category tag, in lowercase without a leading `#` (e.g `ff0000`), as stored by OPNsense. Empty if the category has no color.",
+			},
+			"color_hex": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The hex color code to be used for the category tag, in lowercase with a leading `#` (e.g `#ff0000`), as used by most other tooling. Empty if the category has no color.",
+			},
+			"usage": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The aliases, filter rules & NAT rules referencing the category. Traffic shaper objects do not support categories on OPNsense.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							Computed: true,
+							MarkdownDescription: fmt.Sprintf("The type of the object. One of: %s.", strings.Join(
+								// Surround each type with backticks (`)
+								utils.SliceMap(getCategoryUsageTypes(), func(usageType string) string {
+									return fmt.Sprintf("`%s`", usageType)
+								}),
+								", ",
+							)),
+						},
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Identifier of the object.",
+						},
+						"description": schema.StringAttribute{
+							Computed:    true,
+							Description: "The name of the alias or the description of the rule.",
+						},
+					},
+				},
 			},
 		},
 	}
@@ -147,7 +182,20 @@ func (d *categoryDataSource) Read(ctx context.Context, req datasource.ReadReques
 
 	data.Name = types.StringValue(category.Name)
 	data.Auto = types.BoolValue(category.Auto)
-	data.Color = types.StringValue(category.Color)
+	// Return colors in a consistent form, invalid colors set outside of Terraform are returned as is
+	color, _ := customtypes.NormaliseColor(category.Color)
+	data.Color = types.StringValue(color)
+	data.ColorHex = types.StringValue("")
+	if color != "" {
+		data.ColorHex = types.StringValue(fmt.Sprintf("#%s", color))
+	}
+
+	// Get objects referencing the category
+	usage, err := getCategoryUsage(d.client, data.Id.ValueString(), category.Name)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Get %s error", resourceName), fmt.Sprintf("%s", err))
+	}
+	data.Usage = categoryUsageToModel(usage)
 
 	if resp.Diagnostics.HasError() {
 		return
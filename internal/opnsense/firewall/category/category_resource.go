@@ -4,10 +4,14 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"strings"
+	"terraform-provider-opnsense/internal/customtypes"
 	"terraform-provider-opnsense/internal/opnsense"
 	"terraform-provider-opnsense/internal/opnsense/firewall"
+	"terraform-provider-opnsense/internal/utils"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -21,9 +25,10 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &categoryResource{}
-	_ resource.ResourceWithConfigure   = &categoryResource{}
-	_ resource.ResourceWithImportState = &categoryResource{}
+	_ resource.Resource                 = &categoryResource{}
+	_ resource.ResourceWithConfigure    = &categoryResource{}
+	_ resource.ResourceWithImportState  = &categoryResource{}
+	_ resource.ResourceWithUpgradeState = &categoryResource{}
 )
 
 // NewCategoryResource is a helper function to simplify the provider implementation.
@@ -38,11 +43,30 @@ type categoryResource struct {
 
 // categoryResourceModel describes the resource data model.
 type categoryResourceModel struct {
+	Id          types.String           `tfsdk:"id"`
+	LastUpdated types.String           `tfsdk:"last_updated"`
+	ContentHash types.String           `tfsdk:"content_hash"`
+	Name        types.String           `tfsdk:"name"`
+	Auto        types.Bool             `tfsdk:"auto"`
+	Color       customtypes.ColorValue `tfsdk:"color"`
+	ForceDetach types.Bool             `tfsdk:"force_detach"`
+	Usage       types.List             `tfsdk:"usage"`
+}
+
+// categoryUsageModel describes an OPNsense object referencing the category.
+type categoryUsageModel struct {
+	Type        types.String `tfsdk:"type"`
 	Id          types.String `tfsdk:"id"`
-	LastUpdated types.String `tfsdk:"last_updated"`
-	Name        types.String `tfsdk:"name"`
-	Auto        types.Bool   `tfsdk:"auto"`
-	Color       types.String `tfsdk:"color"`
+	Description types.String `tfsdk:"description"`
+}
+
+// getCategoryUsageAttrTypes returns the attribute types of an object referencing the category.
+func getCategoryUsageAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"type":        types.StringType,
+		"id":          types.StringType,
+		"description": types.StringType,
+	}
 }
 
 // Metadata returns the resource type name.
@@ -53,6 +77,7 @@ func (r *categoryResource) Metadata(ctx context.Context, req resource.MetadataRe
 // Schema defines the schema for the resource.
 func (r *categoryResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:     1,
 		Description: "To ease maintenance of larger rulesets, OPNsense includes categories for the firewall. Each rule can contain one or more categories, which can be filtered on top of each firewall rule page.",
 
 		Attributes: map[string]schema.Attribute{
@@ -64,8 +89,13 @@ func (r *categoryResource) Schema(ctx context.Context, req resource.SchemaReques
 				},
 			},
 			"last_updated": schema.StringAttribute{
+				Computed:           true,
+				Description:        fmt.Sprintf("DateTime when the %s was last updated.", resourceName),
+				DeprecationMessage: "Use `content_hash` to detect changes to this resource instead. This attribute will be removed in a future release.",
+			},
+			"content_hash": schema.StringAttribute{
 				Computed:    true,
-				Description: fmt.Sprintf("DateTime when the %s was last updated.", resourceName),
+				Description: fmt.Sprintf("Hash of the %s configuration on OPNsense. Changes whenever the configuration is modified, including changes made outside of Terraform.", resourceName),
 			},
 			"name": schema.StringAttribute{
 				Required:    true,
@@ -78,10 +108,52 @@ func (r *categoryResource) Schema(ctx context.Context, req resource.SchemaReques
 				Default:     booldefault.StaticBool(false),
 			},
 			"color": schema.StringAttribute{
-				Optional:    true,
-				Computed:    true,
-				Description: "The hex color code to be used for the category tag.",
-				Default:     stringdefault.StaticString(""),
+				CustomType: customtypes.ColorType{},
+				Optional:   true,
+				Computed:   true,
+				MarkdownDescription: fmt.Sprintf(
+					"The color to be used for the category tag. Must be a hex color code (e.g `#ff0000` or `ff0000`, case-insensitive) or one of the palette colors: %s. Defaults to `\"\"` (no color).",
+					strings.Join(
+						// Surround each color with backticks (`)
+						utils.SliceMap(customtypes.GetColorPaletteNames(), func(color string) string {
+							return fmt.Sprintf("`%s`", color)
+						}),
+						", ",
+					),
+				),
+				Default: stringdefault.StaticString(""),
+			},
+			"force_detach": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Whether to remove the category from all objects referencing it before deleting the category. If `false`, deleting a category that is still in use fails. Defaults to `false`.",
+				Default:             booldefault.StaticBool(false),
+			},
+			"usage": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The aliases, filter rules & NAT rules referencing the category. Traffic shaper objects do not support categories on OPNsense.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							Computed: true,
+							MarkdownDescription: fmt.Sprintf("The type of the object. One of: %s.", strings.Join(
+								// Surround each type with backticks (`)
+								utils.SliceMap(getCategoryUsageTypes(), func(usageType string) string {
+									return fmt.Sprintf("`%s`", usageType)
+								}),
+								", ",
+							)),
+						},
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Identifier of the object.",
+						},
+						"description": schema.StringAttribute{
+							Computed:    true,
+							Description: "The name of the alias or the description of the rule.",
+						},
+					},
+				},
 			},
 		},
 	}
@@ -133,6 +205,19 @@ func (r *categoryResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
+	// Get content hash from OPNsense
+	contentHash, err := getCategoryContentHash(r.client, uuid)
+	contentHashValue, diags := utils.ContentHashValue(fmt.Sprintf("Create %s error", resourceName), contentHash, err)
+	resp.Diagnostics.Append(diags...)
+	plan.ContentHash = contentHashValue
+
+	// Get objects referencing the category from OPNsense
+	usage, err := getCategoryUsageList(ctx, r.client, uuid, category.Name)
+	if err != nil {
+		resp.Diagnostics.AddWarning(fmt.Sprintf("Create %s error", resourceName), fmt.Sprintf("Unable to get usage: %s", err))
+	}
+	plan.Usage = usage
+
 	// Update plan ID & last_updated fields
 	plan.Id = types.StringValue(uuid)
 	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
@@ -172,9 +257,25 @@ func (r *categoryResource) Read(ctx context.Context, req resource.ReadRequest, r
 	tflog.Debug(ctx, fmt.Sprintf("Successfully got %s information", resourceName), map[string]any{"success": true})
 
 	// Overwite items with refreshed state
+	contentHash, err := utils.ContentHash(category)
+	contentHashValue, diags := utils.ContentHashValue(fmt.Sprintf("Read %s error", resourceName), contentHash, err)
+	resp.Diagnostics.Append(diags...)
+	state.ContentHash = contentHashValue
+
 	state.Name = types.StringValue(category.Name)
 	state.Auto = types.BoolValue(category.Auto)
-	state.Color = types.StringValue(category.Color)
+	state.Color = customtypes.NewColorValue(category.Color)
+
+	usage, err := getCategoryUsageList(ctx, r.client, state.Id.ValueString(), category.Name)
+	if err != nil {
+		resp.Diagnostics.AddWarning(fmt.Sprintf("Read %s error", resourceName), fmt.Sprintf("Unable to get usage: %s", err))
+	}
+	state.Usage = usage
+
+	// Default provider-side settings, e.g. after import
+	if state.ForceDetach.IsNull() {
+		state.ForceDetach = types.BoolValue(false)
+	}
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
@@ -217,6 +318,19 @@ func (r *categoryResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
+	// Get content hash from OPNsense
+	contentHash, err := getCategoryContentHash(r.client, state.Id.ValueString())
+	contentHashValue, diags := utils.ContentHashValue(fmt.Sprintf("Update %s error", resourceName), contentHash, err)
+	resp.Diagnostics.Append(diags...)
+	plan.ContentHash = contentHashValue
+
+	// Get objects referencing the category from OPNsense
+	usage, err := getCategoryUsageList(ctx, r.client, state.Id.ValueString(), category.Name)
+	if err != nil {
+		resp.Diagnostics.AddWarning(fmt.Sprintf("Update %s error", resourceName), fmt.Sprintf("Unable to get usage: %s", err))
+	}
+	plan.Usage = usage
+
 	// Update last_updated field (if change detected)
 	if !(reflect.DeepEqual(plan, state)) {
 		plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
@@ -242,13 +356,50 @@ func (r *categoryResource) Delete(ctx context.Context, req resource.DeleteReques
 		return
 	}
 
-	// Delete alias on OPNsense
+	// Check whether the category is still in use
+	tflog.Debug(ctx, fmt.Sprintf("Getting %s usage", resourceName), map[string]any{"uuid": state.Id.ValueString()})
+
+	usage, err := getCategoryUsage(r.client, state.Id.ValueString(), state.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Delete %s error", resourceName), fmt.Sprintf("%s", err))
+		return
+	}
+
+	if len(usage) > 0 {
+		if !state.ForceDetach.ValueBool() {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Delete %s error", resourceName),
+				fmt.Sprintf("Category `%s` is still referenced by the following objects:\n%sRemove the category from these objects, or set `force_detach` to `true` to remove it automatically.", state.Name.ValueString(), categoryUsageToString(usage)),
+			)
+			return
+		}
+
+		// Remove category from all objects referencing it
+		tflog.Debug(ctx, fmt.Sprintf("Detaching %s from objects on OPNsense", resourceName), map[string]any{"count": len(usage)})
+
+		applyErrors, err := detachCategory(r.client, state.Id.ValueString(), usage)
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Delete %s error", resourceName), fmt.Sprintf("%s", err))
+			return
+		}
+		for _, applyErr := range applyErrors {
+			resp.Diagnostics.AddWarning(fmt.Sprintf("Delete %s error", resourceName), fmt.Sprintf("%s", applyErr))
+		}
+
+		tflog.Debug(ctx, fmt.Sprintf("Successfully detached %s from objects on OPNsense", resourceName), map[string]any{"success": true})
+	}
+
+	// Delete category on OPNsense
 	tflog.Debug(ctx, fmt.Sprintf("Deleting %s on OPNsense", resourceName), map[string]any{"uuid": state.Id.ValueString()})
 
-	err := deleteCategory(r.client, state.Id.ValueString())
+	err = deleteCategory(r.client, state.Id.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(fmt.Sprintf("Delete %s error", resourceName), fmt.Sprintf("%s", err))
 	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	tflog.Info(ctx, fmt.Sprintf("Successfully deleted %s", resourceName))
 }
 
@@ -1,6 +1,7 @@
 package category_test
 
 import (
+	"regexp"
 	"testing"
 
 	"terraform-provider-opnsense/internal/acctest"
@@ -31,7 +32,7 @@ func TestAccCategoryResource(t *testing.T) {
 				ImportState:             true,
 				ImportStateId:           "test_acc_category_resource",
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"last_updated"},
+				ImportStateVerifyIgnore: []string{"last_updated", "force_detach"},
 			},
 			// Update and Read testing
 			{
@@ -47,6 +48,66 @@ func TestAccCategoryResource(t *testing.T) {
 	})
 }
 
+func TestAccCategoryResource_usage(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccCategoryResourceConfig_usage,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("opnsense_firewall_category.test_acc_resource_usage", tfjsonpath.New("force_detach"), knownvalue.Bool(true)),
+					statecheck.ExpectKnownValue("data.opnsense_firewall_category.test_acc_resource_usage", tfjsonpath.New("usage"), knownvalue.ListExact([]knownvalue.Check{
+						knownvalue.ObjectPartial(map[string]knownvalue.Check{
+							"type":        knownvalue.StringExact("alias"),
+							"description": knownvalue.StringExact("test_acc_category_usage"),
+						}),
+					})),
+				},
+			},
+			// Refresh testing, usage is updated on read
+			{
+				Config: testAccCategoryResourceConfig_usage,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("opnsense_firewall_category.test_acc_resource_usage", tfjsonpath.New("usage"), knownvalue.ListSizeExact(1)),
+				},
+			},
+		},
+	})
+}
+
+func TestAccCategoryResource_color(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing (hex color, normalised on OPNsense)
+			{
+				Config: testAccCategoryResourceConfig_colorHex,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("opnsense_firewall_category.test_acc_resource_color", tfjsonpath.New("color"), knownvalue.StringExact("#FF0000")),
+					statecheck.ExpectKnownValue("data.opnsense_firewall_category.test_acc_resource_color", tfjsonpath.New("color"), knownvalue.StringExact("ff0000")),
+					statecheck.ExpectKnownValue("data.opnsense_firewall_category.test_acc_resource_color", tfjsonpath.New("color_hex"), knownvalue.StringExact("#ff0000")),
+				},
+			},
+			// Update and Read testing (palette color)
+			{
+				Config: testAccCategoryResourceConfig_colorPalette,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("opnsense_firewall_category.test_acc_resource_color", tfjsonpath.New("color"), knownvalue.StringExact("blue")),
+					statecheck.ExpectKnownValue("data.opnsense_firewall_category.test_acc_resource_color", tfjsonpath.New("color"), knownvalue.StringExact("0000ff")),
+				},
+			},
+			// Invalid color testing
+			{
+				Config:      testAccCategoryResourceConfig_colorInvalid,
+				ExpectError: regexp.MustCompile("Invalid Color"),
+			},
+		},
+	})
+}
+
 // testAccCategoryResourceConfig defines a category resource.
 const testAccCategoryResourceConfig = `
 	resource "opnsense_firewall_category" "test_acc_resource" {
@@ -62,3 +123,56 @@ const testAccCategoryResourceConfig_modified = `
 		name  = "test_acc_category_resource"
 	}
 `
+
+// testAccCategoryResourceConfig_usage defines a category resource referenced by an alias.
+const testAccCategoryResourceConfig_usage = `
+	resource "opnsense_firewall_category" "test_acc_resource_usage" {
+		name         = "test_acc_category_usage"
+		force_detach = true
+	}
+
+	resource "opnsense_firewall_alias" "test_acc_resource_usage" {
+		name       = "test_acc_category_usage"
+		type       = "host"
+		content    = ["10.0.0.1"]
+		categories = [opnsense_firewall_category.test_acc_resource_usage.name]
+	}
+
+	data "opnsense_firewall_category" "test_acc_resource_usage" {
+		name = opnsense_firewall_category.test_acc_resource_usage.name
+
+		depends_on = [opnsense_firewall_alias.test_acc_resource_usage]
+	}
+`
+
+// testAccCategoryResourceConfig_colorHex defines a category resource with an uppercase hex color.
+const testAccCategoryResourceConfig_colorHex = `
+	resource "opnsense_firewall_category" "test_acc_resource_color" {
+		name  = "test_acc_category_color"
+		color = "#FF0000"
+	}
+
+	data "opnsense_firewall_category" "test_acc_resource_color" {
+		id = opnsense_firewall_category.test_acc_resource_color.id
+	}
+`
+
+// testAccCategoryResourceConfig_colorPalette defines a category resource with a palette color.
+const testAccCategoryResourceConfig_colorPalette = `
+	resource "opnsense_firewall_category" "test_acc_resource_color" {
+		name  = "test_acc_category_color"
+		color = "blue"
+	}
+
+	data "opnsense_firewall_category" "test_acc_resource_color" {
+		id = opnsense_firewall_category.test_acc_resource_color.id
+	}
+`
+
+// testAccCategoryResourceConfig_colorInvalid defines a category resource with an invalid color.
+const testAccCategoryResourceConfig_colorInvalid = `
+	resource "opnsense_firewall_category" "test_acc_resource_color" {
+		name  = "test_acc_category_color"
+		color = "not_a_color"
+	}
+`
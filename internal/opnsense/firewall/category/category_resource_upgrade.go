@@ -0,0 +1,58 @@
+package category
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-opnsense/internal/customtypes"
+	"terraform-provider-opnsense/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// categoryResourceModelV0 describes the resource data model of schema version 0.
+type categoryResourceModelV0 struct {
+	Id          *string `json:"id"`
+	LastUpdated *string `json:"last_updated"`
+	Name        *string `json:"name"`
+	Auto        *bool   `json:"auto"`
+	Color       *string `json:"color"`
+}
+
+// UpgradeState upgrades the resource state from prior schema versions to the current schema version.
+func (r *categoryResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {StateUpgrader: upgradeCategoryStateV0},
+	}
+}
+
+// upgradeCategoryStateV0 upgrades the resource state from schema version 0.
+func upgradeCategoryStateV0(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	tflog.Info(ctx, fmt.Sprintf("Upgrading %s state from version 0", resourceName))
+
+	var priorState categoryResourceModelV0
+	err := utils.DecodeRawState(req, &priorState)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Upgrade %s state error", resourceName), fmt.Sprintf("%s", err))
+		return
+	}
+
+	state := categoryResourceModel{
+		Id:          utils.StringOrNull(priorState.Id),
+		LastUpdated: utils.StringOrNull(priorState.LastUpdated),
+		Name:        utils.StringOrNull(priorState.Name),
+		Auto:        utils.BoolOrDefault(priorState.Auto, false),
+		Color:       customtypes.ColorValue{StringValue: utils.StringOrDefault(priorState.Color, "")},
+		ForceDetach: types.BoolValue(false),
+		Usage:       types.ListNull(types.ObjectType{AttrTypes: getCategoryUsageAttrTypes()}),
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Successfully upgraded %s state from version 0", resourceName))
+}
@@ -0,0 +1,26 @@
+package category_test
+
+import (
+	"terraform-provider-opnsense/internal/acctest"
+	"terraform-provider-opnsense/internal/opnsense/firewall/category"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestCategoryResource_upgradeState(t *testing.T) {
+	acctest.RunStateUpgradeTests(t, []acctest.StateUpgradeTestCase{
+		{
+			Name:      "v0 defaults",
+			Resource:  category.NewCategoryResource(),
+			Version:   0,
+			StateFile: "testdata/category_resource_v0.json",
+			Expected: map[string]attr.Value{
+				"auto":         types.BoolValue(false),
+				"color":        types.StringValue(""),
+				"force_detach": types.BoolValue(false),
+			},
+		},
+	})
+}
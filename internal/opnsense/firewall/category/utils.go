@@ -4,9 +4,11 @@ import (
 	"context"
 	"fmt"
 
+	"terraform-provider-opnsense/internal/customtypes"
 	"terraform-provider-opnsense/internal/opnsense"
 	"terraform-provider-opnsense/internal/utils"
 
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
@@ -22,16 +24,65 @@ type category struct {
 	Color string
 }
 
+type categoryUsage struct {
+	Type        string
+	Id          string
+	Description string
+}
+
+// categoryUsageSource describes an OPNsense object type that can reference categories.
+type categoryUsageSource struct {
+	// Type reported in the category usage.
+	Type string
+	// OPNsense controller of the objects.
+	Controller string
+	// Key of the object in get & set requests.
+	Object string
+	// Field holding the category uuids of the object.
+	Field string
+	// Field used as description of the object in the category usage.
+	DescriptionField string
+
+	SearchCommand opnsense.Command
+	GetCommand    opnsense.Command
+	SetCommand    opnsense.Command
+	ApplyCommand  opnsense.Command
+}
+
+// getCategoryUsageSources returns the OPNsense object types that can reference categories. Traffic shaper objects do not support categories on OPNsense.
+func getCategoryUsageSources() []categoryUsageSource {
+	return []categoryUsageSource{
+		{Type: "alias", Controller: "alias", Object: "alias", Field: "categories", DescriptionField: "name", SearchCommand: "searchItem", GetCommand: "getItem", SetCommand: "setItem", ApplyCommand: "reconfigure"},
+		{Type: "filter_rule", Controller: "filter", Object: "rule", Field: "categories", DescriptionField: "description", SearchCommand: "search_rule", GetCommand: "get_rule", SetCommand: "set_rule", ApplyCommand: "apply"},
+		{Type: "source_nat_rule", Controller: "source_nat", Object: "rule", Field: "categories", DescriptionField: "description", SearchCommand: "search_rule", GetCommand: "get_rule", SetCommand: "set_rule", ApplyCommand: "apply"},
+		{Type: "port_forward_rule", Controller: "d_nat", Object: "rule", Field: "category", DescriptionField: "descr", SearchCommand: "search_rule", GetCommand: "get_rule", SetCommand: "set_rule", ApplyCommand: "apply"},
+		{Type: "one_to_one_nat_rule", Controller: "one_to_one", Object: "rule", Field: "categories", DescriptionField: "description", SearchCommand: "search_rule", GetCommand: "get_rule", SetCommand: "set_rule", ApplyCommand: "apply"},
+		{Type: "npt_rule", Controller: "npt", Object: "rule", Field: "categories", DescriptionField: "description", SearchCommand: "search_rule", GetCommand: "get_rule", SetCommand: "set_rule", ApplyCommand: "apply"},
+	}
+}
+
+// getCategoryUsageTypes returns the object types reported in the category usage.
+func getCategoryUsageTypes() []string {
+	usageTypes := []string{}
+	for _, source := range getCategoryUsageSources() {
+		usageTypes = append(usageTypes, source.Type)
+	}
+	return usageTypes
+}
+
 // Helper functions
 // createCategory creates a category based on the specified plan.
 func createCategory(ctx context.Context, plan categoryResourceModel) category {
 	// Create category from plan
 	tflog.Debug(ctx, "Creating category object from plan", map[string]any{"plan": plan})
 
+	// Colors are validated at plan time, normalise to the form stored by OPNsense
+	color, _ := customtypes.NormaliseColor(plan.Color.ValueString())
+
 	category := category{
 		Name:  plan.Name.ValueString(),
 		Auto:  plan.Auto.ValueBool(),
-		Color: plan.Color.ValueString(),
+		Color: color,
 	}
 
 	tflog.Debug(ctx, fmt.Sprintf("Successfully created %s object from plan", resourceName), map[string]any{"success": true})
@@ -56,3 +107,83 @@ func GetCategoryUuids(client *opnsense.Client, categoriesList *utils.Set) (*util
 	}
 	return categoryUuids, nil
 }
+
+// getCategoryUsage gets the OPNsense objects referencing the category with the specified uuid & name.
+func getCategoryUsage(client *opnsense.Client, uuid string, name string) ([]categoryUsage, error) {
+	usage := []categoryUsage{}
+	for _, source := range getCategoryUsageSources() {
+		objects, err := searchCategoryUsage(client, source, uuid, name)
+		if err != nil {
+			return nil, err
+		}
+		usage = append(usage, objects...)
+	}
+	return usage, nil
+}
+
+// detachCategory removes the category with the specified uuid from all OPNsense objects in the specified usage & applies the configuration of the affected object types.
+// Returns the errors of applying the configuration separately, as the category is already detached at that point.
+func detachCategory(client *opnsense.Client, uuid string, usage []categoryUsage) ([]error, error) {
+	applyErrors := []error{}
+	for _, source := range getCategoryUsageSources() {
+		detached := false
+		for _, object := range usage {
+			if object.Type != source.Type {
+				continue
+			}
+
+			err := removeCategoryFromObject(client, source, object.Id, uuid)
+			if err != nil {
+				return nil, err
+			}
+			detached = true
+		}
+
+		if detached {
+			err := applyCategoryUsageSource(client, source)
+			if err != nil {
+				applyErrors = append(applyErrors, err)
+			}
+		}
+	}
+	return applyErrors, nil
+}
+
+// categoryUsageToString formats the specified category usage as a list, one object per line.
+func categoryUsageToString(usage []categoryUsage) string {
+	var result string
+	for _, object := range usage {
+		result += fmt.Sprintf("  %s `%s` (%s)\n", object.Type, object.Description, object.Id)
+	}
+	return result
+}
+
+// categoryUsageToModel converts the specified category usage to the Terraform data model.
+func categoryUsageToModel(usage []categoryUsage) []categoryUsageModel {
+	models := []categoryUsageModel{}
+	for _, object := range usage {
+		models = append(models, categoryUsageModel{
+			Type:        types.StringValue(object.Type),
+			Id:          types.StringValue(object.Id),
+			Description: types.StringValue(object.Description),
+		})
+	}
+	return models
+}
+
+// getCategoryUsageList gets the OPNsense objects referencing the category with the specified uuid & name as a Terraform list. Returns a null list on error.
+func getCategoryUsageList(ctx context.Context, client *opnsense.Client, uuid string, name string) (types.List, error) {
+	elementType := types.ObjectType{AttrTypes: getCategoryUsageAttrTypes()}
+
+	usage, err := getCategoryUsage(client, uuid, name)
+	if err != nil {
+		return types.ListNull(elementType), err
+	}
+
+	list, diags := types.ListValueFrom(ctx, elementType, categoryUsageToModel(usage))
+	if diags.HasError() {
+		return types.ListNull(elementType), fmt.Errorf("%v", diags)
+	}
+
+	return list, nil
+}
@@ -21,6 +21,11 @@ const (
 	applyConfigCommand opnsense.Command = "reconfigure"
 )
 
+// HTTP errors
+
+// errGroupNotExist is returned when a group with the requested name does not exist.
+var errGroupNotExist = errors.New(fmt.Sprintf("Search %[1]s error: %[1]s does not exist", resourceName))
+
 // HTTP request bodies
 
 type groupHttpBody struct {
@@ -124,7 +129,7 @@ func searchGroup(client *opnsense.Client, name string) (string, error) {
 		}
 	}
 
-	return "", errors.New(fmt.Sprintf("Search %[1]s error: %[1]s does not exist", resourceName))
+	return "", errGroupNotExist
 }
 
 // getGroup searches the OPNsense firewall for the group with a matching UUID.
@@ -296,3 +301,13 @@ func applyConfig(client *opnsense.Client) error {
 	}
 	return nil
 }
+
+// getGroupContentHash gets the content hash of the group from OPNsense.
+func getGroupContentHash(client *opnsense.Client, uuid string) (string, error) {
+	object, err := getGroup(client, uuid)
+	if err != nil {
+		return "", err
+	}
+
+	return utils.ContentHash(object)
+}
@@ -0,0 +1,310 @@
+package group
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"terraform-provider-opnsense/internal/opnsense"
+	"terraform-provider-opnsense/internal/opnsense/firewall"
+	"terraform-provider-opnsense/internal/opnsense/interfaces/overview"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &groupMemberResource{}
+	_ resource.ResourceWithConfigure   = &groupMemberResource{}
+	_ resource.ResourceWithImportState = &groupMemberResource{}
+)
+
+// NewGroupMemberResource is a helper function to simplify the provider implementation.
+func NewGroupMemberResource() resource.Resource {
+	return &groupMemberResource{}
+}
+
+// groupMemberResource defines the resource implementation.
+type groupMemberResource struct {
+	client *opnsense.Client
+}
+
+// groupMemberResourceModel describes the resource data model.
+type groupMemberResourceModel struct {
+	Id        types.String `tfsdk:"id"`
+	Group     types.String `tfsdk:"group"`
+	Interface types.String `tfsdk:"interface"`
+}
+
+// Metadata returns the resource type name.
+func (r *groupMemberResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_%s_%s_member", req.ProviderTypeName, firewall.TypeName, controller)
+}
+
+// Schema defines the schema for the resource.
+func (r *groupMemberResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the membership of a single interface in an existing firewall interface group, without managing the group itself. " +
+			"This allows several configurations to each add their own interfaces to a shared group. " +
+			"Do not use this resource together with the `members` attribute of `opnsense_firewall_group` for the same group, as they will overwrite each other. " +
+			"If the group is managed by Terraform, add `lifecycle { ignore_changes = [members] }` to the group resource.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: fmt.Sprintf("Identifier of the %s, in the format `<group>/<interface>`.", memberResourceName),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"group": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the group to add the interface to. The group must already exist.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"interface": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The identifier of the interface to add to the group (e.g `lan` or `opt1`). The interface must already exist.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *groupMemberResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*opnsense.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *opnsense.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *groupMemberResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, fmt.Sprintf("Creating %s", memberResourceName))
+
+	// Read Terraform plan data into the model
+	var plan groupMemberResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Check group & interface exist
+	uuid, err := searchGroup(r.client, plan.Group.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Create %s error", memberResourceName), fmt.Sprintf("%s", err))
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ifaceExists, err := overview.VerifyInterface(r.client, plan.Interface.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Create %s error", memberResourceName), fmt.Sprintf("%s", err))
+	} else if !ifaceExists {
+		resp.Diagnostics.AddError(fmt.Sprintf("Create %s error", memberResourceName), fmt.Sprintf("Interface `%s` does not exist. Please verify that the specified interface exists on your OPNsense firewall", plan.Interface.ValueString()))
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Add interface to group on OPNsense
+	tflog.Debug(ctx, fmt.Sprintf("Adding %s on OPNsense", memberResourceName), map[string]any{"group": plan.Group.ValueString(), "interface": plan.Interface.ValueString()})
+
+	changed, err := setGroupMember(r.client, uuid, plan.Interface.ValueString(), true)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Create %s error", memberResourceName), fmt.Sprintf("%s", err))
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Successfully added %s on OPNsense", memberResourceName), map[string]any{"changed": changed})
+
+	// Apply configuration if the group was changed
+	if changed {
+		err = applyConfig(r.client)
+		if err != nil {
+			resp.Diagnostics.AddWarning(fmt.Sprintf("Create %s error", memberResourceName), fmt.Sprintf("%s", err))
+		} else {
+			tflog.Debug(ctx, "Successfully applied configuration on OPNsense", map[string]any{"success": true})
+		}
+	}
+
+	plan.Id = types.StringValue(fmt.Sprintf("%s/%s", plan.Group.ValueString(), plan.Interface.ValueString()))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Successfully created %s", memberResourceName))
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *groupMemberResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, fmt.Sprintf("Reading %s", memberResourceName))
+
+	// Read Terraform prior state data into the model
+	var state groupMemberResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get group
+	tflog.Debug(ctx, fmt.Sprintf("Getting %s information", resourceName), map[string]any{"group": state.Group.ValueString()})
+
+	uuid, err := searchGroup(r.client, state.Group.ValueString())
+	if errors.Is(err, errGroupNotExist) {
+		tflog.Warn(ctx, fmt.Sprintf("%s not found, removing %s from state", resourceName, memberResourceName), map[string]any{"group": state.Group.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Read %s error", memberResourceName), fmt.Sprintf("%s", err))
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	group, err := getGroup(r.client, uuid)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Read %s error", memberResourceName), fmt.Sprintf("%s", err))
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Successfully got %s information", resourceName), map[string]any{"success": true})
+
+	// Remove member from state if the interface is no longer in the group
+	if !group.Members.Contains(state.Interface.ValueString()) {
+		tflog.Warn(ctx, fmt.Sprintf("%s not found in %s, removing from state", memberResourceName, resourceName), map[string]any{"group": state.Group.ValueString(), "interface": state.Interface.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.Id = types.StringValue(fmt.Sprintf("%s/%s", state.Group.ValueString(), state.Interface.ValueString()))
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Successfully read %s", memberResourceName))
+}
+
+// Update updates the resource on OPNsense and the Terraform state. All attributes require replacement, so only the state is updated.
+func (r *groupMemberResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, fmt.Sprintf("Updating %s", memberResourceName))
+
+	// Read Terraform plan data into the model
+	var plan groupMemberResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Successfully updated %s", memberResourceName))
+}
+
+// Delete removes the resource on OPNsense and from the Terraform state.
+func (r *groupMemberResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, fmt.Sprintf("Deleting %s", memberResourceName))
+
+	// Read Terraform prior state data into the model
+	var state groupMemberResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Nothing to remove if the group no longer exists
+	uuid, err := searchGroup(r.client, state.Group.ValueString())
+	if errors.Is(err, errGroupNotExist) {
+		tflog.Warn(ctx, fmt.Sprintf("%s not found, skipping removal of %s", resourceName, memberResourceName), map[string]any{"group": state.Group.ValueString()})
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Delete %s error", memberResourceName), fmt.Sprintf("%s", err))
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Remove interface from group on OPNsense
+	tflog.Debug(ctx, fmt.Sprintf("Deleting %s on OPNsense", memberResourceName), map[string]any{"group": state.Group.ValueString(), "interface": state.Interface.ValueString()})
+
+	changed, err := setGroupMember(r.client, uuid, state.Interface.ValueString(), false)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Delete %s error", memberResourceName), fmt.Sprintf("%s", err))
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Apply configuration if the group was changed
+	if changed {
+		err = applyConfig(r.client)
+		if err != nil {
+			resp.Diagnostics.AddWarning(fmt.Sprintf("Delete %s error", memberResourceName), fmt.Sprintf("%s", err))
+		} else {
+			tflog.Debug(ctx, "Successfully applied configuration on OPNsense", map[string]any{"success": true})
+		}
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Successfully deleted %s", memberResourceName))
+}
+
+// ImportState imports the resource from OPNsense and enables Terraform to begin managing the resource.
+func (r *groupMemberResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Info(ctx, fmt.Sprintf("Importing %s", memberResourceName))
+
+	// Group names cannot contain slashes, so the first slash separates the group from the interface
+	group, iface, found := strings.Cut(req.ID, "/")
+	if !found || group == "" || iface == "" {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Import %s error", memberResourceName),
+			fmt.Sprintf("Expected import identifier in the format `<group>/<interface>`, got: `%s`", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("group"), group)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("interface"), iface)...)
+}
@@ -0,0 +1,110 @@
+package group_test
+
+import (
+	"regexp"
+	"testing"
+
+	"terraform-provider-opnsense/internal/acctest"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccGroupMemberResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccGroupMemberResourceConfig,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("opnsense_firewall_group_member.test_acc_resource_member", tfjsonpath.New("id"), knownvalue.StringExact("test_acc_group_member/wan")),
+					statecheck.ExpectKnownValue("opnsense_firewall_group_member.test_acc_resource_member", tfjsonpath.New("group"), knownvalue.StringExact("test_acc_group_member")),
+					statecheck.ExpectKnownValue("opnsense_firewall_group_member.test_acc_resource_member", tfjsonpath.New("interface"), knownvalue.StringExact("wan")),
+					statecheck.ExpectKnownValue("data.opnsense_firewall_group.test_acc_resource_member", tfjsonpath.New("members"), knownvalue.SetExact([]knownvalue.Check{
+						knownvalue.StringExact("lan"),
+						knownvalue.StringExact("wan"),
+					})),
+				},
+			},
+			// ImportState testing
+			{
+				ResourceName:      "opnsense_firewall_group_member.test_acc_resource_member",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Delete testing, the other members of the group are kept
+			{
+				Config: testAccGroupMemberResourceConfig_removed,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("data.opnsense_firewall_group.test_acc_resource_member", tfjsonpath.New("members"), knownvalue.SetExact([]knownvalue.Check{
+						knownvalue.StringExact("lan"),
+					})),
+				},
+			},
+		},
+	})
+}
+
+func TestAccGroupMemberResource_invalidConfig(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccGroupMemberResourceConfig_invalidGroup,
+				ExpectError: regexp.MustCompile("does not exist"),
+			},
+		},
+	})
+}
+
+// testAccGroupMemberResourceConfig defines a group with a group member resource.
+const testAccGroupMemberResourceConfig = `
+	resource "opnsense_firewall_group" "test_acc_resource_member" {
+		name    = "test_acc_group_member"
+		members = ["lan"]
+
+		lifecycle {
+			ignore_changes = [members]
+		}
+	}
+
+	resource "opnsense_firewall_group_member" "test_acc_resource_member" {
+		group     = opnsense_firewall_group.test_acc_resource_member.name
+		interface = "wan"
+	}
+
+	data "opnsense_firewall_group" "test_acc_resource_member" {
+		id = opnsense_firewall_group.test_acc_resource_member.id
+
+		depends_on = [opnsense_firewall_group_member.test_acc_resource_member]
+	}
+`
+
+// testAccGroupMemberResourceConfig_removed removes the group member resource.
+const testAccGroupMemberResourceConfig_removed = `
+	resource "opnsense_firewall_group" "test_acc_resource_member" {
+		name    = "test_acc_group_member"
+		members = ["lan"]
+
+		lifecycle {
+			ignore_changes = [members]
+		}
+	}
+
+	data "opnsense_firewall_group" "test_acc_resource_member" {
+		id = opnsense_firewall_group.test_acc_resource_member.id
+	}
+`
+
+// testAccGroupMemberResourceConfig_invalidGroup defines a group member resource for a group that does not exist.
+const testAccGroupMemberResourceConfig_invalidGroup = `
+	resource "opnsense_firewall_group_member" "test_acc_resource_member" {
+		group     = "test_acc_group_member_missing"
+		interface = "lan"
+	}
+`
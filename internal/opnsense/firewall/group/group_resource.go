@@ -24,9 +24,10 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &groupResource{}
-	_ resource.ResourceWithConfigure   = &groupResource{}
-	_ resource.ResourceWithImportState = &groupResource{}
+	_ resource.Resource                 = &groupResource{}
+	_ resource.ResourceWithConfigure    = &groupResource{}
+	_ resource.ResourceWithImportState  = &groupResource{}
+	_ resource.ResourceWithUpgradeState = &groupResource{}
 )
 
 // NewGroupResource is a helper function to simplify the provider implementation.
@@ -43,6 +44,7 @@ type groupResource struct {
 type groupResourceModel struct {
 	Id          types.String `tfsdk:"id"`
 	LastUpdated types.String `tfsdk:"last_updated"`
+	ContentHash types.String `tfsdk:"content_hash"`
 	Name        types.String `tfsdk:"name"`
 	Members     types.Set    `tfsdk:"members"`
 	NoGroup     types.Bool   `tfsdk:"no_group"`
@@ -58,6 +60,7 @@ func (r *groupResource) Metadata(ctx context.Context, req resource.MetadataReque
 // Schema defines the schema for the resource.
 func (r *groupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:     1,
 		Description: "To simplify rulesets, you can combine interfaces into Interface Groups and add policies which will be applied to all interfaces in the group.",
 
 		Attributes: map[string]schema.Attribute{
@@ -69,8 +72,13 @@ func (r *groupResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				},
 			},
 			"last_updated": schema.StringAttribute{
+				Computed:           true,
+				Description:        fmt.Sprintf("DateTime when the %s was last updated.", resourceName),
+				DeprecationMessage: "Use `content_hash` to detect changes to this resource instead. This attribute will be removed in a future release.",
+			},
+			"content_hash": schema.StringAttribute{
 				Computed:    true,
-				Description: fmt.Sprintf("DateTime when the %s was last updated.", resourceName),
+				Description: fmt.Sprintf("Hash of the %s configuration on OPNsense. Changes whenever the configuration is modified, including changes made outside of Terraform.", resourceName),
 			},
 			"name": schema.StringAttribute{
 				Required:    true,
@@ -163,6 +171,11 @@ func (r *groupResource) Create(ctx context.Context, req resource.CreateRequest,
 		tflog.Debug(ctx, "Successfully applied configuration on OPNsense", map[string]any{"success": true})
 	}
 
+	// Get content hash from OPNsense
+	contentHash, err := getGroupContentHash(r.client, uuid)
+	plan.ContentHash, diags = utils.ContentHashValue(fmt.Sprintf("Create %s error", resourceName), contentHash, err)
+	resp.Diagnostics.Append(diags...)
+
 	// Update plan ID & last_updated fields
 	plan.Id = types.StringValue(uuid)
 	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
@@ -202,6 +215,11 @@ func (r *groupResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	tflog.Debug(ctx, fmt.Sprintf("Successfully got %s information", resourceName), map[string]any{"success": true})
 
 	// Overwite items with refreshed state
+	contentHash, err := utils.ContentHash(group)
+	contentHashValue, diags := utils.ContentHashValue(fmt.Sprintf("Read %s error", resourceName), contentHash, err)
+	resp.Diagnostics.Append(diags...)
+	state.ContentHash = contentHashValue
+
 	state.Name = types.StringValue(group.Name)
 
 	members, diags := utils.SetGoToTerraform(ctx, group.Members)
@@ -271,6 +289,11 @@ func (r *groupResource) Update(ctx context.Context, req resource.UpdateRequest,
 		tflog.Debug(ctx, "Successfully applied configuration on OPNsense", map[string]any{"success": true})
 	}
 
+	// Get content hash from OPNsense
+	contentHash, err := getGroupContentHash(r.client, state.Id.ValueString())
+	plan.ContentHash, diags = utils.ContentHashValue(fmt.Sprintf("Update %s error", resourceName), contentHash, err)
+	resp.Diagnostics.Append(diags...)
+
 	// Update last_updated field (if change detected)
 	if !(reflect.DeepEqual(plan, state)) {
 		plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
@@ -0,0 +1,65 @@
+package group
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-opnsense/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// groupResourceModelV0 describes the resource data model of schema version 0.
+type groupResourceModelV0 struct {
+	Id          *string  `json:"id"`
+	LastUpdated *string  `json:"last_updated"`
+	Name        *string  `json:"name"`
+	Members     []string `json:"members"`
+	NoGroup     *bool    `json:"no_group"`
+	Sequence    *int32   `json:"sequence"`
+	Description *string  `json:"description"`
+}
+
+// UpgradeState upgrades the resource state from prior schema versions to the current schema version.
+func (r *groupResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {StateUpgrader: upgradeGroupStateV0},
+	}
+}
+
+// upgradeGroupStateV0 upgrades the resource state from schema version 0.
+func upgradeGroupStateV0(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	tflog.Info(ctx, fmt.Sprintf("Upgrading %s state from version 0", resourceName))
+
+	var priorState groupResourceModelV0
+	err := utils.DecodeRawState(req, &priorState)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Upgrade %s state error", resourceName), fmt.Sprintf("%s", err))
+		return
+	}
+
+	members, diags := utils.StringSetOrDefault(ctx, priorState.Members, []string{})
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state := groupResourceModel{
+		Id:          utils.StringOrNull(priorState.Id),
+		LastUpdated: utils.StringOrNull(priorState.LastUpdated),
+		Name:        utils.StringOrNull(priorState.Name),
+		Members:     members,
+		NoGroup:     utils.BoolOrDefault(priorState.NoGroup, false),
+		Sequence:    utils.Int32OrDefault(priorState.Sequence, 0),
+		Description: utils.StringOrDefault(priorState.Description, ""),
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Successfully upgraded %s state from version 0", resourceName))
+}
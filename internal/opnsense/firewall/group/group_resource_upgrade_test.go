@@ -0,0 +1,27 @@
+package group_test
+
+import (
+	"terraform-provider-opnsense/internal/acctest"
+	"terraform-provider-opnsense/internal/opnsense/firewall/group"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestGroupResource_upgradeState(t *testing.T) {
+	acctest.RunStateUpgradeTests(t, []acctest.StateUpgradeTestCase{
+		{
+			Name:      "v0 defaults",
+			Resource:  group.NewGroupResource(),
+			Version:   0,
+			StateFile: "testdata/group_resource_v0.json",
+			Expected: map[string]attr.Value{
+				"members":     types.SetValueMust(types.StringType, []attr.Value{types.StringValue("lan")}),
+				"no_group":    types.BoolValue(false),
+				"sequence":    types.Int32Value(0),
+				"description": types.StringValue(""),
+			},
+		},
+	})
+}
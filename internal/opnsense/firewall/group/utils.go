@@ -3,6 +3,7 @@ package group
 import (
 	"context"
 	"fmt"
+	"sync"
 
 	"terraform-provider-opnsense/internal/opnsense"
 	"terraform-provider-opnsense/internal/opnsense/interfaces/overview"
@@ -15,9 +16,20 @@ import (
 const (
 	controller string = "group"
 
-	resourceName string = "group"
+	resourceName       string = "group"
+	memberResourceName string = "group member"
 )
 
+const (
+	// Number of attempts to update the members of a group when the change is overwritten by a concurrent change.
+	groupMemberAttempts int = 3
+)
+
+// groupMemberMutex serialises the read-modify-write of group members within this provider process, so that group member resources
+// applied in parallel by the same Terraform run do not overwrite each other. It does not protect against other processes (e.g. a
+// second Terraform run or the web GUI), those races are only handled by the verify & retry loop of setGroupMember.
+var groupMemberMutex sync.Mutex
+
 type group struct {
 	Name        string
 	Members     *utils.Set
@@ -65,3 +77,47 @@ func createGroup(ctx context.Context, client *opnsense.Client, plan groupResourc
 
 	return group, diagnostics
 }
+
+// setGroupMember adds (present is true) or removes (present is false) the specified member interface to or from the group with a matching UUID.
+// The group is updated with a read-modify-write, which is verified & retried in case a concurrent change outside of the provider overwrote it.
+// Returns true if the group was changed.
+func setGroupMember(client *opnsense.Client, uuid string, member string, present bool) (bool, error) {
+	groupMemberMutex.Lock()
+	defer groupMemberMutex.Unlock()
+
+	changed := false
+	for attempt := 1; attempt <= groupMemberAttempts; attempt++ {
+		group, err := getGroup(client, uuid)
+		if err != nil {
+			return changed, err
+		}
+
+		// Also verifies the result of the previous attempt
+		if group.Members.Contains(member) == present {
+			return changed, nil
+		}
+
+		if present {
+			group.Members.Add(member)
+		} else {
+			group.Members.Remove(member)
+		}
+
+		err = setGroup(client, *group, uuid)
+		if err != nil {
+			return changed, err
+		}
+		changed = true
+	}
+
+	// Verify the result of the last attempt
+	group, err := getGroup(client, uuid)
+	if err != nil {
+		return changed, err
+	}
+	if group.Members.Contains(member) != present {
+		return changed, fmt.Errorf("Set %s error: members of %s `%s` were overwritten by a concurrent change %d times, please try again", memberResourceName, resourceName, group.Name, groupMemberAttempts)
+	}
+
+	return changed, nil
+}
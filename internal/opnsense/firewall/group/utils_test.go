@@ -0,0 +1,110 @@
+package group
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+
+	"terraform-provider-opnsense/internal/opnsense"
+)
+
+// groupStub is a stubbed OPNsense group API. The first overwrites calls to set the group are dropped, as if a concurrent
+// change overwrote them.
+type groupStub struct {
+	mutex      sync.Mutex
+	members    []string
+	overwrites int
+	sets       int
+}
+
+func (s *groupStub) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	switch {
+	case strings.HasPrefix(r.URL.Path, "/api/firewall/group/getItem/"):
+		members := make(map[string]map[string]any)
+		for _, name := range []string{"lan", "opt1", "opt2"} {
+			selected := 0
+			if slices.Contains(s.members, name) {
+				selected = 1
+			}
+			members[name] = map[string]any{"value": strings.ToUpper(name), "selected": selected}
+		}
+
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"group": map[string]any{"ifname": "test_group", "members": members, "nogroup": "0", "sequence": "0", "descr": ""},
+		})
+	case strings.HasPrefix(r.URL.Path, "/api/firewall/group/setItem/"):
+		var body groupHttpBody
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		s.sets++
+		if s.overwrites > 0 {
+			s.overwrites--
+		} else {
+			s.members = strings.Split(body.Group.Members, ",")
+		}
+
+		_, _ = fmt.Fprint(w, `{"result":"saved"}`)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func TestSetGroupMember(t *testing.T) {
+	testCases := []struct {
+		name            string
+		members         []string
+		overwrites      int
+		member          string
+		present         bool
+		expectedChanged bool
+		expectedSets    int
+		expectedError   bool
+	}{
+		{name: "add member", members: []string{"lan"}, member: "opt1", present: true, expectedChanged: true, expectedSets: 1},
+		{name: "remove member", members: []string{"lan", "opt1"}, member: "opt1", present: false, expectedChanged: true, expectedSets: 1},
+		{name: "member already present", members: []string{"lan", "opt1"}, member: "opt1", present: true, expectedChanged: false, expectedSets: 0},
+		{name: "retry after concurrent change", members: []string{"lan"}, overwrites: 2, member: "opt1", present: true, expectedChanged: true, expectedSets: 3},
+		{name: "concurrent change on every attempt", members: []string{"lan"}, overwrites: groupMemberAttempts, member: "opt1", present: true, expectedChanged: true, expectedSets: groupMemberAttempts, expectedError: true},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			stub := &groupStub{members: testCase.members, overwrites: testCase.overwrites}
+			server := httptest.NewServer(stub)
+			defer server.Close()
+
+			client, err := opnsense.NewClient(opnsense.ClientOpts{Endpoint: server.URL, ApiKey: "key", ApiSecret: "secret", Timeout: 5})
+			if err != nil {
+				t.Fatalf("unexpected error creating client: %s", err)
+			}
+
+			changed, err := setGroupMember(client, "uuid", testCase.member, testCase.present)
+			if (err != nil) != testCase.expectedError {
+				t.Fatalf("expected error to be %t, got: %v", testCase.expectedError, err)
+			}
+
+			if changed != testCase.expectedChanged {
+				t.Errorf("expected changed to be %t, got %t", testCase.expectedChanged, changed)
+			}
+
+			if stub.sets != testCase.expectedSets {
+				t.Errorf("expected %d calls to set the group, got %d", testCase.expectedSets, stub.sets)
+			}
+
+			if !testCase.expectedError && slices.Contains(stub.members, testCase.member) != testCase.present {
+				t.Errorf("expected member `%s` to be present %t, got members %v", testCase.member, testCase.present, stub.members)
+			}
+		})
+	}
+}
@@ -277,3 +277,13 @@ func applyNptv6NatConfig(client *opnsense.Client) error {
 	}
 	return nil
 }
+
+// getNptv6NatContentHash gets the content hash of the NPTv6 NAT rule from OPNsense.
+func getNptv6NatContentHash(client *opnsense.Client, uuid string) (string, error) {
+	object, err := getNptv6Nat(client, uuid)
+	if err != nil {
+		return "", err
+	}
+
+	return utils.ContentHash(object)
+}
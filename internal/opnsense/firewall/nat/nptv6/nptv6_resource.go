@@ -6,6 +6,7 @@ import (
 	"reflect"
 	"time"
 
+	"terraform-provider-opnsense/internal/customtypes"
 	"terraform-provider-opnsense/internal/opnsense"
 	"terraform-provider-opnsense/internal/opnsense/firewall"
 	"terraform-provider-opnsense/internal/opnsense/firewall/nat"
@@ -30,9 +31,10 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &natNptv6Resource{}
-	_ resource.ResourceWithConfigure   = &natNptv6Resource{}
-	_ resource.ResourceWithImportState = &natNptv6Resource{}
+	_ resource.Resource                 = &natNptv6Resource{}
+	_ resource.ResourceWithConfigure    = &natNptv6Resource{}
+	_ resource.ResourceWithImportState  = &natNptv6Resource{}
+	_ resource.ResourceWithUpgradeState = &natNptv6Resource{}
 )
 
 // NewNatNptv6Resource is a helper function to simplify the provider implementation.
@@ -47,17 +49,18 @@ type natNptv6Resource struct {
 
 // natNptv6ResourceModel describes the resource data model.
 type natNptv6ResourceModel struct {
-	Id             types.String `tfsdk:"id"`
-	LastUpdated    types.String `tfsdk:"last_updated"`
-	Enabled        types.Bool   `tfsdk:"enabled"`
-	Log            types.Bool   `tfsdk:"log"`
-	Sequence       types.Int32  `tfsdk:"sequence"`
-	Interface      types.String `tfsdk:"interface"`
-	InternalPrefix types.String `tfsdk:"internal_prefix"`
-	ExternalPrefix types.String `tfsdk:"external_prefix"`
-	TrackInterface types.String `tfsdk:"track_interface"`
-	Categories     types.Set    `tfsdk:"categories"`
-	Description    types.String `tfsdk:"description"`
+	Id             types.String             `tfsdk:"id"`
+	LastUpdated    types.String             `tfsdk:"last_updated"`
+	ContentHash    types.String             `tfsdk:"content_hash"`
+	Enabled        types.Bool               `tfsdk:"enabled"`
+	Log            types.Bool               `tfsdk:"log"`
+	Sequence       types.Int32              `tfsdk:"sequence"`
+	Interface      types.String             `tfsdk:"interface"`
+	InternalPrefix customtypes.NetworkValue `tfsdk:"internal_prefix"`
+	ExternalPrefix customtypes.NetworkValue `tfsdk:"external_prefix"`
+	TrackInterface types.String             `tfsdk:"track_interface"`
+	Categories     types.Set                `tfsdk:"categories"`
+	Description    types.String             `tfsdk:"description"`
 }
 
 // Metadata returns the resource type name.
@@ -70,6 +73,7 @@ func (r *natNptv6Resource) Schema(ctx context.Context, req resource.SchemaReques
 	emptySet, _ := basetypes.NewSetValue(types.StringType, []attr.Value{})
 
 	resp.Schema = schema.Schema{
+		Version:     1,
 		Description: "Network Prefix Translation, shortened to NPTv6, is used to translate IPv6 addresses.",
 
 		Attributes: map[string]schema.Attribute{
@@ -81,8 +85,13 @@ func (r *natNptv6Resource) Schema(ctx context.Context, req resource.SchemaReques
 				},
 			},
 			"last_updated": schema.StringAttribute{
+				Computed:           true,
+				Description:        fmt.Sprintf("DateTime when the %s was last updated.", resourceName),
+				DeprecationMessage: "Use `content_hash` to detect changes to this resource instead. This attribute will be removed in a future release.",
+			},
+			"content_hash": schema.StringAttribute{
 				Computed:    true,
-				Description: fmt.Sprintf("DateTime when the %s was last updated.", resourceName),
+				Description: fmt.Sprintf("Hash of the %s configuration on OPNsense. Changes whenever the configuration is modified, including changes made outside of Terraform.", resourceName),
 			},
 			"enabled": schema.BoolAttribute{
 				Optional:            true,
@@ -107,10 +116,12 @@ func (r *natNptv6Resource) Schema(ctx context.Context, req resource.SchemaReques
 				Description: "The interface this rule applies to.",
 			},
 			"internal_prefix": schema.StringAttribute{
+				CustomType:  customtypes.NetworkType{},
 				Required:    true,
 				Description: "The internal IPv6 prefix used in the LAN(s). This will replace the prefix of the destination address in inbound packets. The prefix size specified here will also be applied to the external prefix.",
 			},
 			"external_prefix": schema.StringAttribute{
+				CustomType:  customtypes.NetworkType{},
 				Optional:    true,
 				Computed:    true,
 				Description: "The external IPv6 prefix. This will replace the prefix of the source address in outbound packets. Leave empty to auto-detect the prefix address using the specified tracking interface instead. The prefix size specified for the internal prefix will also be applied to the external prefix.",
@@ -208,6 +219,11 @@ func (r *natNptv6Resource) Create(ctx context.Context, req resource.CreateReques
 		tflog.Debug(ctx, "Successfully applied configuration on OPNsense", map[string]any{"success": true})
 	}
 
+	// Get content hash from OPNsense
+	contentHash, err := getNptv6NatContentHash(r.client, uuid)
+	plan.ContentHash, diags = utils.ContentHashValue(fmt.Sprintf("Create %s error", resourceName), contentHash, err)
+	resp.Diagnostics.Append(diags...)
+
 	// Update plan ID & last_updated fields
 	plan.Id = types.StringValue(uuid)
 	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
@@ -248,12 +264,17 @@ func (r *natNptv6Resource) Read(ctx context.Context, req resource.ReadRequest, r
 	tflog.Debug(ctx, fmt.Sprintf("Successfully got %s information", resourceName), map[string]any{"success": true})
 
 	// Overwite items with refreshed state
+	contentHash, err := utils.ContentHash(rule)
+	contentHashValue, diags := utils.ContentHashValue(fmt.Sprintf("Read %s error", resourceName), contentHash, err)
+	resp.Diagnostics.Append(diags...)
+	state.ContentHash = contentHashValue
+
 	state.Enabled = types.BoolValue(rule.Enabled)
 	state.Log = types.BoolValue(rule.Log)
 	state.Sequence = types.Int32Value(rule.Sequence)
 	state.Interface = types.StringValue(rule.Interface)
-	state.InternalPrefix = types.StringValue(rule.InternalPrefix)
-	state.ExternalPrefix = types.StringValue(rule.ExternalPrefix)
+	state.InternalPrefix = customtypes.NewNetworkValue(rule.InternalPrefix)
+	state.ExternalPrefix = customtypes.NewNetworkValue(rule.ExternalPrefix)
 	state.TrackInterface = types.StringValue(rule.TrackInterface)
 	state.Description = types.StringValue(rule.Description)
 
@@ -320,6 +341,11 @@ func (r *natNptv6Resource) Update(ctx context.Context, req resource.UpdateReques
 		tflog.Debug(ctx, "Successfully applied configuration on OPNsense", map[string]any{"success": true})
 	}
 
+	// Get content hash from OPNsense
+	contentHash, err := getNptv6NatContentHash(r.client, state.Id.ValueString())
+	plan.ContentHash, diags = utils.ContentHashValue(fmt.Sprintf("Update %s error", resourceName), contentHash, err)
+	resp.Diagnostics.Append(diags...)
+
 	// Update last_updated field (if change detected)
 	if !(reflect.DeepEqual(plan, state)) {
 		plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
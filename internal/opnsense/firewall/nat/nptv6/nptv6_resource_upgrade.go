@@ -0,0 +1,74 @@
+package nptv6
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-opnsense/internal/customtypes"
+	"terraform-provider-opnsense/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// natNptv6ResourceModelV0 describes the resource data model of schema version 0.
+type natNptv6ResourceModelV0 struct {
+	Id             *string  `json:"id"`
+	LastUpdated    *string  `json:"last_updated"`
+	Enabled        *bool    `json:"enabled"`
+	Log            *bool    `json:"log"`
+	Sequence       *int32   `json:"sequence"`
+	Interface      *string  `json:"interface"`
+	InternalPrefix *string  `json:"internal_prefix"`
+	ExternalPrefix *string  `json:"external_prefix"`
+	TrackInterface *string  `json:"track_interface"`
+	Categories     []string `json:"categories"`
+	Description    *string  `json:"description"`
+}
+
+// UpgradeState upgrades the resource state from prior schema versions to the current schema version.
+func (r *natNptv6Resource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {StateUpgrader: upgradeNatNptv6StateV0},
+	}
+}
+
+// upgradeNatNptv6StateV0 upgrades the resource state from schema version 0.
+func upgradeNatNptv6StateV0(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	tflog.Info(ctx, fmt.Sprintf("Upgrading %s state from version 0", resourceName))
+
+	var priorState natNptv6ResourceModelV0
+	err := utils.DecodeRawState(req, &priorState)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Upgrade %s state error", resourceName), fmt.Sprintf("%s", err))
+		return
+	}
+
+	categories, diags := utils.StringSetOrDefault(ctx, priorState.Categories, []string{})
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state := natNptv6ResourceModel{
+		Id:             utils.StringOrNull(priorState.Id),
+		LastUpdated:    utils.StringOrNull(priorState.LastUpdated),
+		Enabled:        utils.BoolOrDefault(priorState.Enabled, true),
+		Log:            utils.BoolOrDefault(priorState.Log, false),
+		Sequence:       utils.Int32OrDefault(priorState.Sequence, 1),
+		Interface:      utils.StringOrNull(priorState.Interface),
+		InternalPrefix: customtypes.NetworkValue{StringValue: utils.StringOrNull(priorState.InternalPrefix)},
+		ExternalPrefix: customtypes.NetworkValue{StringValue: utils.StringOrDefault(priorState.ExternalPrefix, "")},
+		TrackInterface: utils.StringOrDefault(priorState.TrackInterface, ""),
+		Categories:     categories,
+		Description:    utils.StringOrDefault(priorState.Description, ""),
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Successfully upgraded %s state from version 0", resourceName))
+}
@@ -0,0 +1,28 @@
+package nptv6_test
+
+import (
+	"terraform-provider-opnsense/internal/acctest"
+	"terraform-provider-opnsense/internal/opnsense/firewall/nat/nptv6"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestNatNptv6Resource_upgradeState(t *testing.T) {
+	acctest.RunStateUpgradeTests(t, []acctest.StateUpgradeTestCase{
+		{
+			Name:      "v0 defaults",
+			Resource:  nptv6.NewNatNptv6Resource(),
+			Version:   0,
+			StateFile: "testdata/nptv6_resource_v0.json",
+			Expected: map[string]attr.Value{
+				"enabled":         types.BoolValue(true),
+				"log":             types.BoolValue(false),
+				"sequence":        types.Int32Value(1),
+				"external_prefix": types.StringValue(""),
+				"track_interface": types.StringValue(""),
+			},
+		},
+	})
+}
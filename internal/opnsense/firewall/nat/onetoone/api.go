@@ -306,3 +306,13 @@ func applyOneToOneNatConfig(client *opnsense.Client) error {
 	}
 	return nil
 }
+
+// getOneToOneNatContentHash gets the content hash of the one-to-one NAT rule from OPNsense.
+func getOneToOneNatContentHash(client *opnsense.Client, uuid string) (string, error) {
+	object, err := getOneToOneNat(client, uuid)
+	if err != nil {
+		return "", err
+	}
+
+	return utils.ContentHash(object)
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 	"time"
 
+	"terraform-provider-opnsense/internal/customtypes"
 	"terraform-provider-opnsense/internal/opnsense"
 	"terraform-provider-opnsense/internal/opnsense/firewall"
 	"terraform-provider-opnsense/internal/opnsense/firewall/nat"
@@ -31,9 +32,11 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &natOneToOneResource{}
-	_ resource.ResourceWithConfigure   = &natOneToOneResource{}
-	_ resource.ResourceWithImportState = &natOneToOneResource{}
+	_ resource.Resource                   = &natOneToOneResource{}
+	_ resource.ResourceWithConfigure      = &natOneToOneResource{}
+	_ resource.ResourceWithImportState    = &natOneToOneResource{}
+	_ resource.ResourceWithUpgradeState   = &natOneToOneResource{}
+	_ resource.ResourceWithValidateConfig = &natOneToOneResource{}
 )
 
 // NewNatOneToOneResource is a helper function to simplify the provider implementation.
@@ -48,21 +51,22 @@ type natOneToOneResource struct {
 
 // natOneToOneResourceModel describes the resource data model.
 type natOneToOneResourceModel struct {
-	Id             types.String `tfsdk:"id"`
-	LastUpdated    types.String `tfsdk:"last_updated"`
-	Enabled        types.Bool   `tfsdk:"enabled"`
-	Log            types.Bool   `tfsdk:"log"`
-	Sequence       types.Int32  `tfsdk:"sequence"`
-	Interface      types.String `tfsdk:"interface"`
-	Type           types.String `tfsdk:"type"`
-	Source         types.String `tfsdk:"source"`
-	SourceNot      types.Bool   `tfsdk:"source_not"`
-	Destination    types.String `tfsdk:"destination"`
-	DestinationNot types.Bool   `tfsdk:"destination_not"`
-	External       types.String `tfsdk:"external"`
-	NatReflection  types.String `tfsdk:"nat_reflection"`
-	Categories     types.Set    `tfsdk:"categories"`
-	Description    types.String `tfsdk:"description"`
+	Id             types.String             `tfsdk:"id"`
+	LastUpdated    types.String             `tfsdk:"last_updated"`
+	ContentHash    types.String             `tfsdk:"content_hash"`
+	Enabled        types.Bool               `tfsdk:"enabled"`
+	Log            types.Bool               `tfsdk:"log"`
+	Sequence       types.Int32              `tfsdk:"sequence"`
+	Interface      types.String             `tfsdk:"interface"`
+	Type           types.String             `tfsdk:"type"`
+	Source         customtypes.NetworkValue `tfsdk:"source"`
+	SourceNot      types.Bool               `tfsdk:"source_not"`
+	Destination    customtypes.NetworkValue `tfsdk:"destination"`
+	DestinationNot types.Bool               `tfsdk:"destination_not"`
+	External       customtypes.NetworkValue `tfsdk:"external"`
+	NatReflection  types.String             `tfsdk:"nat_reflection"`
+	Categories     types.Set                `tfsdk:"categories"`
+	Description    types.String             `tfsdk:"description"`
 }
 
 // Metadata returns the resource type name.
@@ -75,6 +79,7 @@ func (r *natOneToOneResource) Schema(ctx context.Context, req resource.SchemaReq
 	emptySet, _ := basetypes.NewSetValue(types.StringType, []attr.Value{})
 
 	resp.Schema = schema.Schema{
+		Version:             1,
 		MarkdownDescription: "One-to-one NAT will translate two IPs one-to-one, rather than one-to-many as is most common.",
 
 		Attributes: map[string]schema.Attribute{
@@ -86,8 +91,13 @@ func (r *natOneToOneResource) Schema(ctx context.Context, req resource.SchemaReq
 				},
 			},
 			"last_updated": schema.StringAttribute{
+				Computed:           true,
+				Description:        fmt.Sprintf("DateTime when the %s entry was last updated.", resourceName),
+				DeprecationMessage: "Use `content_hash` to detect changes to this resource instead. This attribute will be removed in a future release.",
+			},
+			"content_hash": schema.StringAttribute{
 				Computed:    true,
-				Description: fmt.Sprintf("DateTime when the %s entry was last updated.", resourceName),
+				Description: fmt.Sprintf("Hash of the %s configuration on OPNsense. Changes whenever the configuration is modified, including changes made outside of Terraform.", resourceName),
 			},
 			"enabled": schema.BoolAttribute{
 				Optional:            true,
@@ -128,6 +138,7 @@ func (r *natOneToOneResource) Schema(ctx context.Context, req resource.SchemaReq
 				},
 			},
 			"source": schema.StringAttribute{
+				CustomType:          customtypes.NetworkType{},
 				Required:            true,
 				MarkdownDescription: "The internal subnet for this 1:1 mapping. Can be a single network/host, alias or predefined network. For interface addresses, add `ip` to the end of the interface name (e.g `opt1ip`).",
 			},
@@ -138,6 +149,7 @@ func (r *natOneToOneResource) Schema(ctx context.Context, req resource.SchemaReq
 				Default:             booldefault.StaticBool(false),
 			},
 			"destination": schema.StringAttribute{
+				CustomType:  customtypes.NetworkType{},
 				Required:    true,
 				Description: "The 1:1 mapping will only be used for connections to or from the specified destination. Can be a single network/host, alias or predefined network. For interface addresses, add `ip` to the end of the interface name (e.g `opt1ip`).",
 			},
@@ -148,8 +160,9 @@ func (r *natOneToOneResource) Schema(ctx context.Context, req resource.SchemaReq
 				Default:             booldefault.StaticBool(false),
 			},
 			"external": schema.StringAttribute{
-				Required:    true,
-				Description: "The external subnet's starting address for the 1:1 mapping or network. This is the address or network the traffic will translate to/from.",
+				CustomType:          customtypes.NetworkType{},
+				Required:            true,
+				MarkdownDescription: "The external subnet's starting address for the 1:1 mapping or network. This is the address or network the traffic will translate to/from. Usually the `address` of an `opnsense_interfaces_virtual_ip`.",
 			},
 			"nat_reflection": schema.StringAttribute{
 				Optional: true,
@@ -186,6 +199,47 @@ func (r *natOneToOneResource) Schema(ctx context.Context, req resource.SchemaReq
 	}
 }
 
+// ValidateConfig validates the resource configuration for cross-attribute constraints.
+func (r *natOneToOneResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config natOneToOneResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Skip validation if the external address is not yet known
+	if config.External.IsNull() || config.External.IsUnknown() {
+		return
+	}
+
+	// External must be a single address or network
+	external, ok := parseNetwork(config.External.ValueString())
+	if !ok {
+		resp.Diagnostics.AddAttributeError(path.Root("external"), "Invalid Attribute Value", fmt.Sprintf("The `external` attribute must be an IP address or network in CIDR notation, got: `%s`.", config.External.ValueString()))
+		return
+	}
+
+	// Source can be an alias or predefined network, in which case it cannot be compared with the external network
+	if config.Source.IsNull() || config.Source.IsUnknown() {
+		return
+	}
+
+	source, ok := parseNetwork(config.Source.ValueString())
+	if !ok {
+		return
+	}
+
+	if source.Addr().Is4() != external.Addr().Is4() {
+		resp.Diagnostics.AddAttributeError(path.Root("external"), "Invalid Attribute Combination", "The `source` and `external` attributes must belong to the same address family.")
+		return
+	}
+
+	// Binat rules translate the source network to an external network of the same size
+	if config.Type.ValueString() == "binat" && source.Bits() != external.Bits() {
+		resp.Diagnostics.AddAttributeError(path.Root("external"), "Invalid Attribute Combination", fmt.Sprintf("The `external` network must have the same prefix length as the `source` network when `type` is set to `binat`, got: /%d and /%d.", external.Bits(), source.Bits()))
+	}
+}
+
 // Configure adds the provider configured client to the resource.
 func (r *natOneToOneResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Add a nil check when handling ProviderData because Terraform
@@ -246,6 +300,11 @@ func (r *natOneToOneResource) Create(ctx context.Context, req resource.CreateReq
 		tflog.Debug(ctx, "Successfully applied configuration on OPNsense", map[string]any{"success": true})
 	}
 
+	// Get content hash from OPNsense
+	contentHash, err := getOneToOneNatContentHash(r.client, uuid)
+	plan.ContentHash, diags = utils.ContentHashValue(fmt.Sprintf("Create %s error", resourceName), contentHash, err)
+	resp.Diagnostics.Append(diags...)
+
 	// Update plan ID & last_updated fields
 	plan.Id = types.StringValue(uuid)
 	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
@@ -286,16 +345,21 @@ func (r *natOneToOneResource) Read(ctx context.Context, req resource.ReadRequest
 	tflog.Debug(ctx, fmt.Sprintf("Successfully got %s information", resourceName), map[string]any{"success": true})
 
 	// Overwite items with refreshed state
+	contentHash, err := utils.ContentHash(rule)
+	contentHashValue, diags := utils.ContentHashValue(fmt.Sprintf("Read %s error", resourceName), contentHash, err)
+	resp.Diagnostics.Append(diags...)
+	state.ContentHash = contentHashValue
+
 	state.Enabled = types.BoolValue(rule.Enabled)
 	state.Log = types.BoolValue(rule.Log)
 	state.Sequence = types.Int32Value(rule.Sequence)
 	state.Interface = types.StringValue(rule.Interface)
 	state.Type = types.StringValue(rule.Type)
-	state.Source = types.StringValue(rule.Source)
+	state.Source = customtypes.NewNetworkValue(rule.Source)
 	state.SourceNot = types.BoolValue(rule.SourceNot)
-	state.Destination = types.StringValue(rule.Destination)
+	state.Destination = customtypes.NewNetworkValue(rule.Destination)
 	state.DestinationNot = types.BoolValue(rule.DestinationNot)
-	state.External = types.StringValue(rule.External)
+	state.External = customtypes.NewNetworkValue(rule.External)
 	state.NatReflection = types.StringValue(rule.NatRefection)
 	state.Description = types.StringValue(rule.Description)
 
@@ -362,6 +426,11 @@ func (r *natOneToOneResource) Update(ctx context.Context, req resource.UpdateReq
 		tflog.Debug(ctx, "Successfully applied configuration on OPNsense", map[string]any{"success": true})
 	}
 
+	// Get content hash from OPNsense
+	contentHash, err := getOneToOneNatContentHash(r.client, state.Id.ValueString())
+	plan.ContentHash, diags = utils.ContentHashValue(fmt.Sprintf("Update %s error", resourceName), contentHash, err)
+	resp.Diagnostics.Append(diags...)
+
 	// Update last_updated field (if change detected)
 	if !(reflect.DeepEqual(plan, state)) {
 		plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
@@ -1,6 +1,7 @@
 package onetoone_test
 
 import (
+	"regexp"
 	"terraform-provider-opnsense/internal/acctest"
 	"testing"
 
@@ -103,6 +104,25 @@ func TestAccOneToOneNatResource_defaults(t *testing.T) {
 	})
 }
 
+func TestAccOneToOneNatResource_invalidConfig(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccOneToOneNatResourceConfig_invalid_external,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Value`),
+			},
+			{
+				Config:      testAccOneToOneNatResourceConfig_binat_prefix,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+		},
+	})
+}
+
 // testAccOneToOneNatResourceConfig defines a one-to-one NAT resource of type `nat`.
 const testAccOneToOneNatResourceConfig = `
 	resource "opnsense_firewall_nat_one_to_one" "test_acc_resource_nat" {
@@ -153,3 +173,25 @@ const testAccOneToOneNatResourceConfig_default = `
 		external        = "1.1.1.1"
 	}
 `
+
+// testAccOneToOneNatResourceConfig_invalid_external defines a one-to-one NAT resource with an alias as the external address.
+const testAccOneToOneNatResourceConfig_invalid_external = `
+	resource "opnsense_firewall_nat_one_to_one" "test_acc_resource_invalid" {
+		interface = "wan"
+		type = "nat"
+		source = "1.1.1.1"
+		destination = "any"
+		external = "perm_test_acc_alias"
+	}
+`
+
+// testAccOneToOneNatResourceConfig_binat_prefix defines a one-to-one NAT resource of type `binat` with mismatched prefix lengths.
+const testAccOneToOneNatResourceConfig_binat_prefix = `
+	resource "opnsense_firewall_nat_one_to_one" "test_acc_resource_invalid" {
+		interface = "wan"
+		type = "binat"
+		source = "10.0.0.0/24"
+		destination = "any"
+		external = "192.0.2.0/28"
+	}
+`
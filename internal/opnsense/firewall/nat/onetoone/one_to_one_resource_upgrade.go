@@ -0,0 +1,82 @@
+package onetoone
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-opnsense/internal/customtypes"
+	"terraform-provider-opnsense/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// natOneToOneResourceModelV0 describes the resource data model of schema version 0.
+type natOneToOneResourceModelV0 struct {
+	Id             *string  `json:"id"`
+	LastUpdated    *string  `json:"last_updated"`
+	Enabled        *bool    `json:"enabled"`
+	Log            *bool    `json:"log"`
+	Sequence       *int32   `json:"sequence"`
+	Interface      *string  `json:"interface"`
+	Type           *string  `json:"type"`
+	Source         *string  `json:"source"`
+	SourceNot      *bool    `json:"source_not"`
+	Destination    *string  `json:"destination"`
+	DestinationNot *bool    `json:"destination_not"`
+	External       *string  `json:"external"`
+	NatReflection  *string  `json:"nat_reflection"`
+	Categories     []string `json:"categories"`
+	Description    *string  `json:"description"`
+}
+
+// UpgradeState upgrades the resource state from prior schema versions to the current schema version.
+func (r *natOneToOneResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {StateUpgrader: upgradeNatOneToOneStateV0},
+	}
+}
+
+// upgradeNatOneToOneStateV0 upgrades the resource state from schema version 0.
+func upgradeNatOneToOneStateV0(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	tflog.Info(ctx, fmt.Sprintf("Upgrading %s state from version 0", resourceName))
+
+	var priorState natOneToOneResourceModelV0
+	err := utils.DecodeRawState(req, &priorState)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Upgrade %s state error", resourceName), fmt.Sprintf("%s", err))
+		return
+	}
+
+	categories, diags := utils.StringSetOrDefault(ctx, priorState.Categories, []string{})
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state := natOneToOneResourceModel{
+		Id:             utils.StringOrNull(priorState.Id),
+		LastUpdated:    utils.StringOrNull(priorState.LastUpdated),
+		Enabled:        utils.BoolOrDefault(priorState.Enabled, true),
+		Log:            utils.BoolOrDefault(priorState.Log, false),
+		Sequence:       utils.Int32OrDefault(priorState.Sequence, 1),
+		Interface:      utils.StringOrNull(priorState.Interface),
+		Type:           utils.StringOrNull(priorState.Type),
+		Source:         customtypes.NetworkValue{StringValue: utils.StringOrNull(priorState.Source)},
+		SourceNot:      utils.BoolOrDefault(priorState.SourceNot, false),
+		Destination:    customtypes.NetworkValue{StringValue: utils.StringOrNull(priorState.Destination)},
+		DestinationNot: utils.BoolOrDefault(priorState.DestinationNot, false),
+		External:       customtypes.NetworkValue{StringValue: utils.StringOrNull(priorState.External)},
+		NatReflection:  utils.StringOrDefault(priorState.NatReflection, "default"),
+		Categories:     categories,
+		Description:    utils.StringOrDefault(priorState.Description, ""),
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Successfully upgraded %s state from version 0", resourceName))
+}
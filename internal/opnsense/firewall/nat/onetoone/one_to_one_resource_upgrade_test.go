@@ -0,0 +1,27 @@
+package onetoone_test
+
+import (
+	"terraform-provider-opnsense/internal/acctest"
+	"terraform-provider-opnsense/internal/opnsense/firewall/nat/onetoone"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestNatOneToOneResource_upgradeState(t *testing.T) {
+	acctest.RunStateUpgradeTests(t, []acctest.StateUpgradeTestCase{
+		{
+			Name:      "v0 defaults",
+			Resource:  onetoone.NewNatOneToOneResource(),
+			Version:   0,
+			StateFile: "testdata/one_to_one_resource_v0.json",
+			Expected: map[string]attr.Value{
+				"enabled":        types.BoolValue(true),
+				"sequence":       types.Int32Value(1),
+				"nat_reflection": types.StringValue("default"),
+				"description":    types.StringValue(""),
+			},
+		},
+	})
+}
@@ -3,6 +3,7 @@ package onetoone
 import (
 	"context"
 	"fmt"
+	"net/netip"
 	"strings"
 
 	"terraform-provider-opnsense/internal/opnsense"
@@ -55,6 +56,21 @@ func getNatReflectionOptions() []string {
 
 // Helper functions
 
+// parseNetwork parses the specified value as an IP address or network in CIDR notation. Single IP addresses are
+// treated as host networks.
+func parseNetwork(value string) (netip.Prefix, bool) {
+	if prefix, err := netip.ParsePrefix(value); err == nil {
+		return prefix, true
+	}
+
+	addr, err := netip.ParseAddr(value)
+	if err != nil {
+		return netip.Prefix{}, false
+	}
+
+	return netip.PrefixFrom(addr, addr.BitLen()), true
+}
+
 // createOneToOneNat creates a one-to-one nat rule based on the specified plan.
 func createOneToOneNat(ctx context.Context, client *opnsense.Client, plan natOneToOneResourceModel) (oneToOneNat, diag.Diagnostics) {
 	var diagnostics diag.Diagnostics
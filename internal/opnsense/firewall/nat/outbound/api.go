@@ -0,0 +1,173 @@
+package outbound
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"terraform-provider-opnsense/internal/opnsense"
+	"terraform-provider-opnsense/internal/opnsense/firewall"
+	"terraform-provider-opnsense/internal/utils"
+)
+
+const (
+	outboundOpnsenseController string = "source_nat"
+
+	getOutboundSettingsCommand         opnsense.Command = "get"
+	setOutboundSettingsCommand         opnsense.Command = "set"
+	applyOutboundSettingsConfigCommand opnsense.Command = "apply"
+)
+
+// HTTP request bodies
+
+type outboundSettingsHttpBody struct {
+	Nat outboundNatRequest `json:"nat"`
+}
+
+type outboundNatRequest struct {
+	Outbound outboundSettingsRequest `json:"outbound"`
+}
+
+type outboundSettingsRequest struct {
+	Mode string `json:"mode"`
+}
+
+// HTTP response types
+
+type getOutboundSettingsResponse struct {
+	Nat struct {
+		Outbound struct {
+			Mode map[string]struct {
+				Value    string `json:"value"`
+				Selected uint8  `json:"selected"`
+			} `json:"mode"`
+		} `json:"outbound"`
+	} `json:"nat"`
+}
+
+// getOutboundSettings gets the outbound NAT settings from the OPNsense firewall.
+func getOutboundSettings(client *opnsense.Client) (*outboundSettings, error) {
+	path := fmt.Sprintf("%s/%s/%s", firewall.Module, outboundOpnsenseController, getOutboundSettingsCommand)
+
+	httpResp, err := client.DoRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, fmt.Errorf("OPNsense client error: %s", err)
+	}
+	if httpResp.StatusCode != 200 {
+		return nil, fmt.Errorf("Get %s error (http): abnormal status code %d in HTTP response. Please contact the provider for assistance", resourceName, httpResp.StatusCode)
+	}
+
+	var response getOutboundSettingsResponse
+	err = json.NewDecoder(httpResp.Body).Decode(&response)
+	if err != nil {
+		return nil, fmt.Errorf("Get %s error (http): %s", resourceName, err)
+	}
+
+	// Extract values from response
+	var mode string
+	for name, value := range response.Nat.Outbound.Mode {
+		if value.Selected == 1 {
+			var exists bool
+			mode, exists = modes.GetByValue(name)
+			if !exists {
+				return nil, fmt.Errorf("Get %s error: mode `%s` not supported. Please contact the provider maintainers.", resourceName, name)
+			}
+			break
+		}
+	}
+
+	if mode == "" {
+		return nil, fmt.Errorf("Get %s error: no mode selected on OPNsense. Please contact the provider maintainers.", resourceName)
+	}
+
+	return &outboundSettings{
+		Mode: mode,
+	}, nil
+}
+
+// setOutboundSettings sets the outbound NAT settings on the OPNsense firewall.
+func setOutboundSettings(client *opnsense.Client, settings outboundSettings) error {
+	path := fmt.Sprintf("%s/%s/%s", firewall.Module, outboundOpnsenseController, setOutboundSettingsCommand)
+
+	mode, exists := modes.GetByKey(settings.Mode)
+	if !exists {
+		return fmt.Errorf("Set %s error: mode `%s` not supported. Please contact the provider maintainers.", resourceName, settings.Mode)
+	}
+
+	// Generate API body
+	body := outboundSettingsHttpBody{
+		Nat: outboundNatRequest{
+			Outbound: outboundSettingsRequest{
+				Mode: mode,
+			},
+		},
+	}
+
+	reqBody, err := json.Marshal(body)
+	if err != nil {
+		return fmt.Errorf("Set %s error: failed to marshal json body - %s", resourceName, err)
+	}
+
+	httpResp, err := client.DoRequest(http.MethodPost, path, reqBody)
+	if err != nil {
+		return fmt.Errorf("OPNsense client error: %s", err)
+	}
+
+	if httpResp.StatusCode != 200 {
+		return fmt.Errorf("Set %s error (http): abnormal status code %d in HTTP response. Please contact the provider for assistance", resourceName, httpResp.StatusCode)
+	}
+
+	var response opnsense.OpnsenseAddItemResponse
+	err = json.NewDecoder(httpResp.Body).Decode(&response)
+	if err != nil {
+		return fmt.Errorf("Set %s error (http): failed to decode http response - %s", resourceName, err)
+	}
+
+	if strings.ToLower(response.Result) == "failed" {
+		return fmt.Errorf("Set %[1]s error: failed to set %[1]s on OPNsense - failed validations:\n%s", resourceName, opnsense.ValidationsToString(response.Validations))
+	}
+
+	return nil
+}
+
+// applyOutboundSettingsConfig applies the outbound NAT configuration on the OPNsense firewall.
+func applyOutboundSettingsConfig(client *opnsense.Client) error {
+	path := fmt.Sprintf("%s/%s/%s", firewall.Module, outboundOpnsenseController, applyOutboundSettingsConfigCommand)
+
+	// Generate empty body
+	reqBody, err := json.Marshal(nil)
+	if err != nil {
+		return fmt.Errorf("Apply configuration error: failed to marshal json body - %s", err)
+	}
+
+	httpResp, err := client.DoRequest(http.MethodPost, path, reqBody)
+	if err != nil {
+		return fmt.Errorf("OPNsense client error: %s", err)
+	}
+
+	if httpResp.StatusCode != 200 {
+		return fmt.Errorf("Apply configuration error (http): abnormal status code %d in HTTP response. Please contact the provider for assistance", httpResp.StatusCode)
+	}
+
+	var resp opnsense.OpnsenseApplyConfigResponse
+	err = json.NewDecoder(httpResp.Body).Decode(&resp)
+	if err != nil {
+		return fmt.Errorf("Apply configuration error (http): failed to decode http response - %s", err)
+	}
+
+	if strings.Trim(strings.ToLower(resp.Status), "\n") != "ok" {
+		return fmt.Errorf("Apply configuration error: failed to apply configuration on OPNsense. Please contact the provider maintainers for assistance")
+	}
+	return nil
+}
+
+// getOutboundSettingsContentHash gets the content hash of the outbound NAT settings from OPNsense.
+func getOutboundSettingsContentHash(client *opnsense.Client) (string, error) {
+	object, err := getOutboundSettings(client)
+	if err != nil {
+		return "", err
+	}
+
+	return utils.ContentHash(object)
+}
@@ -0,0 +1,318 @@
+package outbound
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"terraform-provider-opnsense/internal/opnsense"
+	"terraform-provider-opnsense/internal/opnsense/firewall"
+	"terraform-provider-opnsense/internal/opnsense/firewall/nat"
+	"terraform-provider-opnsense/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &natOutboundSettingsResource{}
+	_ resource.ResourceWithConfigure   = &natOutboundSettingsResource{}
+	_ resource.ResourceWithImportState = &natOutboundSettingsResource{}
+)
+
+// NewNatOutboundSettingsResource is a helper function to simplify the provider implementation.
+func NewNatOutboundSettingsResource() resource.Resource {
+	return &natOutboundSettingsResource{}
+}
+
+// natOutboundSettingsResource defines the resource implementation.
+type natOutboundSettingsResource struct {
+	client *opnsense.Client
+}
+
+// natOutboundSettingsResourceModel describes the resource data model.
+type natOutboundSettingsResourceModel struct {
+	Mode        types.String `tfsdk:"mode"`
+	DefaultMode types.String `tfsdk:"default_mode"`
+	ContentHash types.String `tfsdk:"content_hash"`
+}
+
+// Metadata returns the resource type name.
+func (r *natOutboundSettingsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_%s_%s_%s_settings", req.ProviderTypeName, firewall.TypeName, nat.NatController, outboundController)
+}
+
+// Schema defines the schema for the resource.
+func (r *natOutboundSettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	modeList := strings.Join(
+		// Surround each mode with backticks (`)
+		utils.SliceMap(getModes(), func(mode string) string {
+			return fmt.Sprintf("`%s`", mode)
+		}),
+		", ",
+	)
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Controls the outbound NAT mode, which determines whether automatically generated and/or manually created source NAT rules are used. Only one instance of this resource should be defined, as the settings apply to the whole firewall.",
+
+		Attributes: map[string]schema.Attribute{
+			"mode": schema.StringAttribute{
+				Required: true,
+				MarkdownDescription: fmt.Sprintf(
+					"The outbound NAT mode. `automatic` only uses automatically generated rules, `hybrid` uses both automatically generated and manual rules, `manual` only uses manual rules and `disabled` turns off outbound NAT. Must be one of: %s.", modeList,
+				),
+				Validators: []validator.String{
+					// Mode must be one of the listed values
+					stringvalidator.OneOf(getModes()...),
+				},
+			},
+			"default_mode": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				MarkdownDescription: fmt.Sprintf(
+					"The outbound NAT mode restored when this resource is destroyed. Must be one of: %s. Defaults to `automatic`.", modeList,
+				),
+				Validators: []validator.String{
+					// Mode must be one of the listed values
+					stringvalidator.OneOf(getModes()...),
+				},
+				Default: stringdefault.StaticString(modeAutomatic),
+			},
+			"content_hash": schema.StringAttribute{
+				Computed:    true,
+				Description: fmt.Sprintf("Hash of the %s on OPNsense. Changes whenever the configuration is modified, including changes made outside of Terraform.", resourceName),
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *natOutboundSettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*opnsense.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *opnsense.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *natOutboundSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, fmt.Sprintf("Creating %s", resourceName))
+
+	// Read Terraform plan data into the model
+	var plan natOutboundSettingsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set outbound NAT settings on OPNsense
+	tflog.Debug(ctx, fmt.Sprintf("Setting %s on OPNsense", resourceName), map[string]any{"mode": plan.Mode.ValueString()})
+
+	err := setOutboundSettings(r.client, outboundSettings{Mode: plan.Mode.ValueString()})
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Create %s error", resourceName), fmt.Sprintf("%s", err))
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Apply configuration on OPNsense
+	tflog.Debug(ctx, "Applying configuration on OPNsense")
+
+	err = applyOutboundSettingsConfig(r.client)
+	if err != nil {
+		resp.Diagnostics.AddWarning(fmt.Sprintf("Create %s error", resourceName), fmt.Sprintf("%s", err))
+	} else {
+		tflog.Debug(ctx, "Successfully applied configuration on OPNsense", map[string]any{"success": true})
+	}
+
+	// Get content hash from OPNsense
+	contentHash, err := getOutboundSettingsContentHash(r.client)
+	contentHashValue, diags := utils.ContentHashValue(fmt.Sprintf("Create %s error", resourceName), contentHash, err)
+	resp.Diagnostics.Append(diags...)
+	plan.ContentHash = contentHashValue
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Successfully created %s", resourceName))
+}
+
+// Read resource information.
+func (r *natOutboundSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, fmt.Sprintf("Reading %s", resourceName))
+
+	// Read Terraform prior state data into the model
+	var state natOutboundSettingsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get outbound NAT settings
+	tflog.Debug(ctx, fmt.Sprintf("Getting %s", resourceName))
+
+	settings, err := getOutboundSettings(r.client)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Read %s error", resourceName), fmt.Sprintf("%s", err))
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Successfully got %s", resourceName), map[string]any{"success": true})
+
+	// Overwite items with refreshed state
+	contentHash, err := utils.ContentHash(settings)
+	contentHashValue, diags := utils.ContentHashValue(fmt.Sprintf("Read %s error", resourceName), contentHash, err)
+	resp.Diagnostics.Append(diags...)
+	state.ContentHash = contentHashValue
+
+	state.Mode = types.StringValue(settings.Mode)
+
+	// The default mode is only known to Terraform
+	if state.DefaultMode.IsNull() {
+		state.DefaultMode = types.StringValue(modeAutomatic)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Successfully read %s", resourceName))
+}
+
+// Update updates the resource on OPNsense and the Terraform state.
+func (r *natOutboundSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, fmt.Sprintf("Updating %s", resourceName))
+
+	// Read Terraform plan data into the model
+	var plan natOutboundSettingsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update outbound NAT settings on OPNsense
+	tflog.Debug(ctx, fmt.Sprintf("Updating %s on OPNsense", resourceName), map[string]any{"mode": plan.Mode.ValueString()})
+
+	err := setOutboundSettings(r.client, outboundSettings{Mode: plan.Mode.ValueString()})
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Update %s error", resourceName), fmt.Sprintf("%s", err))
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Apply configuration on OPNsense
+	tflog.Debug(ctx, "Applying configuration on OPNsense")
+
+	err = applyOutboundSettingsConfig(r.client)
+	if err != nil {
+		resp.Diagnostics.AddWarning(fmt.Sprintf("Update %s error", resourceName), fmt.Sprintf("%s", err))
+	} else {
+		tflog.Debug(ctx, "Successfully applied configuration on OPNsense", map[string]any{"success": true})
+	}
+
+	// Get content hash from OPNsense
+	contentHash, err := getOutboundSettingsContentHash(r.client)
+	contentHashValue, diags := utils.ContentHashValue(fmt.Sprintf("Update %s error", resourceName), contentHash, err)
+	resp.Diagnostics.Append(diags...)
+	plan.ContentHash = contentHashValue
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Successfully updated %s", resourceName))
+}
+
+// Delete restores the default outbound NAT mode on OPNsense and removes the resource from the Terraform state.
+func (r *natOutboundSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, fmt.Sprintf("Deleting %s", resourceName))
+
+	// Read Terraform prior state data into the model
+	var state natOutboundSettingsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Restore default outbound NAT mode on OPNsense
+	defaultMode := modeAutomatic
+	if !state.DefaultMode.IsNull() {
+		defaultMode = state.DefaultMode.ValueString()
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Restoring default %s on OPNsense", resourceName), map[string]any{"mode": defaultMode})
+
+	err := setOutboundSettings(r.client, outboundSettings{Mode: defaultMode})
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Delete %s error", resourceName), fmt.Sprintf("%s", err))
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Apply configuration on OPNsense
+	tflog.Debug(ctx, "Applying configuration on OPNsense")
+
+	err = applyOutboundSettingsConfig(r.client)
+	if err != nil {
+		resp.Diagnostics.AddWarning(fmt.Sprintf("Delete %s error", resourceName), fmt.Sprintf("%s", err))
+	} else {
+		tflog.Debug(ctx, "Successfully applied configuration on OPNsense", map[string]any{"success": true})
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Successfully deleted %s", resourceName))
+}
+
+// ImportState imports the resource from OPNsense and enables Terraform to begin managing the resource.
+func (r *natOutboundSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Info(ctx, fmt.Sprintf("Importing %s", resourceName))
+
+	// Nothing special needs to be done since the read function does not require any attributes.
+	// Setting mode to an empty string to prevent terraform from throwing a missing resource import state
+	// error.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("mode"), "")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Successfully imported %s", resourceName))
+}
@@ -0,0 +1,112 @@
+package outbound_test
+
+import (
+	"terraform-provider-opnsense/internal/acctest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccNatOutboundSettingsResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccNatOutboundSettingsResourceConfig,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("opnsense_firewall_nat_outbound_settings.test_acc_resource", tfjsonpath.New("mode"), knownvalue.StringExact("hybrid")),
+					statecheck.ExpectKnownValue("opnsense_firewall_nat_outbound_settings.test_acc_resource", tfjsonpath.New("default_mode"), knownvalue.StringExact("automatic")),
+				},
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "opnsense_firewall_nat_outbound_settings.test_acc_resource",
+				ImportState:                          true,
+				ImportStateId:                        "",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "mode",
+			},
+			// Update and Read testing
+			{
+				Config: testAccNatOutboundSettingsResourceConfig_modified,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("opnsense_firewall_nat_outbound_settings.test_acc_resource", tfjsonpath.New("mode"), knownvalue.StringExact("manual")),
+					statecheck.ExpectKnownValue("opnsense_firewall_nat_outbound_settings.test_acc_resource", tfjsonpath.New("default_mode"), knownvalue.StringExact("hybrid")),
+				},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccNatOutboundSettingsResource_modes(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccNatOutboundSettingsResourceConfig_disabled,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("opnsense_firewall_nat_outbound_settings.test_acc_resource", tfjsonpath.New("mode"), knownvalue.StringExact("disabled")),
+				},
+			},
+			// Refresh testing, the mode read from OPNsense must match the configured mode
+			{
+				RefreshState: true,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("opnsense_firewall_nat_outbound_settings.test_acc_resource", "mode", "disabled"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccNatOutboundSettingsResourceConfig_automatic,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("opnsense_firewall_nat_outbound_settings.test_acc_resource", tfjsonpath.New("mode"), knownvalue.StringExact("automatic")),
+				},
+			},
+			// Refresh testing, the mode read from OPNsense must match the configured mode
+			{
+				RefreshState: true,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("opnsense_firewall_nat_outbound_settings.test_acc_resource", "mode", "automatic"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+// testAccNatOutboundSettingsResourceConfig defines an outbound NAT settings resource.
+const testAccNatOutboundSettingsResourceConfig = `
+	resource "opnsense_firewall_nat_outbound_settings" "test_acc_resource" {
+		mode = "hybrid"
+	}
+`
+
+// testAccNatOutboundSettingsResourceConfig_modified defines a modified outbound NAT settings resource.
+const testAccNatOutboundSettingsResourceConfig_modified = `
+	resource "opnsense_firewall_nat_outbound_settings" "test_acc_resource" {
+		mode         = "manual"
+		default_mode = "hybrid"
+	}
+`
+
+// testAccNatOutboundSettingsResourceConfig_disabled defines an outbound NAT settings resource with outbound NAT disabled.
+const testAccNatOutboundSettingsResourceConfig_disabled = `
+	resource "opnsense_firewall_nat_outbound_settings" "test_acc_resource" {
+		mode = "disabled"
+	}
+`
+
+// testAccNatOutboundSettingsResourceConfig_automatic defines an outbound NAT settings resource with automatic outbound NAT.
+const testAccNatOutboundSettingsResourceConfig_automatic = `
+	resource "opnsense_firewall_nat_outbound_settings" "test_acc_resource" {
+		mode = "automatic"
+	}
+`
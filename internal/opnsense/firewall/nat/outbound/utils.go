@@ -0,0 +1,46 @@
+package outbound
+
+import (
+	"terraform-provider-opnsense/internal/utils"
+)
+
+const (
+	outboundController string = "outbound"
+
+	resourceName string = "outbound NAT settings"
+)
+
+type outboundSettings struct {
+	Mode string
+}
+
+// Outbound NAT values
+
+func getModes() []string {
+	return modes.GetAllKeys()
+}
+
+// Mode mappings
+const (
+	modeAutomatic string = "automatic"
+	modeHybrid    string = "hybrid"
+	modeManual    string = "manual"
+	modeDisabled  string = "disabled"
+)
+
+var modeMappings = map[string]string{
+	modeAutomatic: "automatic",
+	modeHybrid:    "hybrid",
+	modeManual:    "advanced",
+	modeDisabled:  "disabled",
+}
+
+var modes = getBidirectionalMode()
+
+func getBidirectionalMode() *utils.BidirectionalMap {
+	modes := utils.NewBidirectionalMap()
+	for key, value := range modeMappings {
+		modes.Put(key, value)
+	}
+	return modes
+}
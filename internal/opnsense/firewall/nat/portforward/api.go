@@ -0,0 +1,359 @@
+package portforward
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"terraform-provider-opnsense/internal/opnsense"
+	"terraform-provider-opnsense/internal/opnsense/firewall"
+	"terraform-provider-opnsense/internal/opnsense/firewall/category"
+	"terraform-provider-opnsense/internal/utils"
+)
+
+const (
+	portForwardOpnsenseController string = "d_nat"
+
+	addPortForwardCommand         opnsense.Command = "add_rule"
+	getPortForwardCommand         opnsense.Command = "get_rule"
+	setPortForwardCommand         opnsense.Command = "set_rule"
+	deletePortForwardCommand      opnsense.Command = "del_rule"
+	applyPortForwardConfigCommand opnsense.Command = "apply"
+)
+
+// HTTP request bodies
+
+type portForwardHttpBody struct {
+	Rule portForwardRequest `json:"rule"`
+}
+
+type portForwardRequest struct {
+	Disabled      uint8                     `json:"disabled"`
+	NoRedirect    uint8                     `json:"nordr"`
+	Sequence      int32                     `json:"sequence"`
+	Interface     string                    `json:"interface"`
+	IpVersion     string                    `json:"ipprotocol"`
+	Protocol      string                    `json:"protocol"`
+	Source        portForwardAddressRequest `json:"source"`
+	Destination   portForwardAddressRequest `json:"destination"`
+	Target        string                    `json:"target"`
+	TargetPort    string                    `json:"local-port"`
+	NatReflection string                    `json:"natreflection"`
+	FilterRule    string                    `json:"associated-rule-id"`
+	Log           uint8                     `json:"log"`
+	Categories    string                    `json:"category"`
+	Description   string                    `json:"descr"`
+}
+
+type portForwardAddressRequest struct {
+	Network string `json:"network"`
+	Not     uint8  `json:"not"`
+	Port    string `json:"port"`
+}
+
+// HTTP response types
+
+type getPortForwardResponse struct {
+	Rule portForwardRuleResponse `json:"rule"`
+}
+
+type portForwardRuleResponse struct {
+	Disabled      uint8                      `json:"disabled,string"`
+	NoRedirect    uint8                      `json:"nordr,string"`
+	Sequence      int32                      `json:"sequence,string"`
+	Interface     portForwardOptions         `json:"interface"`
+	IpVersion     portForwardOptions         `json:"ipprotocol"`
+	Protocol      portForwardOptions         `json:"protocol"`
+	Source        portForwardAddressResponse `json:"source"`
+	Destination   portForwardAddressResponse `json:"destination"`
+	Target        string                     `json:"target"`
+	TargetPort    string                     `json:"local-port"`
+	NatReflection portForwardOptions         `json:"natreflection"`
+	FilterRule    string                     `json:"associated-rule-id"`
+	Log           uint8                      `json:"log,string"`
+	Categories    portForwardOptions         `json:"category"`
+	Description   string                     `json:"descr"`
+}
+
+type portForwardAddressResponse struct {
+	Network string `json:"network"`
+	Not     uint8  `json:"not,string"`
+	Port    string `json:"port"`
+}
+
+// portForwardOptions describes an option field in OPNsense HTTP responses.
+type portForwardOptions map[string]struct {
+	Value    string `json:"value"`
+	Selected uint8  `json:"selected"`
+}
+
+// selected returns the first selected option, or an empty string if none is selected.
+func (o portForwardOptions) selected() string {
+	for name, value := range o {
+		if value.Selected == 1 {
+			return name
+		}
+	}
+	return ""
+}
+
+// Helper functions
+
+// portForwardToHttpBody converts a port forward object to a portForwardHttpBody object for sending to the OPNsense API.
+func portForwardToHttpBody(portForward portForward) portForwardHttpBody {
+	return portForwardHttpBody{
+		Rule: portForwardRequest{
+			Disabled:   utils.BoolToInt(!portForward.Enabled),
+			NoRedirect: utils.BoolToInt(portForward.NoRedirect),
+			Sequence:   portForward.Sequence,
+			Interface:  portForward.Interface,
+			IpVersion:  portForward.IpVersion,
+			Protocol:   portForward.Protocol,
+			Source: portForwardAddressRequest{
+				Network: portForward.Source,
+				Not:     utils.BoolToInt(portForward.SourceNot),
+				Port:    portForward.SourcePort,
+			},
+			Destination: portForwardAddressRequest{
+				Network: portForward.Destination,
+				Not:     utils.BoolToInt(portForward.DestinationNot),
+				Port:    portForward.DestinationPort,
+			},
+			Target:        portForward.Target,
+			TargetPort:    portForward.TargetPort,
+			NatReflection: portForward.NatReflection,
+			FilterRule:    portForward.FilterRule,
+			Log:           utils.BoolToInt(portForward.Log),
+			Categories:    strings.Join(portForward.Categories.Elements(), ","),
+			Description:   portForward.Description,
+		},
+	}
+}
+
+// unsupportedApiError returns the error reported when the OPNsense firewall does not provide the d_nat API.
+func unsupportedApiError(action string) error {
+	return fmt.Errorf("%[1]s %[2]s error: the `%[3]s/%[4]s` API is not available on this OPNsense firewall. Port forward rules can only be managed on OPNsense versions that provide this API, please upgrade OPNsense to use this resource.", action, resourceName, firewall.Module, portForwardOpnsenseController)
+}
+
+// addPortForward creates a port forward rule on the OPNsense firewall. Returns the UUID on successful creation.
+func addPortForward(client *opnsense.Client, portForward portForward) (string, error) {
+	path := fmt.Sprintf("%s/%s/%s", firewall.Module, portForwardOpnsenseController, addPortForwardCommand)
+
+	// Generate API body from port forward rule object
+	body := portForwardToHttpBody(portForward)
+	reqBody, err := json.Marshal(body)
+	if err != nil {
+		return "", fmt.Errorf("Add %s error: failed to marshal json body - %s", resourceName, err)
+	}
+
+	httpResp, err := client.DoRequest(http.MethodPost, path, reqBody)
+	if err != nil {
+		return "", fmt.Errorf("OPNsense client error: %s", err)
+	}
+
+	if httpResp.StatusCode == 404 {
+		return "", unsupportedApiError("Add")
+	}
+
+	if httpResp.StatusCode != 200 {
+		return "", fmt.Errorf("Add %s error (http): abnormal status code %d in HTTP response. Please contact the provider for assistance", resourceName, httpResp.StatusCode)
+	}
+
+	var response opnsense.OpnsenseAddItemResponse
+	err = json.NewDecoder(httpResp.Body).Decode(&response)
+	if err != nil {
+		return "", fmt.Errorf("Add %s error (http): failed to decode http response - %s", resourceName, err)
+	}
+
+	if strings.ToLower(response.Result) == "failed" {
+		return "", fmt.Errorf("Add %[1]s error: failed to add %[1]s to OPNsense - failed validations:\n%s", resourceName, opnsense.ValidationsToString(response.Validations))
+	}
+
+	return response.Uuid, nil
+}
+
+// getPortForward searches the OPNsense firewall for the port forward rule with a matching UUID.
+func getPortForward(client *opnsense.Client, uuid string) (*portForward, error) {
+	path := fmt.Sprintf("%s/%s/%s/%s", firewall.Module, portForwardOpnsenseController, getPortForwardCommand, uuid)
+
+	httpResp, err := client.DoRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, fmt.Errorf("OPNsense client error: %s", err)
+	}
+	if httpResp.StatusCode == 404 {
+		return nil, unsupportedApiError("Get")
+	}
+	if httpResp.StatusCode != 200 {
+		return nil, fmt.Errorf("Get %s error (http): abnormal status code %d in HTTP response. Please contact the provider for assistance", resourceName, httpResp.StatusCode)
+	}
+
+	var response getPortForwardResponse
+	err = json.NewDecoder(httpResp.Body).Decode(&response)
+	if err != nil {
+		var jsonTypeError *json.UnmarshalTypeError
+		if errors.As(err, &jsonTypeError) && jsonTypeError.Value == "array" {
+			return nil, fmt.Errorf("Get %[1]s error: %[1]s with uuid `%[2]s` does not exist.\n\nIf this occurs in a resource block, it is usually because the %[1]s is removed from OPNsense (not using terraform) but is still present in the terraform state. Remove the missing %[1]s from the terraform state to rectify the error.", resourceName, uuid)
+		}
+		return nil, fmt.Errorf("Get %s error (http): %s", resourceName, err)
+	}
+
+	// Extract values from response
+	ipVersion, exists := ipVersions.GetByValue(response.Rule.IpVersion.selected())
+	if !exists {
+		return nil, fmt.Errorf("Get %s error: Ip version `%s` not supported. Please contact the provider maintainers.", resourceName, response.Rule.IpVersion.selected())
+	}
+
+	natReflection := response.Rule.NatReflection.selected()
+	if natReflection == "" {
+		natReflection = "default"
+	}
+
+	categories := utils.NewSet()
+	for name, value := range response.Rule.Categories {
+		if value.Selected == 1 && value.Value != "" {
+			categoryName, err := category.GetCategoryName(client, name)
+			if err != nil {
+				return nil, fmt.Errorf("Get %s error: failed to get category - %s", resourceName, err)
+			}
+
+			categories.Add(categoryName)
+		}
+	}
+
+	return &portForward{
+		Enabled:         response.Rule.Disabled == 0,
+		NoRedirect:      response.Rule.NoRedirect == 1,
+		Sequence:        response.Rule.Sequence,
+		Interface:       response.Rule.Interface.selected(),
+		IpVersion:       ipVersion,
+		Protocol:        strings.ToLower(response.Rule.Protocol.selected()),
+		Source:          response.Rule.Source.Network,
+		SourceNot:       response.Rule.Source.Not == 1,
+		SourcePort:      response.Rule.Source.Port,
+		Destination:     response.Rule.Destination.Network,
+		DestinationNot:  response.Rule.Destination.Not == 1,
+		DestinationPort: response.Rule.Destination.Port,
+		Target:          response.Rule.Target,
+		TargetPort:      response.Rule.TargetPort,
+		NatReflection:   natReflection,
+		FilterRule:      filterRuleFromOpnsense(response.Rule.FilterRule),
+		Log:             response.Rule.Log == 1,
+		Categories:      categories,
+		Description:     response.Rule.Description,
+	}, nil
+}
+
+// setPortForward updates an existing port forward rule on the OPNsense firewall with a matching UUID.
+func setPortForward(client *opnsense.Client, portForward portForward, uuid string) error {
+	path := fmt.Sprintf("%s/%s/%s/%s", firewall.Module, portForwardOpnsenseController, setPortForwardCommand, uuid)
+
+	// Generate API body from port forward rule object
+	body := portForwardToHttpBody(portForward)
+	reqBody, err := json.Marshal(body)
+	if err != nil {
+		return fmt.Errorf("Set %s error: failed to marshal json body - %s", resourceName, err)
+	}
+
+	httpResp, err := client.DoRequest(http.MethodPost, path, reqBody)
+	if err != nil {
+		return fmt.Errorf("OPNsense client error: %s", err)
+	}
+
+	if httpResp.StatusCode == 404 {
+		return unsupportedApiError("Set")
+	}
+
+	if httpResp.StatusCode != 200 {
+		return fmt.Errorf("Set %s error (http): abnormal status code %d in HTTP response. Please contact the provider for assistance", resourceName, httpResp.StatusCode)
+	}
+
+	var response opnsense.OpnsenseAddItemResponse
+	err = json.NewDecoder(httpResp.Body).Decode(&response)
+	if err != nil {
+		return fmt.Errorf("Set %s error (http): failed to decode http response - %s", resourceName, err)
+	}
+
+	if strings.ToLower(response.Result) == "failed" {
+		return fmt.Errorf("Set %[1]s error: failed to update %[1]s on OPNsense - failed validations:\n%s", resourceName, opnsense.ValidationsToString(response.Validations))
+	}
+
+	return nil
+}
+
+// deletePortForward removes an existing port forward rule from the OPNsense firewall with a matching UUID.
+func deletePortForward(client *opnsense.Client, uuid string) error {
+	path := fmt.Sprintf("%s/%s/%s/%s", firewall.Module, portForwardOpnsenseController, deletePortForwardCommand, uuid)
+
+	// Generate empty body
+	reqBody, err := json.Marshal(nil)
+	if err != nil {
+		return fmt.Errorf("Delete %s error: failed to marshal json body - %s", resourceName, err)
+	}
+
+	httpResp, err := client.DoRequest(http.MethodPost, path, reqBody)
+	if err != nil {
+		return fmt.Errorf("OPNsense client error: %s", err)
+	}
+
+	if httpResp.StatusCode == 404 {
+		return unsupportedApiError("Delete")
+	}
+
+	if httpResp.StatusCode != 200 {
+		return fmt.Errorf("Delete %s error (http): abnormal status code %d in HTTP response. Please contact the provider for assistance", resourceName, httpResp.StatusCode)
+	}
+
+	var resp opnsense.OpnsenseAddItemResponse
+	err = json.NewDecoder(httpResp.Body).Decode(&resp)
+	if err != nil {
+		return fmt.Errorf("Delete %s error (http): failed to decode http response - %s", resourceName, err)
+	}
+
+	if strings.ToLower(resp.Result) != "deleted" && strings.ToLower(resp.Result) != "not found" {
+		return fmt.Errorf("Delete %[1]s error: failed to delete %[1]s on OPNsense. Please contact the provider maintainers for assistance", resourceName)
+	}
+	return nil
+}
+
+// applyPortForwardConfig applies the port forward configuration on the OPNsense firewall.
+func applyPortForwardConfig(client *opnsense.Client) error {
+	path := fmt.Sprintf("%s/%s/%s", firewall.Module, portForwardOpnsenseController, applyPortForwardConfigCommand)
+
+	// Generate empty body
+	reqBody, err := json.Marshal(nil)
+	if err != nil {
+		return fmt.Errorf("Apply configuration error: failed to marshal json body - %s", err)
+	}
+
+	httpResp, err := client.DoRequest(http.MethodPost, path, reqBody)
+	if err != nil {
+		return fmt.Errorf("OPNsense client error: %s", err)
+	}
+
+	if httpResp.StatusCode != 200 {
+		return fmt.Errorf("Apply configuration error (http): abnormal status code %d in HTTP response. Please contact the provider for assistance", httpResp.StatusCode)
+	}
+
+	var resp opnsense.OpnsenseApplyConfigResponse
+	err = json.NewDecoder(httpResp.Body).Decode(&resp)
+	if err != nil {
+		return fmt.Errorf("Apply configuration error (http): failed to decode http response - %s", err)
+	}
+
+	if strings.Trim(strings.ToLower(resp.Status), "\n") != "ok" {
+		return fmt.Errorf("Apply configuration error: failed to apply configuration on OPNsense. Please contact the provider maintainers for assistance")
+	}
+	return nil
+}
+
+// getPortForwardContentHash gets the content hash of the port forward rule from OPNsense.
+func getPortForwardContentHash(client *opnsense.Client, uuid string) (string, error) {
+	object, err := getPortForward(client, uuid)
+	if err != nil {
+		return "", err
+	}
+
+	return utils.ContentHash(object)
+}
@@ -0,0 +1,74 @@
+package portforward_test
+
+import (
+	"testing"
+
+	"terraform-provider-opnsense/internal/acctest"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccNatPortForwardDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing (via id)
+			{
+				Config: testAccNatPortForwardDataSourceConfig_id,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("data.opnsense_firewall_nat_port_forward.test_acc_data_source", tfjsonpath.New("enabled"), knownvalue.Bool(true)),
+					statecheck.ExpectKnownValue("data.opnsense_firewall_nat_port_forward.test_acc_data_source", tfjsonpath.New("no_redirect"), knownvalue.Bool(false)),
+					statecheck.ExpectKnownValue("data.opnsense_firewall_nat_port_forward.test_acc_data_source", tfjsonpath.New("sequence"), knownvalue.Int32Exact(2)),
+					statecheck.ExpectKnownValue("data.opnsense_firewall_nat_port_forward.test_acc_data_source", tfjsonpath.New("interface"), knownvalue.StringExact("wan")),
+					statecheck.ExpectKnownValue("data.opnsense_firewall_nat_port_forward.test_acc_data_source", tfjsonpath.New("ip_version"), knownvalue.StringExact("ipv4")),
+					statecheck.ExpectKnownValue("data.opnsense_firewall_nat_port_forward.test_acc_data_source", tfjsonpath.New("protocol"), knownvalue.StringExact("tcp")),
+					statecheck.ExpectKnownValue("data.opnsense_firewall_nat_port_forward.test_acc_data_source", tfjsonpath.New("source"), knownvalue.StringExact("any")),
+					statecheck.ExpectKnownValue("data.opnsense_firewall_nat_port_forward.test_acc_data_source", tfjsonpath.New("source_not"), knownvalue.Bool(false)),
+					statecheck.ExpectKnownValue("data.opnsense_firewall_nat_port_forward.test_acc_data_source", tfjsonpath.New("source_port"), knownvalue.StringExact("")),
+					statecheck.ExpectKnownValue("data.opnsense_firewall_nat_port_forward.test_acc_data_source", tfjsonpath.New("destination"), knownvalue.StringExact("wanip")),
+					statecheck.ExpectKnownValue("data.opnsense_firewall_nat_port_forward.test_acc_data_source", tfjsonpath.New("destination_not"), knownvalue.Bool(false)),
+					statecheck.ExpectKnownValue("data.opnsense_firewall_nat_port_forward.test_acc_data_source", tfjsonpath.New("destination_port"), knownvalue.StringExact("8080")),
+					statecheck.ExpectKnownValue("data.opnsense_firewall_nat_port_forward.test_acc_data_source", tfjsonpath.New("target"), knownvalue.StringExact("10.0.0.10")),
+					statecheck.ExpectKnownValue("data.opnsense_firewall_nat_port_forward.test_acc_data_source", tfjsonpath.New("target_port"), knownvalue.StringExact("80")),
+					statecheck.ExpectKnownValue("data.opnsense_firewall_nat_port_forward.test_acc_data_source", tfjsonpath.New("nat_reflection"), knownvalue.StringExact("default")),
+					statecheck.ExpectKnownValue("data.opnsense_firewall_nat_port_forward.test_acc_data_source", tfjsonpath.New("filter_rule"), knownvalue.StringExact("associated")),
+					statecheck.ExpectKnownValue("data.opnsense_firewall_nat_port_forward.test_acc_data_source", tfjsonpath.New("log"), knownvalue.Bool(true)),
+					statecheck.ExpectKnownValue("data.opnsense_firewall_nat_port_forward.test_acc_data_source", tfjsonpath.New("categories"), knownvalue.ListExact([]knownvalue.Check{
+						knownvalue.StringExact("perm_test_acc_category"),
+					})),
+					statecheck.ExpectKnownValue("data.opnsense_firewall_nat_port_forward.test_acc_data_source", tfjsonpath.New("description"), knownvalue.StringExact("port forward rule for terraform data source testing")),
+				},
+			},
+		},
+	})
+}
+
+// testAccNatPortForwardDataSourceConfig_id creates a port forward resource and imports it as a data source via its id.
+const testAccNatPortForwardDataSourceConfig_id = `
+	resource "opnsense_firewall_nat_port_forward" "test_acc_data_source" {
+		enabled          = true
+		sequence         = 2
+		interface        = "wan"
+		ip_version       = "ipv4"
+		protocol         = "tcp"
+		destination      = "wanip"
+		destination_port = "8080"
+		target           = "10.0.0.10"
+		target_port      = "80"
+		nat_reflection   = "default"
+		filter_rule      = "associated"
+		log              = true
+		categories = [
+			"perm_test_acc_category"
+		]
+		description = "port forward rule for terraform data source testing"
+	}
+
+	data "opnsense_firewall_nat_port_forward" "test_acc_data_source" {
+		id = opnsense_firewall_nat_port_forward.test_acc_data_source.id
+	}
+`
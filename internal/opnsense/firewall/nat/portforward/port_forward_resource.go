@@ -0,0 +1,562 @@
+package portforward
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"terraform-provider-opnsense/internal/customtypes"
+	"terraform-provider-opnsense/internal/opnsense"
+	"terraform-provider-opnsense/internal/opnsense/firewall"
+	"terraform-provider-opnsense/internal/opnsense/firewall/nat"
+	"terraform-provider-opnsense/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                   = &natPortForwardResource{}
+	_ resource.ResourceWithConfigure      = &natPortForwardResource{}
+	_ resource.ResourceWithImportState    = &natPortForwardResource{}
+	_ resource.ResourceWithValidateConfig = &natPortForwardResource{}
+)
+
+// NewNatPortForwardResource is a helper function to simplify the provider implementation.
+func NewNatPortForwardResource() resource.Resource {
+	return &natPortForwardResource{}
+}
+
+// natPortForwardResource defines the resource implementation.
+type natPortForwardResource struct {
+	client *opnsense.Client
+}
+
+// natPortForwardResourceModel describes the resource data model.
+type natPortForwardResourceModel struct {
+	Id              types.String                           `tfsdk:"id"`
+	ContentHash     types.String                           `tfsdk:"content_hash"`
+	Enabled         types.Bool                             `tfsdk:"enabled"`
+	NoRedirect      types.Bool                             `tfsdk:"no_redirect"`
+	Sequence        types.Int32                            `tfsdk:"sequence"`
+	Interface       types.String                           `tfsdk:"interface"`
+	IpVersion       types.String                           `tfsdk:"ip_version"`
+	Protocol        customtypes.CaseInsensitiveStringValue `tfsdk:"protocol"`
+	Source          customtypes.NetworkValue               `tfsdk:"source"`
+	SourceNot       types.Bool                             `tfsdk:"source_not"`
+	SourcePort      customtypes.PortValue                  `tfsdk:"source_port"`
+	Destination     customtypes.NetworkValue               `tfsdk:"destination"`
+	DestinationNot  types.Bool                             `tfsdk:"destination_not"`
+	DestinationPort customtypes.PortValue                  `tfsdk:"destination_port"`
+	Target          customtypes.NetworkValue               `tfsdk:"target"`
+	TargetPort      customtypes.PortValue                  `tfsdk:"target_port"`
+	NatReflection   types.String                           `tfsdk:"nat_reflection"`
+	FilterRule      types.String                           `tfsdk:"filter_rule"`
+	Log             types.Bool                             `tfsdk:"log"`
+	Categories      types.Set                              `tfsdk:"categories"`
+	Description     types.String                           `tfsdk:"description"`
+}
+
+// Metadata returns the resource type name.
+func (r *natPortForwardResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_%s_%s_%s", req.ProviderTypeName, firewall.TypeName, nat.NatController, portForwardController)
+}
+
+// Schema defines the schema for the resource.
+func (r *natPortForwardResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	emptySet, _ := basetypes.NewSetValue(types.StringType, []attr.Value{})
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Port forwarding (destination NAT) redirects traffic arriving on an interface to another host and/or port, typically to expose internal services. Requires an OPNsense version that provides the `firewall/d_nat` API.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: fmt.Sprintf("Identifier of the %s.", resourceName),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"content_hash": schema.StringAttribute{
+				Computed:    true,
+				Description: fmt.Sprintf("Hash of the %s configuration on OPNsense. Changes whenever the configuration is modified, including changes made outside of Terraform.", resourceName),
+			},
+			"enabled": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Whether the rule is enabled. Defaults to `true`.",
+				Default:             booldefault.StaticBool(true),
+			},
+			"no_redirect": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Disable redirection for traffic matching this rule. Can be used to exclude traffic from a broader port forward rule. Defaults to `false`.",
+				Default:             booldefault.StaticBool(false),
+			},
+			"sequence": schema.Int32Attribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Order in which multiple matching rules are evaluated and applied (lowest first). Defaults to `1`.",
+				Validators:          []validator.Int32{int32validator.Between(1, 999999)},
+				Default:             int32default.StaticInt32(1),
+			},
+			"interface": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Interface this rule applies to. Use the interface identifiers (e.g `lan`, `opt1`).",
+			},
+			"ip_version": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				MarkdownDescription: fmt.Sprintf(
+					"The applicable ip version this for this rule. Must be one of: %s. Defaults to `ipv4`.", strings.Join(
+						// Surround each type with backticks (`)
+						utils.SliceMap(getIpVersions(), func(proto string) string {
+							return fmt.Sprintf("`%s`", proto)
+						}),
+						", ",
+					),
+				),
+				Validators: []validator.String{
+					// Type must be one of the listed values
+					stringvalidator.OneOf(getIpVersions()...),
+				},
+				Default: stringdefault.StaticString("ipv4"),
+			},
+			"protocol": schema.StringAttribute{
+				CustomType: customtypes.CaseInsensitiveStringType{},
+				Optional:   true,
+				Computed:   true,
+				MarkdownDescription: fmt.Sprintf(
+					"The applicable protocol for this rule. Must be one of: %s. Defaults to `tcp`.", strings.Join(
+						// Surround each type with backticks (`)
+						utils.SliceMap(getProtocols(), func(proto string) string {
+							return fmt.Sprintf("`%s`", proto)
+						}),
+						", ",
+					),
+				),
+				Validators: []validator.String{
+					// Type must be one of the listed values
+					stringvalidator.OneOf(getProtocols()...),
+				},
+				Default: stringdefault.StaticString("tcp"),
+			},
+			"source": schema.StringAttribute{
+				CustomType:          customtypes.NetworkType{},
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Source IP or network. Can be a single network/host, alias or predefined network. For interface addresses, add `ip` to the end of the interface name (e.g `opt1ip`). Defaults to `any`",
+				Default:             stringdefault.StaticString("any"),
+			},
+			"source_not": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Whether the source matching should be inverted. Defaults to `false`.",
+				Default:             booldefault.StaticBool(false),
+			},
+			"source_port": schema.StringAttribute{
+				CustomType:          customtypes.PortType{},
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "[Only for `tcp`, `udp` & `tcp/udp` protocols] Source port number or well known name (`imap`, `imaps`, `http`, `https`, ...), for ranges use a dash. Usually left empty, as the source port of a connection is random.",
+				Default:             stringdefault.StaticString(""),
+			},
+			"destination": schema.StringAttribute{
+				CustomType:          customtypes.NetworkType{},
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Destination IP or network. Can be a single network/host, alias or predefined network. For interface addresses, add `ip` to the end of the interface name (e.g `wanip`). Defaults to `any`",
+				Default:             stringdefault.StaticString("any"),
+			},
+			"destination_not": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Whether the destination matching should be inverted. Defaults to `false`.",
+				Default:             booldefault.StaticBool(false),
+			},
+			"destination_port": schema.StringAttribute{
+				CustomType:          customtypes.PortType{},
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "[Only for `tcp`, `udp` & `tcp/udp` protocols] Destination port number or well known name (`imap`, `imaps`, `http`, `https`, ...), for ranges use a dash.",
+				Default:             stringdefault.StaticString(""),
+			},
+			"target": schema.StringAttribute{
+				CustomType:          customtypes.NetworkType{},
+				Required:            true,
+				MarkdownDescription: "IP address or alias of the host to redirect matching packets to.",
+			},
+			"target_port": schema.StringAttribute{
+				CustomType:          customtypes.PortType{},
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "[Only for `tcp`, `udp` & `tcp/udp` protocols] Port number or well known name on the target host to redirect matching packets to. For ranges, specify the first port of the range. Leave empty to keep the destination port.",
+				Default:             stringdefault.StaticString(""),
+			},
+			"nat_reflection": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				MarkdownDescription: fmt.Sprintf(
+					"Whether nat reflection should be enabled. Must be one of: %s. Defaults to `default`.", strings.Join(
+						// Surround each type with backticks (`)
+						utils.SliceMap(getNatReflectionOptions(), func(option string) string {
+							return fmt.Sprintf("`%s`", option)
+						}),
+						", ",
+					),
+				),
+				Validators: []validator.String{
+					// Type must be one of the listed values
+					stringvalidator.OneOf(getNatReflectionOptions()...),
+				},
+				Default: stringdefault.StaticString("default"),
+			},
+			"filter_rule": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				MarkdownDescription: fmt.Sprintf(
+					"How traffic matching this rule is let through the firewall. `associated` creates a filter rule which is kept in sync with this rule, `pass` passes the traffic without a filter rule and `none` requires a separate filter rule. Must be one of: %s. Defaults to `associated`.", strings.Join(
+						// Surround each type with backticks (`)
+						utils.SliceMap(getFilterRuleOptions(), func(option string) string {
+							return fmt.Sprintf("`%s`", option)
+						}),
+						", ",
+					),
+				),
+				Validators: []validator.String{
+					// Type must be one of the listed values
+					stringvalidator.OneOf(getFilterRuleOptions()...),
+				},
+				Default: stringdefault.StaticString(filterRuleAssociated),
+			},
+			"log": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Whether packets that are handled by this rule should be logged. Defaults to `false`.",
+				Default:             booldefault.StaticBool(false),
+			},
+			"categories": schema.SetAttribute{
+				Optional:    true,
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "The categories of the rule.",
+				Default:     setdefault.StaticValue(emptySet),
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Description to identify this rule.",
+				Default:     stringdefault.StaticString(""),
+			},
+		},
+	}
+}
+
+// ValidateConfig validates the resource configuration for cross-attribute constraints.
+func (r *natPortForwardResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config natPortForwardResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Skip validation if the protocol is not yet known
+	if config.Protocol.IsUnknown() {
+		return
+	}
+
+	// Protocol defaults to `tcp` when not configured
+	protocol := "tcp"
+	if !config.Protocol.IsNull() {
+		protocol = strings.ToLower(config.Protocol.ValueString())
+	}
+
+	if firewall.ProtocolSupportsPorts(protocol) {
+		return
+	}
+
+	// Ports are only applicable to the TCP & UDP protocols
+	ports := map[string]customtypes.PortValue{
+		"source_port":      config.SourcePort,
+		"destination_port": config.DestinationPort,
+		"target_port":      config.TargetPort,
+	}
+
+	for name, value := range ports {
+		if !value.IsNull() && !value.IsUnknown() && value.ValueString() != "" {
+			resp.Diagnostics.AddAttributeError(path.Root(name), "Invalid Attribute Combination", fmt.Sprintf("The `%s` attribute is only applicable when `protocol` is set to `tcp`, `udp` or `tcp/udp`, got: `%s`.", name, protocol))
+		}
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *natPortForwardResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*opnsense.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *opnsense.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *natPortForwardResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, fmt.Sprintf("Creating %s", resourceName))
+
+	// Read Terraform plan data into the model
+	var plan natPortForwardResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create port forward object
+	portForward, diags := createPortForward(ctx, r.client, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create port forward rule on OPNsense
+	tflog.Debug(ctx, fmt.Sprintf("Creating %s on OPNsense", resourceName), map[string]any{fmt.Sprintf("%s", resourceName): portForward})
+
+	uuid, err := addPortForward(r.client, portForward)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Create %s error", resourceName), fmt.Sprintf("%s", err))
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Apply configuration on OPNsense
+	tflog.Debug(ctx, "Applying configuration on OPNsense")
+
+	err = applyPortForwardConfig(r.client)
+	if err != nil {
+		resp.Diagnostics.AddWarning(fmt.Sprintf("Create %s error", resourceName), fmt.Sprintf("%s", err))
+	} else {
+		tflog.Debug(ctx, "Successfully applied configuration on OPNsense", map[string]any{"success": true})
+	}
+
+	// Get content hash from OPNsense
+	contentHash, err := getPortForwardContentHash(r.client, uuid)
+	plan.ContentHash, diags = utils.ContentHashValue(fmt.Sprintf("Create %s error", resourceName), contentHash, err)
+	resp.Diagnostics.Append(diags...)
+
+	// Update plan ID
+	plan.Id = types.StringValue(uuid)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Successfully created %s", resourceName))
+}
+
+// Read resource information.
+func (r *natPortForwardResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, fmt.Sprintf("Reading %s", resourceName))
+
+	var state natPortForwardResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get port forward rule
+	tflog.Debug(ctx, fmt.Sprintf("Getting %s information", resourceName))
+	tflog.SetField(ctx, "uuid", state.Id.ValueString())
+
+	rule, err := getPortForward(r.client, state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Read %s error", resourceName), fmt.Sprintf("%s", err))
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Successfully got %s information", resourceName), map[string]any{"success": true})
+
+	// Overwite items with refreshed state
+	contentHash, err := utils.ContentHash(rule)
+	contentHashValue, diags := utils.ContentHashValue(fmt.Sprintf("Read %s error", resourceName), contentHash, err)
+	resp.Diagnostics.Append(diags...)
+	state.ContentHash = contentHashValue
+
+	state.Enabled = types.BoolValue(rule.Enabled)
+	state.NoRedirect = types.BoolValue(rule.NoRedirect)
+	state.Sequence = types.Int32Value(rule.Sequence)
+	state.Interface = types.StringValue(rule.Interface)
+	state.IpVersion = types.StringValue(rule.IpVersion)
+	state.Protocol = customtypes.NewCaseInsensitiveStringValue(rule.Protocol)
+	state.Source = customtypes.NewNetworkValue(rule.Source)
+	state.SourceNot = types.BoolValue(rule.SourceNot)
+	state.SourcePort = customtypes.NewPortValue(rule.SourcePort)
+	state.Destination = customtypes.NewNetworkValue(rule.Destination)
+	state.DestinationNot = types.BoolValue(rule.DestinationNot)
+	state.DestinationPort = customtypes.NewPortValue(rule.DestinationPort)
+	state.Target = customtypes.NewNetworkValue(rule.Target)
+	state.TargetPort = customtypes.NewPortValue(rule.TargetPort)
+	state.NatReflection = types.StringValue(rule.NatReflection)
+	state.FilterRule = types.StringValue(rule.FilterRule)
+	state.Log = types.BoolValue(rule.Log)
+
+	categories, diags := utils.SetGoToTerraform(ctx, rule.Categories)
+	resp.Diagnostics.Append(diags...)
+	state.Categories = categories
+
+	state.Description = types.StringValue(rule.Description)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Successfully read %s", resourceName))
+}
+
+// Update updates the resource on OPNsense and the Terraform state.
+func (r *natPortForwardResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, fmt.Sprintf("Updating %s", resourceName))
+
+	// Read Terraform plan data into the model
+	var plan natPortForwardResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read current Terraform state data into the model
+	var state natPortForwardResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create port forward object
+	rule, diags := createPortForward(ctx, r.client, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update port forward rule on OPNsense
+	tflog.Debug(ctx, fmt.Sprintf("Updating %s on OPNsense", resourceName), map[string]any{fmt.Sprintf("%s", resourceName): rule})
+
+	err := setPortForward(r.client, rule, state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Update %s error", resourceName), fmt.Sprintf("%s", err))
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Apply configuration on OPNsense
+	tflog.Debug(ctx, "Applying configuration on OPNsense")
+
+	err = applyPortForwardConfig(r.client)
+	if err != nil {
+		resp.Diagnostics.AddWarning(fmt.Sprintf("Update %s error", resourceName), fmt.Sprintf("%s", err))
+	} else {
+		tflog.Debug(ctx, "Successfully applied configuration on OPNsense", map[string]any{"success": true})
+	}
+
+	// Get content hash from OPNsense
+	contentHash, err := getPortForwardContentHash(r.client, state.Id.ValueString())
+	plan.ContentHash, diags = utils.ContentHashValue(fmt.Sprintf("Update %s error", resourceName), contentHash, err)
+	resp.Diagnostics.Append(diags...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Successfully updated %s", resourceName))
+}
+
+// Delete removes the resource on OPNsense and from the Terraform state.
+func (r *natPortForwardResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, fmt.Sprintf("Deleting %s", resourceName))
+
+	// Read Terraform prior state data into the model
+	var state natPortForwardResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete port forward rule on OPNsense
+	tflog.Debug(ctx, fmt.Sprintf("Deleting %s on OPNsense", resourceName), map[string]any{"uuid": state.Id.ValueString()})
+
+	err := deletePortForward(r.client, state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Delete %s error", resourceName), fmt.Sprintf("%s", err))
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Apply configuration on OPNsense
+	tflog.Debug(ctx, "Applying configuration on OPNsense")
+
+	err = applyPortForwardConfig(r.client)
+	if err != nil {
+		resp.Diagnostics.AddWarning(fmt.Sprintf("Delete %s error", resourceName), fmt.Sprintf("%s", err))
+	} else {
+		tflog.Debug(ctx, "Successfully applied configuration on OPNsense", map[string]any{"success": true})
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Successfully deleted %s", resourceName))
+}
+
+// ImportState imports the resource from OPNsense and enables Terraform to begin managing the resource.
+func (r *natPortForwardResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Info(ctx, fmt.Sprintf("Importing %s", resourceName))
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Successfully imported %s", resourceName))
+}
@@ -0,0 +1,211 @@
+package portforward_test
+
+import (
+	"regexp"
+	"terraform-provider-opnsense/internal/acctest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccNatPortForwardResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccNatPortForwardResourceConfig,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("opnsense_firewall_nat_port_forward.test_acc_resource_port_forward", tfjsonpath.New("enabled"), knownvalue.Bool(true)),
+					statecheck.ExpectKnownValue("opnsense_firewall_nat_port_forward.test_acc_resource_port_forward", tfjsonpath.New("no_redirect"), knownvalue.Bool(false)),
+					statecheck.ExpectKnownValue("opnsense_firewall_nat_port_forward.test_acc_resource_port_forward", tfjsonpath.New("sequence"), knownvalue.Int32Exact(2)),
+					statecheck.ExpectKnownValue("opnsense_firewall_nat_port_forward.test_acc_resource_port_forward", tfjsonpath.New("interface"), knownvalue.StringExact("wan")),
+					statecheck.ExpectKnownValue("opnsense_firewall_nat_port_forward.test_acc_resource_port_forward", tfjsonpath.New("ip_version"), knownvalue.StringExact("ipv4")),
+					statecheck.ExpectKnownValue("opnsense_firewall_nat_port_forward.test_acc_resource_port_forward", tfjsonpath.New("protocol"), knownvalue.StringExact("tcp")),
+					statecheck.ExpectKnownValue("opnsense_firewall_nat_port_forward.test_acc_resource_port_forward", tfjsonpath.New("source"), knownvalue.StringExact("any")),
+					statecheck.ExpectKnownValue("opnsense_firewall_nat_port_forward.test_acc_resource_port_forward", tfjsonpath.New("source_not"), knownvalue.Bool(false)),
+					statecheck.ExpectKnownValue("opnsense_firewall_nat_port_forward.test_acc_resource_port_forward", tfjsonpath.New("source_port"), knownvalue.StringExact("")),
+					statecheck.ExpectKnownValue("opnsense_firewall_nat_port_forward.test_acc_resource_port_forward", tfjsonpath.New("destination"), knownvalue.StringExact("wanip")),
+					statecheck.ExpectKnownValue("opnsense_firewall_nat_port_forward.test_acc_resource_port_forward", tfjsonpath.New("destination_not"), knownvalue.Bool(false)),
+					statecheck.ExpectKnownValue("opnsense_firewall_nat_port_forward.test_acc_resource_port_forward", tfjsonpath.New("destination_port"), knownvalue.StringExact("8080")),
+					statecheck.ExpectKnownValue("opnsense_firewall_nat_port_forward.test_acc_resource_port_forward", tfjsonpath.New("target"), knownvalue.StringExact("10.0.0.10")),
+					statecheck.ExpectKnownValue("opnsense_firewall_nat_port_forward.test_acc_resource_port_forward", tfjsonpath.New("target_port"), knownvalue.StringExact("80")),
+					statecheck.ExpectKnownValue("opnsense_firewall_nat_port_forward.test_acc_resource_port_forward", tfjsonpath.New("nat_reflection"), knownvalue.StringExact("default")),
+					statecheck.ExpectKnownValue("opnsense_firewall_nat_port_forward.test_acc_resource_port_forward", tfjsonpath.New("filter_rule"), knownvalue.StringExact("associated")),
+					statecheck.ExpectKnownValue("opnsense_firewall_nat_port_forward.test_acc_resource_port_forward", tfjsonpath.New("log"), knownvalue.Bool(true)),
+					statecheck.ExpectKnownValue("opnsense_firewall_nat_port_forward.test_acc_resource_port_forward", tfjsonpath.New("categories"), knownvalue.ListExact([]knownvalue.Check{
+						knownvalue.StringExact("perm_test_acc_category"),
+					})),
+					statecheck.ExpectKnownValue("opnsense_firewall_nat_port_forward.test_acc_resource_port_forward", tfjsonpath.New("description"), knownvalue.StringExact("port forward rule for terraform resource testing")),
+				},
+			},
+			// ImportState testing
+			{
+				ResourceName:      "opnsense_firewall_nat_port_forward.test_acc_resource_port_forward",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccNatPortForwardResourceConfig_modified,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("opnsense_firewall_nat_port_forward.test_acc_resource_port_forward", tfjsonpath.New("enabled"), knownvalue.Bool(false)),
+					statecheck.ExpectKnownValue("opnsense_firewall_nat_port_forward.test_acc_resource_port_forward", tfjsonpath.New("no_redirect"), knownvalue.Bool(false)),
+					statecheck.ExpectKnownValue("opnsense_firewall_nat_port_forward.test_acc_resource_port_forward", tfjsonpath.New("sequence"), knownvalue.Int32Exact(3)),
+					statecheck.ExpectKnownValue("opnsense_firewall_nat_port_forward.test_acc_resource_port_forward", tfjsonpath.New("interface"), knownvalue.StringExact("lan")),
+					statecheck.ExpectKnownValue("opnsense_firewall_nat_port_forward.test_acc_resource_port_forward", tfjsonpath.New("ip_version"), knownvalue.StringExact("ipv4")),
+					statecheck.ExpectKnownValue("opnsense_firewall_nat_port_forward.test_acc_resource_port_forward", tfjsonpath.New("protocol"), knownvalue.StringExact("tcp/udp")),
+					statecheck.ExpectKnownValue("opnsense_firewall_nat_port_forward.test_acc_resource_port_forward", tfjsonpath.New("source"), knownvalue.StringExact("perm_test_acc_alias")),
+					statecheck.ExpectKnownValue("opnsense_firewall_nat_port_forward.test_acc_resource_port_forward", tfjsonpath.New("source_not"), knownvalue.Bool(true)),
+					statecheck.ExpectKnownValue("opnsense_firewall_nat_port_forward.test_acc_resource_port_forward", tfjsonpath.New("source_port"), knownvalue.StringExact("")),
+					statecheck.ExpectKnownValue("opnsense_firewall_nat_port_forward.test_acc_resource_port_forward", tfjsonpath.New("destination"), knownvalue.StringExact("1.1.1.1")),
+					statecheck.ExpectKnownValue("opnsense_firewall_nat_port_forward.test_acc_resource_port_forward", tfjsonpath.New("destination_not"), knownvalue.Bool(true)),
+					statecheck.ExpectKnownValue("opnsense_firewall_nat_port_forward.test_acc_resource_port_forward", tfjsonpath.New("destination_port"), knownvalue.StringExact("53")),
+					statecheck.ExpectKnownValue("opnsense_firewall_nat_port_forward.test_acc_resource_port_forward", tfjsonpath.New("target"), knownvalue.StringExact("10.0.0.53")),
+					statecheck.ExpectKnownValue("opnsense_firewall_nat_port_forward.test_acc_resource_port_forward", tfjsonpath.New("target_port"), knownvalue.StringExact("")),
+					statecheck.ExpectKnownValue("opnsense_firewall_nat_port_forward.test_acc_resource_port_forward", tfjsonpath.New("nat_reflection"), knownvalue.StringExact("enable")),
+					statecheck.ExpectKnownValue("opnsense_firewall_nat_port_forward.test_acc_resource_port_forward", tfjsonpath.New("filter_rule"), knownvalue.StringExact("pass")),
+					statecheck.ExpectKnownValue("opnsense_firewall_nat_port_forward.test_acc_resource_port_forward", tfjsonpath.New("log"), knownvalue.Bool(false)),
+					statecheck.ExpectKnownValue("opnsense_firewall_nat_port_forward.test_acc_resource_port_forward", tfjsonpath.New("categories"), knownvalue.ListExact([]knownvalue.Check{})),
+					statecheck.ExpectKnownValue("opnsense_firewall_nat_port_forward.test_acc_resource_port_forward", tfjsonpath.New("description"), knownvalue.StringExact("[Updated] port forward rule for terraform resource testing")),
+				},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccNatPortForwardResource_defaults(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccNatPortForwardResourceConfig_default,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("opnsense_firewall_nat_port_forward.test_acc_resource_port_forward", tfjsonpath.New("enabled"), knownvalue.Bool(true)),
+					statecheck.ExpectKnownValue("opnsense_firewall_nat_port_forward.test_acc_resource_port_forward", tfjsonpath.New("no_redirect"), knownvalue.Bool(false)),
+					statecheck.ExpectKnownValue("opnsense_firewall_nat_port_forward.test_acc_resource_port_forward", tfjsonpath.New("sequence"), knownvalue.Int32Exact(1)),
+					statecheck.ExpectKnownValue("opnsense_firewall_nat_port_forward.test_acc_resource_port_forward", tfjsonpath.New("interface"), knownvalue.StringExact("wan")),
+					statecheck.ExpectKnownValue("opnsense_firewall_nat_port_forward.test_acc_resource_port_forward", tfjsonpath.New("ip_version"), knownvalue.StringExact("ipv4")),
+					statecheck.ExpectKnownValue("opnsense_firewall_nat_port_forward.test_acc_resource_port_forward", tfjsonpath.New("protocol"), knownvalue.StringExact("tcp")),
+					statecheck.ExpectKnownValue("opnsense_firewall_nat_port_forward.test_acc_resource_port_forward", tfjsonpath.New("source"), knownvalue.StringExact("any")),
+					statecheck.ExpectKnownValue("opnsense_firewall_nat_port_forward.test_acc_resource_port_forward", tfjsonpath.New("source_not"), knownvalue.Bool(false)),
+					statecheck.ExpectKnownValue("opnsense_firewall_nat_port_forward.test_acc_resource_port_forward", tfjsonpath.New("source_port"), knownvalue.StringExact("")),
+					statecheck.ExpectKnownValue("opnsense_firewall_nat_port_forward.test_acc_resource_port_forward", tfjsonpath.New("destination"), knownvalue.StringExact("any")),
+					statecheck.ExpectKnownValue("opnsense_firewall_nat_port_forward.test_acc_resource_port_forward", tfjsonpath.New("destination_not"), knownvalue.Bool(false)),
+					statecheck.ExpectKnownValue("opnsense_firewall_nat_port_forward.test_acc_resource_port_forward", tfjsonpath.New("destination_port"), knownvalue.StringExact("")),
+					statecheck.ExpectKnownValue("opnsense_firewall_nat_port_forward.test_acc_resource_port_forward", tfjsonpath.New("target"), knownvalue.StringExact("10.0.0.10")),
+					statecheck.ExpectKnownValue("opnsense_firewall_nat_port_forward.test_acc_resource_port_forward", tfjsonpath.New("target_port"), knownvalue.StringExact("")),
+					statecheck.ExpectKnownValue("opnsense_firewall_nat_port_forward.test_acc_resource_port_forward", tfjsonpath.New("nat_reflection"), knownvalue.StringExact("default")),
+					statecheck.ExpectKnownValue("opnsense_firewall_nat_port_forward.test_acc_resource_port_forward", tfjsonpath.New("filter_rule"), knownvalue.StringExact("associated")),
+					statecheck.ExpectKnownValue("opnsense_firewall_nat_port_forward.test_acc_resource_port_forward", tfjsonpath.New("log"), knownvalue.Bool(false)),
+					statecheck.ExpectKnownValue("opnsense_firewall_nat_port_forward.test_acc_resource_port_forward", tfjsonpath.New("categories"), knownvalue.ListExact([]knownvalue.Check{})),
+					statecheck.ExpectKnownValue("opnsense_firewall_nat_port_forward.test_acc_resource_port_forward", tfjsonpath.New("description"), knownvalue.StringExact("")),
+				},
+			},
+			// ImportState testing
+			{
+				ResourceName:      "opnsense_firewall_nat_port_forward.test_acc_resource_port_forward",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccNatPortForwardResource_invalidConfig(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccNatPortForwardResourceConfig_port_protocol,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+			{
+				Config:      testAccNatPortForwardResourceConfig_invalid_filter_rule,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Value Match`),
+			},
+		},
+	})
+}
+
+// testAccNatPortForwardResourceConfig defines a port forward resource.
+const testAccNatPortForwardResourceConfig = `
+	resource "opnsense_firewall_nat_port_forward" "test_acc_resource_port_forward" {
+		enabled          = true
+		sequence         = 2
+		interface        = "wan"
+		ip_version       = "ipv4"
+		protocol         = "tcp"
+		destination      = "wanip"
+		destination_port = "8080"
+		target           = "10.0.0.10"
+		target_port      = "80"
+		nat_reflection   = "default"
+		filter_rule      = "associated"
+		log              = true
+		categories = [
+			"perm_test_acc_category"
+		]
+		description = "port forward rule for terraform resource testing"
+	}
+`
+
+// testAccNatPortForwardResourceConfig_modified defines a modified port forward resource.
+const testAccNatPortForwardResourceConfig_modified = `
+	resource "opnsense_firewall_nat_port_forward" "test_acc_resource_port_forward" {
+		enabled          = false
+		sequence         = 3
+		interface        = "lan"
+		ip_version       = "ipv4"
+		protocol         = "tcp/udp"
+		source           = "perm_test_acc_alias"
+		source_not       = true
+		destination      = "1.1.1.1"
+		destination_not  = true
+		destination_port = "53"
+		target           = "10.0.0.53"
+		nat_reflection   = "enable"
+		filter_rule      = "pass"
+		log              = false
+		categories       = []
+		description      = "[Updated] port forward rule for terraform resource testing"
+	}
+`
+
+// testAccNatPortForwardResourceConfig_default defines a port forward resource with default values.
+const testAccNatPortForwardResourceConfig_default = `
+	resource "opnsense_firewall_nat_port_forward" "test_acc_resource_port_forward" {
+		interface = "wan"
+		target    = "10.0.0.10"
+	}
+`
+
+// testAccNatPortForwardResourceConfig_port_protocol defines a port forward resource with a port on a protocol other than TCP & UDP.
+const testAccNatPortForwardResourceConfig_port_protocol = `
+	resource "opnsense_firewall_nat_port_forward" "test_acc_resource_invalid" {
+		interface   = "wan"
+		protocol    = "icmp"
+		target      = "10.0.0.10"
+		target_port = "80"
+	}
+`
+
+// testAccNatPortForwardResourceConfig_invalid_filter_rule defines a port forward resource with an unsupported filter rule association.
+const testAccNatPortForwardResourceConfig_invalid_filter_rule = `
+	resource "opnsense_firewall_nat_port_forward" "test_acc_resource_invalid" {
+		interface   = "wan"
+		target      = "10.0.0.10"
+		filter_rule = "unassociated"
+	}
+`
@@ -0,0 +1,213 @@
+package portforward
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"terraform-provider-opnsense/internal/opnsense"
+	"terraform-provider-opnsense/internal/opnsense/firewall/category"
+	"terraform-provider-opnsense/internal/opnsense/interfaces/overview"
+	"terraform-provider-opnsense/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	portForwardController string = "port_forward"
+
+	resourceName string = "port forward rule"
+)
+
+type portForward struct {
+	Enabled         bool
+	NoRedirect      bool
+	Sequence        int32
+	Interface       string
+	IpVersion       string
+	Protocol        string
+	Source          string
+	SourceNot       bool
+	SourcePort      string
+	Destination     string
+	DestinationNot  bool
+	DestinationPort string
+	Target          string
+	TargetPort      string
+	NatReflection   string
+	FilterRule      string
+	Log             bool
+	Categories      *utils.Set
+	Description     string
+}
+
+// Port forward values
+
+func getIpVersions() []string {
+	return ipVersions.GetAllKeys()
+}
+
+func getProtocols() []string {
+	return []string{
+		"tcp",
+		"udp",
+		"tcp/udp",
+		"icmp",
+		"esp",
+		"ah",
+		"gre",
+		"ipv6",
+		"igmp",
+		"pim",
+		"ospf",
+		"sctp",
+		"carp",
+		"pfsync",
+	}
+}
+
+func getNatReflectionOptions() []string {
+	return []string{
+		"default",
+		"enable",
+		"disable",
+	}
+}
+
+func getFilterRuleOptions() []string {
+	return filterRules.GetAllKeys()
+}
+
+// Ip version mappings
+const (
+	ipv4 string = "ipv4"
+	ipv6 string = "ipv6"
+)
+
+var ipVersionMappings = map[string]string{
+	ipv4: "inet",
+	ipv6: "inet6",
+}
+
+var ipVersions = getBidirectionalIpVersion()
+
+func getBidirectionalIpVersion() *utils.BidirectionalMap {
+	ipVersions := utils.NewBidirectionalMap()
+	for key, value := range ipVersionMappings {
+		ipVersions.Put(key, value)
+	}
+	return ipVersions
+}
+
+// Filter rule association mappings
+const (
+	filterRuleNone       string = "none"
+	filterRuleAssociated string = "associated"
+	filterRulePass       string = "pass"
+)
+
+var filterRuleMappings = map[string]string{
+	filterRuleNone:       "",
+	filterRuleAssociated: "add-associated",
+	filterRulePass:       "pass",
+}
+
+var filterRules = getBidirectionalFilterRule()
+
+func getBidirectionalFilterRule() *utils.BidirectionalMap {
+	filterRules := utils.NewBidirectionalMap()
+	for key, value := range filterRuleMappings {
+		filterRules.Put(key, value)
+	}
+	return filterRules
+}
+
+// Helper functions
+
+// filterRuleFromOpnsense converts the associated filter rule value stored on OPNsense to the filter rule option.
+// Once the associated filter rule is created, OPNsense replaces the value with the identifier of that rule.
+func filterRuleFromOpnsense(value string) string {
+	if filterRule, exists := filterRules.GetByValue(value); exists {
+		return filterRule
+	}
+	return filterRuleAssociated
+}
+
+// createPortForward creates a port forward object based on the specified plan.
+func createPortForward(ctx context.Context, client *opnsense.Client, plan natPortForwardResourceModel) (portForward, diag.Diagnostics) {
+	var diagnostics diag.Diagnostics
+
+	// Create port forward rule from plan
+	tflog.Debug(ctx, fmt.Sprintf("Creating %s object from plan", resourceName), map[string]any{"plan": plan})
+
+	// Verify interface
+	tflog.Debug(ctx, "Verifying interface", map[string]any{"interface": plan.Interface})
+
+	interfaceExist, err := overview.VerifyInterface(client, plan.Interface.ValueString())
+	if err != nil {
+		diagnostics.AddError(fmt.Sprintf("Create %s object error", resourceName), fmt.Sprintf("%s", err))
+	}
+	if !interfaceExist {
+		diagnostics.AddError(fmt.Sprintf("Create %s object error", resourceName), "The specified interface does not exist. Please verify that the specified interface exist on your OPNsense firewall")
+	}
+
+	tflog.Debug(ctx, "Successfully verified interface", map[string]any{"success": true})
+
+	// Verify all categories exist
+	tflog.Debug(ctx, "Verifying categories", map[string]any{"categories": plan.Categories})
+
+	categories, diags := utils.SetTerraformToGo(ctx, plan.Categories)
+	diagnostics.Append(diags...)
+
+	categoryUuids, err := category.GetCategoryUuids(client, categories)
+	if err != nil {
+		diagnostics.AddError(fmt.Sprintf("Create %s object error", resourceName), fmt.Sprintf("%s", err))
+	}
+
+	tflog.Debug(ctx, "Successfully verified categories", map[string]any{"success": true})
+
+	// IpVersion
+	ipVersion, exists := ipVersions.GetByKey(plan.IpVersion.ValueString())
+	if !exists {
+		diagnostics.AddError(fmt.Sprintf("Create %s object error", resourceName), fmt.Sprintf("Ip version `%s` not supported. Please contact the provider maintainers if you believe this should be supported.", plan.IpVersion.ValueString()))
+	}
+
+	// Filter rule association
+	filterRule, exists := filterRules.GetByKey(plan.FilterRule.ValueString())
+	if !exists {
+		diagnostics.AddError(fmt.Sprintf("Create %s object error", resourceName), fmt.Sprintf("Filter rule association `%s` not supported. Please contact the provider maintainers if you believe this should be supported.", plan.FilterRule.ValueString()))
+	}
+
+	// Check for default nat reflection
+	natReflection := strings.ToLower(plan.NatReflection.ValueString())
+	if natReflection == "default" {
+		natReflection = ""
+	}
+
+	portForward := portForward{
+		Enabled:         plan.Enabled.ValueBool(),
+		NoRedirect:      plan.NoRedirect.ValueBool(),
+		Sequence:        plan.Sequence.ValueInt32(),
+		Interface:       plan.Interface.ValueString(),
+		IpVersion:       ipVersion,
+		Protocol:        strings.ToLower(plan.Protocol.ValueString()),
+		Source:          plan.Source.ValueString(),
+		SourceNot:       plan.SourceNot.ValueBool(),
+		SourcePort:      plan.SourcePort.ValueString(),
+		Destination:     plan.Destination.ValueString(),
+		DestinationNot:  plan.DestinationNot.ValueBool(),
+		DestinationPort: plan.DestinationPort.ValueString(),
+		Target:          plan.Target.ValueString(),
+		TargetPort:      plan.TargetPort.ValueString(),
+		NatReflection:   natReflection,
+		FilterRule:      filterRule,
+		Log:             plan.Log.ValueBool(),
+		Categories:      categoryUuids,
+		Description:     plan.Description.ValueString(),
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Successfully created %s object from plan", resourceName), map[string]any{"success": true})
+
+	return portForward, diagnostics
+}
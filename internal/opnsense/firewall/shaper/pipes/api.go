@@ -330,3 +330,13 @@ func checkShaperPipeExists(client *opnsense.Client, identifier string) (bool, er
 	}
 	return true, nil
 }
+
+// getShaperPipeContentHash gets the content hash of the shaper pipe from OPNsense.
+func getShaperPipeContentHash(client *opnsense.Client, uuid string) (string, error) {
+	object, err := getShaperPipe(client, uuid)
+	if err != nil {
+		return "", err
+	}
+
+	return utils.ContentHash(object)
+}
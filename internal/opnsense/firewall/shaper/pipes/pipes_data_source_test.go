@@ -28,7 +28,7 @@ func TestAccShaperPipeDataSource(t *testing.T) {
 					statecheck.ExpectKnownValue("data.opnsense_firewall_shaper_pipes.test_acc_data_source", tfjsonpath.New("queue"), knownvalue.Int32Exact(10)),
 					statecheck.ExpectKnownValue("data.opnsense_firewall_shaper_pipes.test_acc_data_source", tfjsonpath.New("mask"), knownvalue.StringExact("src-ip")),
 					statecheck.ExpectKnownValue("data.opnsense_firewall_shaper_pipes.test_acc_data_source", tfjsonpath.New("buckets"), knownvalue.Int32Exact(10)),
-					statecheck.ExpectKnownValue("data.opnsense_firewall_shaper_pipes.test_acc_data_source", tfjsonpath.New("scheduler"), knownvalue.StringExact("deficit round robin")),
+					statecheck.ExpectKnownValue("data.opnsense_firewall_shaper_pipes.test_acc_data_source", tfjsonpath.New("scheduler"), knownvalue.StringExact("flowqueue-codel")),
 					statecheck.ExpectKnownValue("data.opnsense_firewall_shaper_pipes.test_acc_data_source", tfjsonpath.New("codel"), knownvalue.ObjectExact(map[string]knownvalue.Check{
 						"enabled":  knownvalue.Bool(true),
 						"target":   knownvalue.Int32Exact(10),
@@ -58,7 +58,7 @@ const testAccShaperPipesDataSourceConfig_id = `
 		queue     = 10
 		mask      = "src-ip"
 		buckets   = 10
-		scheduler = "deficit round robin"
+		scheduler = "flowqueue-codel"
 		codel     = {
 			enabled  = true
 			target   = 10
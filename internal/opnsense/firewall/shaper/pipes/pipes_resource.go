@@ -26,14 +26,17 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &shaperPipesResource{}
-	_ resource.ResourceWithConfigure   = &shaperPipesResource{}
-	_ resource.ResourceWithImportState = &shaperPipesResource{}
+	_ resource.Resource                   = &shaperPipesResource{}
+	_ resource.ResourceWithConfigure      = &shaperPipesResource{}
+	_ resource.ResourceWithImportState    = &shaperPipesResource{}
+	_ resource.ResourceWithUpgradeState   = &shaperPipesResource{}
+	_ resource.ResourceWithValidateConfig = &shaperPipesResource{}
 )
 
 // NewShaperPipesResource is a helper function to simplify the provider implementation.
@@ -50,6 +53,7 @@ type shaperPipesResource struct {
 type shaperPipesResourceModel struct {
 	Id          types.String `tfsdk:"id"`
 	LastUpdated types.String `tfsdk:"last_updated"`
+	ContentHash types.String `tfsdk:"content_hash"`
 	Enabled     types.Bool   `tfsdk:"enabled"`
 	Bandwidth   types.Object `tfsdk:"bandwidth"`
 	Queue       types.Int32  `tfsdk:"queue"`
@@ -85,6 +89,7 @@ func (r *shaperPipesResource) Metadata(ctx context.Context, req resource.Metadat
 // Schema defines the schema for the resource.
 func (r *shaperPipesResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:     1,
 		Description: "A pipe emulates a link with given bandwidth, propagation delay, queue size and packet loss rate. Packets are queued in front of the pipe as they come out from the classifier, and then transferred to the pipe according to the pipe’s parameters.",
 
 		Attributes: map[string]schema.Attribute{
@@ -96,8 +101,13 @@ func (r *shaperPipesResource) Schema(ctx context.Context, req resource.SchemaReq
 				},
 			},
 			"last_updated": schema.StringAttribute{
+				Computed:           true,
+				Description:        fmt.Sprintf("DateTime when the %s was last updated.", resourceName),
+				DeprecationMessage: "Use `content_hash` to detect changes to this resource instead. This attribute will be removed in a future release.",
+			},
+			"content_hash": schema.StringAttribute{
 				Computed:    true,
-				Description: fmt.Sprintf("DateTime when the %s was last updated.", resourceName),
+				Description: fmt.Sprintf("Hash of the %s configuration on OPNsense. Changes whenever the configuration is modified, including changes made outside of Terraform.", resourceName),
 			},
 			"enabled": schema.BoolAttribute{
 				Optional:            true,
@@ -215,21 +225,21 @@ func (r *shaperPipesResource) Schema(ctx context.Context, req resource.SchemaReq
 					"quantum": schema.Int32Attribute{
 						Optional:    true,
 						Computed:    true,
-						Description: "The number of bytes a queue can serve before being moved to the tail of old queues list (bytes), leave empty for defaults.",
+						Description: "[Only for `flowqueue-codel` scheduler] The number of bytes a queue can serve before being moved to the tail of old queues list (bytes), leave empty for defaults.",
 						Validators:  []validator.Int32{int32validator.AtLeast(1)},
 						Default:     int32default.StaticInt32(-1),
 					},
 					"limit": schema.Int32Attribute{
 						Optional:    true,
 						Computed:    true,
-						Description: "The hard size limit of all queues managed by this instance, leave empty for defaults.",
+						Description: "[Only for `flowqueue-codel` scheduler] The hard size limit of all queues managed by this instance, leave empty for defaults.",
 						Validators:  []validator.Int32{int32validator.AtLeast(1)},
 						Default:     int32default.StaticInt32(-1),
 					},
 					"flows": schema.Int32Attribute{
 						Optional:    true,
 						Computed:    true,
-						Description: "The number of flow queues that are created and managed, leave empty for defaults.",
+						Description: "[Only for `flowqueue-codel` scheduler] The number of flow queues that are created and managed, leave empty for defaults.",
 						Validators:  []validator.Int32{int32validator.AtLeast(1)},
 						Default:     int32default.StaticInt32(-1),
 					},
@@ -276,6 +286,62 @@ func (r *shaperPipesResource) Schema(ctx context.Context, req resource.SchemaReq
 	}
 }
 
+// ValidateConfig validates the resource configuration for cross-attribute constraints.
+func (r *shaperPipesResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config shaperPipesResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Skip validation if codel is not configured or the scheduler is not yet known
+	if config.Codel.IsNull() || config.Codel.IsUnknown() || config.Scheduler.IsUnknown() {
+		return
+	}
+
+	var configCodel codelModel
+	resp.Diagnostics.Append(config.Codel.As(ctx, &configCodel, basetypes.ObjectAsOptions{})...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Scheduler defaults to weighted fair queueing when not configured
+	scheduler := weightedFairQueueing
+	if !config.Scheduler.IsNull() {
+		scheduler = config.Scheduler.ValueString()
+	}
+
+	// Quantum, limit & flows are only applicable to the FlowQueue-CoDel scheduler
+	if scheduler != codel {
+		fqCodelAttributes := map[string]types.Int32{
+			"quantum": configCodel.Quantum,
+			"limit":   configCodel.Limit,
+			"flows":   configCodel.Flows,
+		}
+
+		for name, value := range fqCodelAttributes {
+			if !value.IsNull() {
+				resp.Diagnostics.AddAttributeError(path.Root("codel").AtName(name), "Invalid Attribute Combination", fmt.Sprintf("The `codel.%s` attribute is only applicable when `scheduler` is set to `%s`, got: `%s`.", name, codel, scheduler))
+			}
+		}
+	}
+
+	// Target, interval & ecn require either CoDel active queue management or the FlowQueue-CoDel scheduler
+	if scheduler != codel && !configCodel.Enabled.IsUnknown() && !configCodel.Enabled.ValueBool() {
+		codelAttributes := map[string]attr.Value{
+			"target":   configCodel.Target,
+			"interval": configCodel.Interval,
+			"ecn":      configCodel.Ecn,
+		}
+
+		for name, value := range codelAttributes {
+			if !value.IsNull() {
+				resp.Diagnostics.AddAttributeError(path.Root("codel").AtName(name), "Invalid Attribute Combination", fmt.Sprintf("The `codel.%s` attribute is only applicable when `codel.enabled` is `true` or `scheduler` is set to `%s`.", name, codel))
+			}
+		}
+	}
+}
+
 // Configure adds the provider configured client to the resource.
 func (r *shaperPipesResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Add a nil check when handling ProviderData because Terraform
@@ -335,6 +401,11 @@ func (r *shaperPipesResource) Create(ctx context.Context, req resource.CreateReq
 		tflog.Debug(ctx, "Successfully applied configuration on OPNsense", map[string]any{"success": true})
 	}
 
+	// Get content hash from OPNsense
+	contentHash, err := getShaperPipeContentHash(r.client, uuid)
+	plan.ContentHash, diags = utils.ContentHashValue(fmt.Sprintf("Create %s error", resourceName), contentHash, err)
+	resp.Diagnostics.Append(diags...)
+
 	// Update plan ID & last_updated fields
 	plan.Id = types.StringValue(uuid)
 	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
@@ -375,6 +446,11 @@ func (r *shaperPipesResource) Read(ctx context.Context, req resource.ReadRequest
 	tflog.Debug(ctx, fmt.Sprintf("Successfully got %s information", resourceName), map[string]any{"success": true})
 
 	// Overwite items with refreshed state
+	contentHash, err := utils.ContentHash(pipe)
+	contentHashValue, diags := utils.ContentHashValue(fmt.Sprintf("Read %s error", resourceName), contentHash, err)
+	resp.Diagnostics.Append(diags...)
+	state.ContentHash = contentHashValue
+
 	state.Enabled = types.BoolValue(pipe.Enabled)
 	state.Queue = types.Int32Value(pipe.Queue)
 	state.Mask = types.StringValue(pipe.Mask)
@@ -479,6 +555,11 @@ func (r *shaperPipesResource) Update(ctx context.Context, req resource.UpdateReq
 		tflog.Debug(ctx, "Successfully applied configuration on OPNsense", map[string]any{"success": true})
 	}
 
+	// Get content hash from OPNsense
+	contentHash, err := getShaperPipeContentHash(r.client, state.Id.ValueString())
+	plan.ContentHash, diags = utils.ContentHashValue(fmt.Sprintf("Update %s error", resourceName), contentHash, err)
+	resp.Diagnostics.Append(diags...)
+
 	// Update last_updated field (if change detected)
 	if !(reflect.DeepEqual(plan, state)) {
 		plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
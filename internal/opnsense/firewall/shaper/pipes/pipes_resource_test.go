@@ -1,6 +1,7 @@
 package pipes_test
 
 import (
+	"regexp"
 	"terraform-provider-opnsense/internal/acctest"
 	"testing"
 
@@ -27,7 +28,7 @@ func TestAccShaperPipeResource(t *testing.T) {
 					statecheck.ExpectKnownValue("opnsense_firewall_shaper_pipes.test_acc_resource_pipes", tfjsonpath.New("queue"), knownvalue.Int32Exact(10)),
 					statecheck.ExpectKnownValue("opnsense_firewall_shaper_pipes.test_acc_resource_pipes", tfjsonpath.New("mask"), knownvalue.StringExact("src-ip")),
 					statecheck.ExpectKnownValue("opnsense_firewall_shaper_pipes.test_acc_resource_pipes", tfjsonpath.New("buckets"), knownvalue.Int32Exact(10)),
-					statecheck.ExpectKnownValue("opnsense_firewall_shaper_pipes.test_acc_resource_pipes", tfjsonpath.New("scheduler"), knownvalue.StringExact("deficit round robin")),
+					statecheck.ExpectKnownValue("opnsense_firewall_shaper_pipes.test_acc_resource_pipes", tfjsonpath.New("scheduler"), knownvalue.StringExact("flowqueue-codel")),
 					statecheck.ExpectKnownValue("opnsense_firewall_shaper_pipes.test_acc_resource_pipes", tfjsonpath.New("codel"), knownvalue.ObjectExact(map[string]knownvalue.Check{
 						"enabled":  knownvalue.Bool(true),
 						"target":   knownvalue.Int32Exact(10),
@@ -131,6 +132,25 @@ func TestAccShaperPipeResource_default(t *testing.T) {
 	})
 }
 
+func TestAccShaperPipeResource_invalidConfig(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccShaperPipesResourceConfig_fq_codel_scheduler,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+			{
+				Config:      testAccShaperPipesResourceConfig_codel_disabled,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+		},
+	})
+}
+
 // testAccShaperPipesResourceConfig defines a traffic shaper pipe resource.
 const testAccShaperPipesResourceConfig = `
 	resource "opnsense_firewall_shaper_pipes" "test_acc_resource_pipes" {
@@ -142,7 +162,7 @@ const testAccShaperPipesResourceConfig = `
 		queue     = 10
 		mask      = "src-ip"
 		buckets   = 10
-		scheduler = "deficit round robin"
+		scheduler = "flowqueue-codel"
 		codel     = {
 			enabled  = true
 			target   = 10
@@ -194,3 +214,29 @@ const testAccShaperPipesResourceConfig_default = `
 		description = "[Default] traffic shaper pipe for terraform resource testing"
 	}
 `
+
+// testAccShaperPipesResourceConfig_fq_codel_scheduler defines a traffic shaper pipe resource with FlowQueue-CoDel settings on a different scheduler.
+const testAccShaperPipesResourceConfig_fq_codel_scheduler = `
+	resource "opnsense_firewall_shaper_pipes" "test_acc_resource_invalid" {
+		bandwidth = {
+			value = 10
+		}
+		scheduler = "fifo"
+		codel = {
+			quantum = 10
+		}
+	}
+`
+
+// testAccShaperPipesResourceConfig_codel_disabled defines a traffic shaper pipe resource with CoDel settings while CoDel is disabled.
+const testAccShaperPipesResourceConfig_codel_disabled = `
+	resource "opnsense_firewall_shaper_pipes" "test_acc_resource_invalid" {
+		bandwidth = {
+			value = 10
+		}
+		codel = {
+			enabled = false
+			target = 10
+		}
+	}
+`
@@ -0,0 +1,141 @@
+package pipes
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-opnsense/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// shaperPipesResourceModelV0 describes the resource data model of schema version 0.
+type shaperPipesResourceModelV0 struct {
+	Id          *string `json:"id"`
+	LastUpdated *string `json:"last_updated"`
+	Enabled     *bool   `json:"enabled"`
+	Bandwidth   *struct {
+		Value  *int64  `json:"value"`
+		Metric *string `json:"metric"`
+	} `json:"bandwidth"`
+	Queue     *int32  `json:"queue"`
+	Mask      *string `json:"mask"`
+	Buckets   *int32  `json:"buckets"`
+	Scheduler *string `json:"scheduler"`
+	Codel     *struct {
+		Enabled  *bool  `json:"enabled"`
+		Target   *int32 `json:"target"`
+		Interval *int32 `json:"interval"`
+		Ecn      *bool  `json:"ecn"`
+		Quantum  *int32 `json:"quantum"`
+		Limit    *int32 `json:"limit"`
+		Flows    *int32 `json:"flows"`
+	} `json:"codel"`
+	Pie         *bool   `json:"pie"`
+	Delay       *int32  `json:"delay"`
+	Description *string `json:"description"`
+}
+
+// UpgradeState upgrades the resource state from prior schema versions to the current schema version.
+func (r *shaperPipesResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {StateUpgrader: upgradeShaperPipesStateV0},
+	}
+}
+
+// upgradeShaperPipesStateV0 upgrades the resource state from schema version 0.
+func upgradeShaperPipesStateV0(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	tflog.Info(ctx, fmt.Sprintf("Upgrading %s state from version 0", resourceName))
+
+	var priorState shaperPipesResourceModelV0
+	err := utils.DecodeRawState(req, &priorState)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Upgrade %s state error", resourceName), fmt.Sprintf("%s", err))
+		return
+	}
+
+	// Bandwidth
+	bandwidthValue := types.Int64Null()
+	bandwidthMetric := types.StringValue("bit")
+	if priorState.Bandwidth != nil {
+		bandwidthValue = types.Int64PointerValue(priorState.Bandwidth.Value)
+		bandwidthMetric = utils.StringOrDefault(priorState.Bandwidth.Metric, "bit")
+	}
+
+	bandwidth, diags := types.ObjectValue(
+		map[string]attr.Type{
+			"value":  types.Int64Type,
+			"metric": types.StringType,
+		},
+		map[string]attr.Value{
+			"value":  bandwidthValue,
+			"metric": bandwidthMetric,
+		},
+	)
+	resp.Diagnostics.Append(diags...)
+
+	// Codel
+	var priorCodel struct {
+		Enabled  *bool  `json:"enabled"`
+		Target   *int32 `json:"target"`
+		Interval *int32 `json:"interval"`
+		Ecn      *bool  `json:"ecn"`
+		Quantum  *int32 `json:"quantum"`
+		Limit    *int32 `json:"limit"`
+		Flows    *int32 `json:"flows"`
+	}
+	if priorState.Codel != nil {
+		priorCodel = *priorState.Codel
+	}
+
+	codel, diags := types.ObjectValue(
+		map[string]attr.Type{
+			"enabled":  types.BoolType,
+			"target":   types.Int32Type,
+			"interval": types.Int32Type,
+			"ecn":      types.BoolType,
+			"quantum":  types.Int32Type,
+			"limit":    types.Int32Type,
+			"flows":    types.Int32Type,
+		},
+		map[string]attr.Value{
+			"enabled":  utils.BoolOrDefault(priorCodel.Enabled, false),
+			"target":   utils.Int32OrDefault(priorCodel.Target, -1),
+			"interval": utils.Int32OrDefault(priorCodel.Interval, -1),
+			"ecn":      utils.BoolOrDefault(priorCodel.Ecn, false),
+			"quantum":  utils.Int32OrDefault(priorCodel.Quantum, -1),
+			"limit":    utils.Int32OrDefault(priorCodel.Limit, -1),
+			"flows":    utils.Int32OrDefault(priorCodel.Flows, -1),
+		},
+	)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state := shaperPipesResourceModel{
+		Id:          utils.StringOrNull(priorState.Id),
+		LastUpdated: utils.StringOrNull(priorState.LastUpdated),
+		Enabled:     utils.BoolOrDefault(priorState.Enabled, true),
+		Bandwidth:   bandwidth,
+		Queue:       utils.Int32OrDefault(priorState.Queue, -1),
+		Mask:        utils.StringOrDefault(priorState.Mask, "none"),
+		Buckets:     utils.Int32OrDefault(priorState.Buckets, -1),
+		Scheduler:   utils.StringOrDefault(priorState.Scheduler, weightedFairQueueing),
+		Codel:       codel,
+		Pie:         utils.BoolOrDefault(priorState.Pie, false),
+		Delay:       utils.Int32OrDefault(priorState.Delay, -1),
+		Description: utils.StringOrNull(priorState.Description),
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Successfully upgraded %s state from version 0", resourceName))
+}
@@ -0,0 +1,30 @@
+package pipes_test
+
+import (
+	"terraform-provider-opnsense/internal/acctest"
+	"terraform-provider-opnsense/internal/opnsense/firewall/shaper/pipes"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestShaperPipesResource_upgradeState(t *testing.T) {
+	acctest.RunStateUpgradeTests(t, []acctest.StateUpgradeTestCase{
+		{
+			Name:      "v0 defaults",
+			Resource:  pipes.NewShaperPipesResource(),
+			Version:   0,
+			StateFile: "testdata/pipes_resource_v0.json",
+			Expected: map[string]attr.Value{
+				"bandwidth.value":  types.Int64Value(100),
+				"bandwidth.metric": types.StringValue("bit"),
+				"mask":             types.StringValue("none"),
+				"scheduler":        types.StringValue("weighted fair queueing"),
+				"codel.enabled":    types.BoolValue(false),
+				"codel.target":     types.Int32Value(-1),
+				"queue":            types.Int32Value(-1),
+			},
+		},
+	})
+}
@@ -281,3 +281,13 @@ func checkShaperQueueExists(client *opnsense.Client, identifier string) (bool, e
 	}
 	return true, nil
 }
+
+// getShaperQueueContentHash gets the content hash of the shaper queue from OPNsense.
+func getShaperQueueContentHash(client *opnsense.Client, uuid string) (string, error) {
+	object, err := getShaperQueue(client, uuid)
+	if err != nil {
+		return "", err
+	}
+
+	return utils.ContentHash(object)
+}
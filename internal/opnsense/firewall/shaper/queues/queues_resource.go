@@ -31,9 +31,10 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &shaperQueuesResource{}
-	_ resource.ResourceWithConfigure   = &shaperQueuesResource{}
-	_ resource.ResourceWithImportState = &shaperQueuesResource{}
+	_ resource.Resource                 = &shaperQueuesResource{}
+	_ resource.ResourceWithConfigure    = &shaperQueuesResource{}
+	_ resource.ResourceWithImportState  = &shaperQueuesResource{}
+	_ resource.ResourceWithUpgradeState = &shaperQueuesResource{}
 )
 
 // NewShaperQueuesResource is a helper function to simplify the provider implementation.
@@ -50,6 +51,7 @@ type shaperQueuesResource struct {
 type shaperQueuesResourceModel struct {
 	Id          types.String `tfsdk:"id"`
 	LastUpdated types.String `tfsdk:"last_updated"`
+	ContentHash types.String `tfsdk:"content_hash"`
 	Enabled     types.Bool   `tfsdk:"enabled"`
 	Pipe        types.String `tfsdk:"pipe"`
 	Weight      types.Int32  `tfsdk:"weight"`
@@ -75,6 +77,7 @@ func (r *shaperQueuesResource) Metadata(ctx context.Context, req resource.Metada
 // Schema defines the schema for the resource.
 func (r *shaperQueuesResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:     1,
 		Description: "A queue is an abstraction used to implement the WF2Q+ (Worstcase Fair Weighted Fair Queueing) policy, which is an efficient variant of the WFQ policy. The queue associates a weight and a reference pipe to each flow, and then all backlogged (i.e., with packets queued) flows linked to the same pipe share the pipe’s bandwidth proportionally to their weights. Note that weights are not priorities; a flow with a lower weight is still guaranteed to get its fraction of the bandwidth even if a flow with a higher weight is permanently backlogged.",
 
 		Attributes: map[string]schema.Attribute{
@@ -86,8 +89,13 @@ func (r *shaperQueuesResource) Schema(ctx context.Context, req resource.SchemaRe
 				},
 			},
 			"last_updated": schema.StringAttribute{
+				Computed:           true,
+				Description:        fmt.Sprintf("DateTime when the %s was last updated.", resourceName),
+				DeprecationMessage: "Use `content_hash` to detect changes to this resource instead. This attribute will be removed in a future release.",
+			},
+			"content_hash": schema.StringAttribute{
 				Computed:    true,
-				Description: fmt.Sprintf("DateTime when the %s was last updated.", resourceName),
+				Description: fmt.Sprintf("Hash of the %s configuration on OPNsense. Changes whenever the configuration is modified, including changes made outside of Terraform.", resourceName),
 			},
 			"enabled": schema.BoolAttribute{
 				Optional:            true,
@@ -251,6 +259,11 @@ func (r *shaperQueuesResource) Create(ctx context.Context, req resource.CreateRe
 		tflog.Debug(ctx, "Successfully applied configuration on OPNsense", map[string]any{"success": true})
 	}
 
+	// Get content hash from OPNsense
+	contentHash, err := getShaperQueueContentHash(r.client, uuid)
+	plan.ContentHash, diags = utils.ContentHashValue(fmt.Sprintf("Create %s error", resourceName), contentHash, err)
+	resp.Diagnostics.Append(diags...)
+
 	// Update plan ID & last_updated fields
 	plan.Id = types.StringValue(uuid)
 	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
@@ -291,6 +304,11 @@ func (r *shaperQueuesResource) Read(ctx context.Context, req resource.ReadReques
 	tflog.Debug(ctx, fmt.Sprintf("Successfully got %s information", resourceName), map[string]any{"success": true})
 
 	// Overwite items with refreshed state
+	contentHash, err := utils.ContentHash(queue)
+	contentHashValue, diags := utils.ContentHashValue(fmt.Sprintf("Read %s error", resourceName), contentHash, err)
+	resp.Diagnostics.Append(diags...)
+	state.ContentHash = contentHashValue
+
 	state.Enabled = types.BoolValue(queue.Enabled)
 	state.Pipe = types.StringValue(queue.Pipe)
 	state.Weight = types.Int32Value(queue.Weight)
@@ -375,6 +393,11 @@ func (r *shaperQueuesResource) Update(ctx context.Context, req resource.UpdateRe
 		tflog.Debug(ctx, "Successfully applied configuration on OPNsense", map[string]any{"success": true})
 	}
 
+	// Get content hash from OPNsense
+	contentHash, err := getShaperQueueContentHash(r.client, state.Id.ValueString())
+	plan.ContentHash, diags = utils.ContentHashValue(fmt.Sprintf("Update %s error", resourceName), contentHash, err)
+	resp.Diagnostics.Append(diags...)
+
 	// Update last_updated field (if change detected)
 	if !(reflect.DeepEqual(plan, state)) {
 		plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
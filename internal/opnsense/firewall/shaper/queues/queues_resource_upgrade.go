@@ -0,0 +1,101 @@
+package queues
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-opnsense/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// shaperQueuesResourceModelV0 describes the resource data model of schema version 0.
+type shaperQueuesResourceModelV0 struct {
+	Id          *string `json:"id"`
+	LastUpdated *string `json:"last_updated"`
+	Enabled     *bool   `json:"enabled"`
+	Pipe        *string `json:"pipe"`
+	Weight      *int32  `json:"weight"`
+	Mask        *string `json:"mask"`
+	Buckets     *int32  `json:"buckets"`
+	Codel       *struct {
+		Enabled  *bool  `json:"enabled"`
+		Target   *int32 `json:"target"`
+		Interval *int32 `json:"interval"`
+		Ecn      *bool  `json:"ecn"`
+	} `json:"codel"`
+	Pie         *bool   `json:"pie"`
+	Description *string `json:"description"`
+}
+
+// UpgradeState upgrades the resource state from prior schema versions to the current schema version.
+func (r *shaperQueuesResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {StateUpgrader: upgradeShaperQueuesStateV0},
+	}
+}
+
+// upgradeShaperQueuesStateV0 upgrades the resource state from schema version 0.
+func upgradeShaperQueuesStateV0(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	tflog.Info(ctx, fmt.Sprintf("Upgrading %s state from version 0", resourceName))
+
+	var priorState shaperQueuesResourceModelV0
+	err := utils.DecodeRawState(req, &priorState)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Upgrade %s state error", resourceName), fmt.Sprintf("%s", err))
+		return
+	}
+
+	// Codel
+	var codelEnabled, codelEcn *bool
+	var codelTarget, codelInterval *int32
+	if priorState.Codel != nil {
+		codelEnabled = priorState.Codel.Enabled
+		codelTarget = priorState.Codel.Target
+		codelInterval = priorState.Codel.Interval
+		codelEcn = priorState.Codel.Ecn
+	}
+
+	codel, diags := types.ObjectValue(
+		map[string]attr.Type{
+			"enabled":  types.BoolType,
+			"target":   types.Int32Type,
+			"interval": types.Int32Type,
+			"ecn":      types.BoolType,
+		},
+		map[string]attr.Value{
+			"enabled":  utils.BoolOrDefault(codelEnabled, false),
+			"target":   utils.Int32OrDefault(codelTarget, -1),
+			"interval": utils.Int32OrDefault(codelInterval, -1),
+			"ecn":      utils.BoolOrDefault(codelEcn, false),
+		},
+	)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state := shaperQueuesResourceModel{
+		Id:          utils.StringOrNull(priorState.Id),
+		LastUpdated: utils.StringOrNull(priorState.LastUpdated),
+		Enabled:     utils.BoolOrDefault(priorState.Enabled, true),
+		Pipe:        utils.StringOrNull(priorState.Pipe),
+		Weight:      utils.Int32OrDefault(priorState.Weight, 100),
+		Mask:        utils.StringOrDefault(priorState.Mask, "none"),
+		Buckets:     utils.Int32OrDefault(priorState.Buckets, -1),
+		Codel:       codel,
+		Pie:         utils.BoolOrDefault(priorState.Pie, false),
+		Description: utils.StringOrNull(priorState.Description),
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Successfully upgraded %s state from version 0", resourceName))
+}
@@ -0,0 +1,28 @@
+package queues_test
+
+import (
+	"terraform-provider-opnsense/internal/acctest"
+	"terraform-provider-opnsense/internal/opnsense/firewall/shaper/queues"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestShaperQueuesResource_upgradeState(t *testing.T) {
+	acctest.RunStateUpgradeTests(t, []acctest.StateUpgradeTestCase{
+		{
+			Name:      "v0 defaults",
+			Resource:  queues.NewShaperQueuesResource(),
+			Version:   0,
+			StateFile: "testdata/queues_resource_v0.json",
+			Expected: map[string]attr.Value{
+				"enabled":      types.BoolValue(true),
+				"weight":       types.Int32Value(100),
+				"buckets":      types.Int32Value(-1),
+				"codel.target": types.Int32Value(-1),
+				"codel.ecn":    types.BoolValue(false),
+			},
+		},
+	})
+}
@@ -328,3 +328,13 @@ func deleteShaperRule(client *opnsense.Client, uuid string) error {
 	}
 	return nil
 }
+
+// getShaperRuleContentHash gets the content hash of the shaper rule from OPNsense.
+func getShaperRuleContentHash(client *opnsense.Client, uuid string) (string, error) {
+	object, err := getShaperRule(client, uuid)
+	if err != nil {
+		return "", err
+	}
+
+	return utils.ContentHash(object)
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 	"time"
 
+	"terraform-provider-opnsense/internal/customtypes"
 	"terraform-provider-opnsense/internal/opnsense"
 	"terraform-provider-opnsense/internal/opnsense/firewall"
 	"terraform-provider-opnsense/internal/opnsense/firewall/shaper"
@@ -33,9 +34,10 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &shaperRulesResource{}
-	_ resource.ResourceWithConfigure   = &shaperRulesResource{}
-	_ resource.ResourceWithImportState = &shaperRulesResource{}
+	_ resource.Resource                 = &shaperRulesResource{}
+	_ resource.ResourceWithConfigure    = &shaperRulesResource{}
+	_ resource.ResourceWithImportState  = &shaperRulesResource{}
+	_ resource.ResourceWithUpgradeState = &shaperRulesResource{}
 )
 
 // NewShaperRulesResource is a helper function to simplify the provider implementation.
@@ -50,24 +52,25 @@ type shaperRulesResource struct {
 
 // shaperRulesResourceModel describes the resource data model.
 type shaperRulesResourceModel struct {
-	Id              types.String `tfsdk:"id"`
-	LastUpdated     types.String `tfsdk:"last_updated"`
-	Enabled         types.Bool   `tfsdk:"enabled"`
-	Sequence        types.Int32  `tfsdk:"sequence"`
-	Interface       types.String `tfsdk:"interface"`
-	Interface2      types.String `tfsdk:"interface2"`
-	Protocol        types.String `tfsdk:"protocol"`
-	MaxPacketLength types.Int32  `tfsdk:"max_packet_length"`
-	Sources         types.Set    `tfsdk:"sources"`
-	SourceNot       types.Bool   `tfsdk:"source_not"`
-	SourcePort      types.String `tfsdk:"source_port"`
-	Destinations    types.Set    `tfsdk:"destinations"`
-	DestinationNot  types.Bool   `tfsdk:"destination_not"`
-	DestinationPort types.String `tfsdk:"destination_port"`
-	Dscp            types.Set    `tfsdk:"dscp"`
-	Direction       types.String `tfsdk:"direction"`
-	Target          types.String `tfsdk:"target"`
-	Description     types.String `tfsdk:"description"`
+	Id              types.String                           `tfsdk:"id"`
+	LastUpdated     types.String                           `tfsdk:"last_updated"`
+	ContentHash     types.String                           `tfsdk:"content_hash"`
+	Enabled         types.Bool                             `tfsdk:"enabled"`
+	Sequence        types.Int32                            `tfsdk:"sequence"`
+	Interface       types.String                           `tfsdk:"interface"`
+	Interface2      types.String                           `tfsdk:"interface2"`
+	Protocol        customtypes.CaseInsensitiveStringValue `tfsdk:"protocol"`
+	MaxPacketLength types.Int32                            `tfsdk:"max_packet_length"`
+	Sources         types.Set                              `tfsdk:"sources"`
+	SourceNot       types.Bool                             `tfsdk:"source_not"`
+	SourcePort      customtypes.PortValue                  `tfsdk:"source_port"`
+	Destinations    types.Set                              `tfsdk:"destinations"`
+	DestinationNot  types.Bool                             `tfsdk:"destination_not"`
+	DestinationPort customtypes.PortValue                  `tfsdk:"destination_port"`
+	Dscp            types.Set                              `tfsdk:"dscp"`
+	Direction       types.String                           `tfsdk:"direction"`
+	Target          types.String                           `tfsdk:"target"`
+	Description     types.String                           `tfsdk:"description"`
 }
 
 // Metadata returns the resource type name.
@@ -77,10 +80,11 @@ func (r *shaperRulesResource) Metadata(ctx context.Context, req resource.Metadat
 
 // Schema defines the schema for the resource.
 func (r *shaperRulesResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
-	defaultSourcesAndDestinations, _ := basetypes.NewSetValue(types.StringType, []attr.Value{basetypes.NewStringValue("any")})
+	defaultSourcesAndDestinations, _ := basetypes.NewSetValue(customtypes.NetworkType{}, []attr.Value{customtypes.NewNetworkValue("any")})
 	emptySet, _ := basetypes.NewSetValue(types.StringType, []attr.Value{})
 
 	resp.Schema = schema.Schema{
+		Version:     1,
 		Description: "Traffic shaping rules are used to apply the shaping to a certain package flow. The shaping rules are handled independently from the firewall rules and other settings.",
 
 		Attributes: map[string]schema.Attribute{
@@ -92,8 +96,13 @@ func (r *shaperRulesResource) Schema(ctx context.Context, req resource.SchemaReq
 				},
 			},
 			"last_updated": schema.StringAttribute{
+				Computed:           true,
+				Description:        fmt.Sprintf("DateTime when the %s was last updated.", resourceName),
+				DeprecationMessage: "Use `content_hash` to detect changes to this resource instead. This attribute will be removed in a future release.",
+			},
+			"content_hash": schema.StringAttribute{
 				Computed:    true,
-				Description: fmt.Sprintf("DateTime when the %s was last updated.", resourceName),
+				Description: fmt.Sprintf("Hash of the %s configuration on OPNsense. Changes whenever the configuration is modified, including changes made outside of Terraform.", resourceName),
 			},
 			"enabled": schema.BoolAttribute{
 				Optional:            true,
@@ -119,8 +128,9 @@ func (r *shaperRulesResource) Schema(ctx context.Context, req resource.SchemaReq
 				Default:     stringdefault.StaticString(""),
 			},
 			"protocol": schema.StringAttribute{
-				Optional: true,
-				Computed: true,
+				CustomType: customtypes.CaseInsensitiveStringType{},
+				Optional:   true,
+				Computed:   true,
 				MarkdownDescription: fmt.Sprintf(
 					"The applicable protocol for this rule. Must be one of: %s. Defaults to `ip`.", strings.Join(
 						// Surround each type with backticks (`)
@@ -146,7 +156,7 @@ func (r *shaperRulesResource) Schema(ctx context.Context, req resource.SchemaReq
 			"sources": schema.SetAttribute{
 				Optional:            true,
 				Computed:            true,
-				ElementType:         types.StringType,
+				ElementType:         customtypes.NetworkType{},
 				MarkdownDescription: "Source IPs or networks, examples `10.0.0.0/24`, `10.0.0.1`. Defaults to be `any`.",
 				Validators:          []validator.Set{setvalidator.SizeAtLeast(1)},
 				Default:             setdefault.StaticValue(defaultSourcesAndDestinations),
@@ -158,6 +168,7 @@ func (r *shaperRulesResource) Schema(ctx context.Context, req resource.SchemaReq
 				Default:             booldefault.StaticBool(false),
 			},
 			"source_port": schema.StringAttribute{
+				CustomType:          customtypes.PortType{},
 				Optional:            true,
 				Computed:            true,
 				MarkdownDescription: "Source port number or well known name (`imap`, `imaps`, `http`, `https`, ...), for ranges use a dash. Defaults to `any`",
@@ -166,7 +177,7 @@ func (r *shaperRulesResource) Schema(ctx context.Context, req resource.SchemaReq
 			"destinations": schema.SetAttribute{
 				Optional:            true,
 				Computed:            true,
-				ElementType:         types.StringType,
+				ElementType:         customtypes.NetworkType{},
 				MarkdownDescription: "Destination ips or networks, examples `10.0.0.0/24`, `10.0.0.1`. Defaults to be `any`.",
 				Validators:          []validator.Set{setvalidator.SizeAtLeast(1)},
 				Default:             setdefault.StaticValue(defaultSourcesAndDestinations),
@@ -178,6 +189,7 @@ func (r *shaperRulesResource) Schema(ctx context.Context, req resource.SchemaReq
 				Default:             booldefault.StaticBool(false),
 			},
 			"destination_port": schema.StringAttribute{
+				CustomType:          customtypes.PortType{},
 				Optional:            true,
 				Computed:            true,
 				MarkdownDescription: "Destination port number or well known name (`imap`, `imaps`, `http`, `https`, ...), for ranges use a dash. Defaults to `any`",
@@ -293,6 +305,11 @@ func (r *shaperRulesResource) Create(ctx context.Context, req resource.CreateReq
 		tflog.Debug(ctx, "Successfully applied configuration on OPNsense", map[string]any{"success": true})
 	}
 
+	// Get content hash from OPNsense
+	contentHash, err := getShaperRuleContentHash(r.client, uuid)
+	plan.ContentHash, diags = utils.ContentHashValue(fmt.Sprintf("Create %s error", resourceName), contentHash, err)
+	resp.Diagnostics.Append(diags...)
+
 	// Update plan ID & last_updated fields
 	plan.Id = types.StringValue(uuid)
 	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
@@ -333,26 +350,31 @@ func (r *shaperRulesResource) Read(ctx context.Context, req resource.ReadRequest
 	tflog.Debug(ctx, fmt.Sprintf("Successfully got %s information", resourceName), map[string]any{"success": true})
 
 	// Overwite items with refreshed state
+	contentHash, err := utils.ContentHash(rule)
+	contentHashValue, diags := utils.ContentHashValue(fmt.Sprintf("Read %s error", resourceName), contentHash, err)
+	resp.Diagnostics.Append(diags...)
+	state.ContentHash = contentHashValue
+
 	state.Enabled = types.BoolValue(rule.Enabled)
 	state.Sequence = types.Int32Value(rule.Sequence)
 	state.Interface = types.StringValue(rule.Interface)
 	state.Interface2 = types.StringValue(rule.Interface2)
-	state.Protocol = types.StringValue(rule.Protocol)
+	state.Protocol = customtypes.NewCaseInsensitiveStringValue(rule.Protocol)
 	state.MaxPacketLength = types.Int32Value(rule.MaxPacketLength)
 
-	sources, diags := utils.SetGoToTerraform(ctx, rule.Sources)
+	sources, diags := customtypes.NewNetworkSetValue(ctx, rule.Sources.Elements())
 	resp.Diagnostics.Append(diags...)
 	state.Sources = sources
 
 	state.SourceNot = types.BoolValue(rule.SourceNot)
-	state.SourcePort = types.StringValue(rule.SourcePort)
+	state.SourcePort = customtypes.NewPortValue(rule.SourcePort)
 
-	destinations, diags := utils.SetGoToTerraform(ctx, rule.Destinations)
+	destinations, diags := customtypes.NewNetworkSetValue(ctx, rule.Destinations.Elements())
 	resp.Diagnostics.Append(diags...)
 	state.Destinations = destinations
 
 	state.DestinationNot = types.BoolValue(rule.DestinationNot)
-	state.DestinationPort = types.StringValue(rule.DestinationPort)
+	state.DestinationPort = customtypes.NewPortValue(rule.DestinationPort)
 
 	dscp, diags := utils.SetGoToTerraform(ctx, rule.Dscp)
 	resp.Diagnostics.Append(diags...)
@@ -421,6 +443,11 @@ func (r *shaperRulesResource) Update(ctx context.Context, req resource.UpdateReq
 		tflog.Debug(ctx, "Successfully applied configuration on OPNsense", map[string]any{"success": true})
 	}
 
+	// Get content hash from OPNsense
+	contentHash, err := getShaperRuleContentHash(r.client, state.Id.ValueString())
+	plan.ContentHash, diags = utils.ContentHashValue(fmt.Sprintf("Update %s error", resourceName), contentHash, err)
+	resp.Diagnostics.Append(diags...)
+
 	// Update last_updated field (if change detected)
 	if !(reflect.DeepEqual(plan, state)) {
 		plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
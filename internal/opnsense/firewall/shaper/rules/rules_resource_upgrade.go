@@ -0,0 +1,94 @@
+package rules
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-opnsense/internal/customtypes"
+	"terraform-provider-opnsense/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// shaperRulesResourceModelV0 describes the resource data model of schema version 0.
+type shaperRulesResourceModelV0 struct {
+	Id              *string  `json:"id"`
+	LastUpdated     *string  `json:"last_updated"`
+	Enabled         *bool    `json:"enabled"`
+	Sequence        *int32   `json:"sequence"`
+	Interface       *string  `json:"interface"`
+	Interface2      *string  `json:"interface2"`
+	Protocol        *string  `json:"protocol"`
+	MaxPacketLength *int32   `json:"max_packet_length"`
+	Sources         []string `json:"sources"`
+	SourceNot       *bool    `json:"source_not"`
+	SourcePort      *string  `json:"source_port"`
+	Destinations    []string `json:"destinations"`
+	DestinationNot  *bool    `json:"destination_not"`
+	DestinationPort *string  `json:"destination_port"`
+	Dscp            []string `json:"dscp"`
+	Direction       *string  `json:"direction"`
+	Target          *string  `json:"target"`
+	Description     *string  `json:"description"`
+}
+
+// UpgradeState upgrades the resource state from prior schema versions to the current schema version.
+func (r *shaperRulesResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {StateUpgrader: upgradeShaperRulesStateV0},
+	}
+}
+
+// upgradeShaperRulesStateV0 upgrades the resource state from schema version 0.
+func upgradeShaperRulesStateV0(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	tflog.Info(ctx, fmt.Sprintf("Upgrading %s state from version 0", resourceName))
+
+	var priorState shaperRulesResourceModelV0
+	err := utils.DecodeRawState(req, &priorState)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Upgrade %s state error", resourceName), fmt.Sprintf("%s", err))
+		return
+	}
+
+	sources, diags := utils.StringSetOrDefault(ctx, priorState.Sources, []string{"any"})
+	resp.Diagnostics.Append(diags...)
+
+	destinations, diags := utils.StringSetOrDefault(ctx, priorState.Destinations, []string{"any"})
+	resp.Diagnostics.Append(diags...)
+
+	dscp, diags := utils.StringSetOrDefault(ctx, priorState.Dscp, []string{})
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state := shaperRulesResourceModel{
+		Id:              utils.StringOrNull(priorState.Id),
+		LastUpdated:     utils.StringOrNull(priorState.LastUpdated),
+		Enabled:         utils.BoolOrDefault(priorState.Enabled, true),
+		Sequence:        utils.Int32OrDefault(priorState.Sequence, 1),
+		Interface:       utils.StringOrNull(priorState.Interface),
+		Interface2:      utils.StringOrDefault(priorState.Interface2, ""),
+		Protocol:        customtypes.CaseInsensitiveStringValue{StringValue: utils.StringOrDefault(priorState.Protocol, "ip")},
+		MaxPacketLength: utils.Int32OrDefault(priorState.MaxPacketLength, -1),
+		Sources:         sources,
+		SourceNot:       utils.BoolOrDefault(priorState.SourceNot, false),
+		SourcePort:      customtypes.PortValue{StringValue: utils.StringOrDefault(priorState.SourcePort, "any")},
+		Destinations:    destinations,
+		DestinationNot:  utils.BoolOrDefault(priorState.DestinationNot, false),
+		DestinationPort: customtypes.PortValue{StringValue: utils.StringOrDefault(priorState.DestinationPort, "any")},
+		Dscp:            dscp,
+		Direction:       utils.StringOrDefault(priorState.Direction, "both"),
+		Target:          utils.StringOrNull(priorState.Target),
+		Description:     utils.StringOrDefault(priorState.Description, ""),
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Successfully upgraded %s state from version 0", resourceName))
+}
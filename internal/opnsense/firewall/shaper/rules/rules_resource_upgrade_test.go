@@ -0,0 +1,30 @@
+package rules_test
+
+import (
+	"terraform-provider-opnsense/internal/acctest"
+	"terraform-provider-opnsense/internal/opnsense/firewall/shaper/rules"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestShaperRulesResource_upgradeState(t *testing.T) {
+	acctest.RunStateUpgradeTests(t, []acctest.StateUpgradeTestCase{
+		{
+			Name:      "v0 defaults",
+			Resource:  rules.NewShaperRulesResource(),
+			Version:   0,
+			StateFile: "testdata/rules_resource_v0.json",
+			Expected: map[string]attr.Value{
+				"enabled":           types.BoolValue(true),
+				"sequence":          types.Int32Value(1),
+				"protocol":          types.StringValue("ip"),
+				"max_packet_length": types.Int32Value(-1),
+				"source_port":       types.StringValue("any"),
+				"direction":         types.StringValue("both"),
+				"description":       types.StringValue(""),
+			},
+		},
+	})
+}
@@ -0,0 +1,194 @@
+package states
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"terraform-provider-opnsense/internal/opnsense"
+)
+
+const (
+	statesModule             string = "diagnostics"
+	statesOpnsenseController string = "firewall"
+
+	queryStatesCommand opnsense.Command = "query_states"
+	killStatesCommand  opnsense.Command = "kill_states"
+)
+
+// HTTP request bodies
+
+type queryStatesRequestBody struct {
+	Current      int32    `json:"current"`
+	RowCount     int32    `json:"rowCount"`
+	SearchPhrase string   `json:"searchPhrase"`
+	RuleId       string   `json:"ruleid,omitempty"`
+	Sort         struct{} `json:"sort"`
+}
+
+type killStatesRequestBody struct {
+	Filter string `json:"filter"`
+	Label  string `json:"label"`
+}
+
+// HTTP response types
+
+type queryStatesResponse struct {
+	Rows     []stateResponse `json:"rows"`
+	RowCount int32           `json:"rowCount"`
+	Total    int32           `json:"total"`
+	Current  int32           `json:"current"`
+}
+
+type stateResponse struct {
+	Id              string         `json:"id"`
+	Interface       string         `json:"iface"`
+	IpVersion       string         `json:"ipproto"`
+	Protocol        string         `json:"proto"`
+	Direction       string         `json:"dir"`
+	SourceAddress   string         `json:"src_addr"`
+	SourcePort      flexibleString `json:"src_port"`
+	DestinationAddr string         `json:"dst_addr"`
+	DestinationPort flexibleString `json:"dst_port"`
+	NatAddress      string         `json:"gw_addr"`
+	NatPort         flexibleString `json:"gw_port"`
+	State           string         `json:"state"`
+	Age             string         `json:"age"`
+	Expires         string         `json:"expires"`
+	Packets         flexibleString `json:"pkts"`
+	Bytes           flexibleString `json:"bytes"`
+	RuleLabel       string         `json:"label"`
+	RuleDescription string         `json:"descr"`
+}
+
+// flexibleString describes a value in OPNsense HTTP responses that can either be a string or a number.
+type flexibleString string
+
+func (f *flexibleString) UnmarshalJSON(data []byte) error {
+	var value any
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+
+	switch v := value.(type) {
+	case nil:
+		*f = ""
+	case string:
+		*f = flexibleString(v)
+	default:
+		*f = flexibleString(strings.TrimSpace(string(data)))
+	}
+	return nil
+}
+
+// int64 returns the value as a number, or 0 if it is not a number.
+func (f flexibleString) int64() int64 {
+	value, err := strconv.ParseInt(string(f), 10, 64)
+	if err != nil {
+		return 0
+	}
+	return value
+}
+
+type killStatesResponse struct {
+	Result        string         `json:"result"`
+	DroppedStates flexibleString `json:"dropped_states"`
+}
+
+// queryStates gets the states on the OPNsense firewall matching the specified filter.
+func queryStates(client *opnsense.Client, filter statesFilter) ([]firewallState, error) {
+	path := fmt.Sprintf("%s/%s/%s", statesModule, statesOpnsenseController, queryStatesCommand)
+
+	body := queryStatesRequestBody{
+		RowCount: -1,
+		RuleId:   filter.RuleLabel,
+	}
+
+	reqBody, err := json.Marshal(body)
+	if err != nil {
+		return nil, fmt.Errorf("Query %s error: failed to marshal json body - %s", resourceName, err)
+	}
+
+	httpResp, err := client.DoRequest(http.MethodPost, path, reqBody)
+	if err != nil {
+		return nil, fmt.Errorf("OPNsense client error: %s", err)
+	}
+
+	if httpResp.StatusCode != 200 {
+		return nil, fmt.Errorf("Query %s error (http): abnormal status code %d in HTTP response. Please contact the provider for assistance", resourceName, httpResp.StatusCode)
+	}
+
+	var response queryStatesResponse
+	err = json.NewDecoder(httpResp.Body).Decode(&response)
+	if err != nil {
+		return nil, fmt.Errorf("Query %s error (http): %s", resourceName, err)
+	}
+
+	states := []firewallState{}
+	for _, row := range response.Rows {
+		state := firewallState{
+			Id:                 row.Id,
+			Interface:          row.Interface,
+			IpVersion:          ipVersionFromOpnsense(row.IpVersion),
+			Protocol:           strings.ToLower(row.Protocol),
+			Direction:          row.Direction,
+			SourceAddress:      row.SourceAddress,
+			SourcePort:         string(row.SourcePort),
+			DestinationAddress: row.DestinationAddr,
+			DestinationPort:    string(row.DestinationPort),
+			NatAddress:         row.NatAddress,
+			NatPort:            string(row.NatPort),
+			State:              row.State,
+			Age:                row.Age,
+			Expires:            row.Expires,
+			Packets:            row.Packets.int64(),
+			Bytes:              row.Bytes.int64(),
+			RuleLabel:          row.RuleLabel,
+			RuleDescription:    row.RuleDescription,
+		}
+
+		if filter.matches(state) {
+			states = append(states, state)
+		}
+	}
+
+	return states, nil
+}
+
+// killStates removes the states created by the rule with the specified label from the OPNsense firewall. Returns the
+// number of removed states.
+func killStates(client *opnsense.Client, label string) (int64, error) {
+	path := fmt.Sprintf("%s/%s/%s", statesModule, statesOpnsenseController, killStatesCommand)
+
+	body := killStatesRequestBody{
+		Label: label,
+	}
+
+	reqBody, err := json.Marshal(body)
+	if err != nil {
+		return 0, fmt.Errorf("Kill %s error: failed to marshal json body - %s", resourceName, err)
+	}
+
+	httpResp, err := client.DoRequest(http.MethodPost, path, reqBody)
+	if err != nil {
+		return 0, fmt.Errorf("OPNsense client error: %s", err)
+	}
+
+	if httpResp.StatusCode != 200 {
+		return 0, fmt.Errorf("Kill %s error (http): abnormal status code %d in HTTP response. Please contact the provider for assistance", resourceName, httpResp.StatusCode)
+	}
+
+	var response killStatesResponse
+	err = json.NewDecoder(httpResp.Body).Decode(&response)
+	if err != nil {
+		return 0, fmt.Errorf("Kill %s error (http): failed to decode http response - %s", resourceName, err)
+	}
+
+	if strings.ToLower(response.Result) != "ok" {
+		return 0, fmt.Errorf("Kill %[1]s error: failed to kill %[1]s on OPNsense. Please contact the provider maintainers for assistance", resourceName)
+	}
+
+	return response.DroppedStates.int64(), nil
+}
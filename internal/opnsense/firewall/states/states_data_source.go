@@ -0,0 +1,257 @@
+package states
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-opnsense/internal/opnsense"
+	"terraform-provider-opnsense/internal/opnsense/firewall"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &statesDataSource{}
+	_ datasource.DataSourceWithConfigure = &statesDataSource{}
+)
+
+// NewStatesDataSource is a helper function to simplify the provider implementation.
+func NewStatesDataSource() datasource.DataSource {
+	return &statesDataSource{}
+}
+
+// statesDataSource defines the data source implementation.
+type statesDataSource struct {
+	client *opnsense.Client
+}
+
+// statesDataSourceModel describes the data source data model.
+type statesDataSourceModel struct {
+	Interface   types.String `tfsdk:"interface"`
+	Source      types.String `tfsdk:"source"`
+	Destination types.String `tfsdk:"destination"`
+	RuleLabel   types.String `tfsdk:"rule_label"`
+	States      []stateModel `tfsdk:"states"`
+}
+
+// stateModel describes a single state in the data source data model.
+type stateModel struct {
+	Id                 types.String `tfsdk:"id"`
+	Interface          types.String `tfsdk:"interface"`
+	IpVersion          types.String `tfsdk:"ip_version"`
+	Protocol           types.String `tfsdk:"protocol"`
+	Direction          types.String `tfsdk:"direction"`
+	SourceAddress      types.String `tfsdk:"source_address"`
+	SourcePort         types.String `tfsdk:"source_port"`
+	DestinationAddress types.String `tfsdk:"destination_address"`
+	DestinationPort    types.String `tfsdk:"destination_port"`
+	NatAddress         types.String `tfsdk:"nat_address"`
+	NatPort            types.String `tfsdk:"nat_port"`
+	State              types.String `tfsdk:"state"`
+	Age                types.String `tfsdk:"age"`
+	Expires            types.String `tfsdk:"expires"`
+	Packets            types.Int64  `tfsdk:"packets"`
+	Bytes              types.Int64  `tfsdk:"bytes"`
+	RuleLabel          types.String `tfsdk:"rule_label"`
+	RuleDescription    types.String `tfsdk:"rule_description"`
+}
+
+// Metadata returns the data source type name.
+func (d *statesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_%s_%s", req.ProviderTypeName, firewall.TypeName, statesController)
+}
+
+// Schema defines the schema for the datasource.
+func (d *statesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Retrieves the current entries of the firewall state table. States can be filtered by interface, source, destination and rule label. All filters are optional and combined, omitting all filters returns the whole state table.",
+
+		Attributes: map[string]schema.Attribute{
+			"interface": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return states on this interface. Use the interface device name (e.g `vtnet0`, `igb1`).",
+			},
+			"source": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return states with a source address matching this IP address or network in CIDR notation.",
+			},
+			"destination": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return states with a destination address matching this IP address or network in CIDR notation.",
+			},
+			"rule_label": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return states created by the rule with this label. For automation filter rules, the label is the rule `id`.",
+			},
+			"states": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The states matching all filters.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Identifier of the state.",
+						},
+						"interface": schema.StringAttribute{
+							Computed:    true,
+							Description: "Interface the state was created on.",
+						},
+						"ip_version": schema.StringAttribute{
+							Computed:    true,
+							Description: "The ip version of the state.",
+						},
+						"protocol": schema.StringAttribute{
+							Computed:    true,
+							Description: "The protocol of the state.",
+						},
+						"direction": schema.StringAttribute{
+							Computed:    true,
+							Description: "Direction the state was created in.",
+						},
+						"source_address": schema.StringAttribute{
+							Computed:    true,
+							Description: "Source address of the state.",
+						},
+						"source_port": schema.StringAttribute{
+							Computed:    true,
+							Description: "Source port of the state.",
+						},
+						"destination_address": schema.StringAttribute{
+							Computed:    true,
+							Description: "Destination address of the state.",
+						},
+						"destination_port": schema.StringAttribute{
+							Computed:    true,
+							Description: "Destination port of the state.",
+						},
+						"nat_address": schema.StringAttribute{
+							Computed:    true,
+							Description: "Translated address of the state. An empty value means the state is not translated.",
+						},
+						"nat_port": schema.StringAttribute{
+							Computed:    true,
+							Description: "Translated port of the state.",
+						},
+						"state": schema.StringAttribute{
+							Computed:    true,
+							Description: "Connection state of the state (e.g `ESTABLISHED:ESTABLISHED`).",
+						},
+						"age": schema.StringAttribute{
+							Computed:    true,
+							Description: "Time since the state was created.",
+						},
+						"expires": schema.StringAttribute{
+							Computed:    true,
+							Description: "Time until the state expires.",
+						},
+						"packets": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Number of packets matched by the state.",
+						},
+						"bytes": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Number of bytes matched by the state.",
+						},
+						"rule_label": schema.StringAttribute{
+							Computed:    true,
+							Description: "Label of the rule that created the state.",
+						},
+						"rule_description": schema.StringAttribute{
+							Computed:    true,
+							Description: "Description of the rule that created the state.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *statesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*opnsense.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *opnsense.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *statesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, fmt.Sprintf("Reading %s", resourceName))
+
+	// Read Terraform configuration data into the model
+	var data statesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Query firewall states
+	filter := statesFilter{
+		Interface:   data.Interface.ValueString(),
+		Source:      data.Source.ValueString(),
+		Destination: data.Destination.ValueString(),
+		RuleLabel:   data.RuleLabel.ValueString(),
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Querying %s", resourceName), map[string]any{"filter": filter})
+
+	states, err := queryStates(d.client, filter)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Read %s error", resourceName), fmt.Sprintf("%s", err))
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Successfully queried %s", resourceName), map[string]any{"count": len(states)})
+
+	// Map response to model
+	data.States = []stateModel{}
+	for _, state := range states {
+		data.States = append(data.States, stateModel{
+			Id:                 types.StringValue(state.Id),
+			Interface:          types.StringValue(state.Interface),
+			IpVersion:          types.StringValue(state.IpVersion),
+			Protocol:           types.StringValue(state.Protocol),
+			Direction:          types.StringValue(state.Direction),
+			SourceAddress:      types.StringValue(state.SourceAddress),
+			SourcePort:         types.StringValue(state.SourcePort),
+			DestinationAddress: types.StringValue(state.DestinationAddress),
+			DestinationPort:    types.StringValue(state.DestinationPort),
+			NatAddress:         types.StringValue(state.NatAddress),
+			NatPort:            types.StringValue(state.NatPort),
+			State:              types.StringValue(state.State),
+			Age:                types.StringValue(state.Age),
+			Expires:            types.StringValue(state.Expires),
+			Packets:            types.Int64Value(state.Packets),
+			Bytes:              types.Int64Value(state.Bytes),
+			RuleLabel:          types.StringValue(state.RuleLabel),
+			RuleDescription:    types.StringValue(state.RuleDescription),
+		})
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Successfully read %s", resourceName), map[string]any{"success": true})
+}
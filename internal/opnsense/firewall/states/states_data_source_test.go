@@ -0,0 +1,49 @@
+package states_test
+
+import (
+	"regexp"
+	"testing"
+
+	"terraform-provider-opnsense/internal/acctest"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccStatesDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing (no filter)
+			{
+				Config: testAccStatesDataSourceConfig_all,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("data.opnsense_firewall_states.test_acc_data_source", "states.#", regexp.MustCompile(`^[1-9][0-9]*$`)),
+				),
+			},
+			// Read testing (no matching states)
+			{
+				Config: testAccStatesDataSourceConfig_noMatch,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("data.opnsense_firewall_states.test_acc_data_source", tfjsonpath.New("source"), knownvalue.StringExact("198.51.100.0/24")),
+					statecheck.ExpectKnownValue("data.opnsense_firewall_states.test_acc_data_source", tfjsonpath.New("states"), knownvalue.ListExact([]knownvalue.Check{})),
+				},
+			},
+		},
+	})
+}
+
+// testAccStatesDataSourceConfig_all reads the whole state table. The API connection of the provider ensures at least one state exists.
+const testAccStatesDataSourceConfig_all = `
+	data "opnsense_firewall_states" "test_acc_data_source" {}
+`
+
+// testAccStatesDataSourceConfig_noMatch reads the states of a documentation network, which should never have states.
+const testAccStatesDataSourceConfig_noMatch = `
+	data "opnsense_firewall_states" "test_acc_data_source" {
+		source = "198.51.100.0/24"
+	}
+`
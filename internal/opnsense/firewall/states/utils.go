@@ -0,0 +1,119 @@
+package states
+
+import (
+	"fmt"
+	"net/netip"
+
+	"terraform-provider-opnsense/internal/opnsense"
+)
+
+const (
+	statesController string = "states"
+
+	resourceName string = "firewall states"
+)
+
+type firewallState struct {
+	Id                 string
+	Interface          string
+	IpVersion          string
+	Protocol           string
+	Direction          string
+	SourceAddress      string
+	SourcePort         string
+	DestinationAddress string
+	DestinationPort    string
+	NatAddress         string
+	NatPort            string
+	State              string
+	Age                string
+	Expires            string
+	Packets            int64
+	Bytes              int64
+	RuleLabel          string
+	RuleDescription    string
+}
+
+// statesFilter describes the criteria states must match to be returned.
+type statesFilter struct {
+	Interface   string
+	Source      string
+	Destination string
+	RuleLabel   string
+}
+
+// Helper functions
+
+// ipVersionFromOpnsense converts the address family reported by OPNsense to the ip version.
+func ipVersionFromOpnsense(family string) string {
+	switch family {
+	case "inet":
+		return "ipv4"
+	case "inet6":
+		return "ipv6"
+	}
+	return family
+}
+
+// addressMatches checks if the address matches the specified filter, which can be an IP address or a network in CIDR
+// notation. Addresses reported with a port (e.g `192.168.1.1:443` or `[2001:db8::1]:443`) are matched on their IP
+// address. An empty filter matches all addresses.
+func addressMatches(address string, filter string) bool {
+	if filter == "" {
+		return true
+	}
+
+	addr, err := parseStateAddress(address)
+	if err != nil {
+		return address == filter
+	}
+
+	if prefix, err := netip.ParsePrefix(filter); err == nil {
+		return prefix.Contains(addr)
+	}
+
+	filterAddr, err := netip.ParseAddr(filter)
+	if err != nil {
+		return false
+	}
+
+	return addr == filterAddr
+}
+
+// parseStateAddress parses the IP address of a state, with or without a port.
+func parseStateAddress(address string) (netip.Addr, error) {
+	addr, err := netip.ParseAddr(address)
+	if err == nil {
+		return addr, nil
+	}
+
+	addrPort, portErr := netip.ParseAddrPort(address)
+	if portErr != nil {
+		return netip.Addr{}, err
+	}
+
+	return addrPort.Addr(), nil
+}
+
+// matches checks if the state matches all criteria of the filter.
+func (f statesFilter) matches(state firewallState) bool {
+	if f.Interface != "" && state.Interface != f.Interface {
+		return false
+	}
+
+	if f.RuleLabel != "" && state.RuleLabel != f.RuleLabel {
+		return false
+	}
+
+	return addressMatches(state.SourceAddress, f.Source) && addressMatches(state.DestinationAddress, f.Destination)
+}
+
+// KillRuleStates removes all states on the OPNsense firewall that were created by the rule with the specified label.
+// Returns the number of removed states.
+func KillRuleStates(client *opnsense.Client, label string) (int64, error) {
+	if label == "" {
+		return 0, fmt.Errorf("Kill %s error: rule label must not be empty", resourceName)
+	}
+
+	return killStates(client, label)
+}
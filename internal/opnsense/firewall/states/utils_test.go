@@ -0,0 +1,53 @@
+package states
+
+import "testing"
+
+func TestAddressMatches(t *testing.T) {
+	testCases := []struct {
+		address  string
+		filter   string
+		expected bool
+	}{
+		// Empty filter
+		{address: "192.168.1.10", filter: "", expected: true},
+		{address: "", filter: "", expected: true},
+
+		// IPv4 addresses
+		{address: "192.168.1.10", filter: "192.168.1.10", expected: true},
+		{address: "192.168.1.10", filter: "192.168.1.11", expected: false},
+		{address: "192.168.1.10", filter: "192.168.1.0/24", expected: true},
+		{address: "192.168.2.10", filter: "192.168.1.0/24", expected: false},
+		{address: "10.1.2.3", filter: "10.0.0.0/8", expected: true},
+		{address: "192.168.1.10", filter: "0.0.0.0/0", expected: true},
+
+		// IPv6 addresses
+		{address: "2001:db8::1", filter: "2001:db8::1", expected: true},
+		{address: "2001:db8::1", filter: "2001:0db8:0000::0001", expected: true},
+		{address: "2001:db8::1", filter: "2001:db8::2", expected: false},
+		{address: "2001:db8:0:1::1", filter: "2001:db8::/32", expected: true},
+		{address: "2001:db9::1", filter: "2001:db8::/32", expected: false},
+
+		// Mixed address families
+		{address: "192.168.1.10", filter: "2001:db8::/32", expected: false},
+		{address: "2001:db8::1", filter: "192.168.1.0/24", expected: false},
+
+		// Addresses with a port
+		{address: "192.168.1.10:443", filter: "192.168.1.10", expected: true},
+		{address: "192.168.1.10:443", filter: "192.168.1.0/24", expected: true},
+		{address: "192.168.1.10:443", filter: "192.168.1.11", expected: false},
+		{address: "[2001:db8::1]:443", filter: "2001:db8::1", expected: true},
+		{address: "[2001:db8::1]:443", filter: "2001:db8::/32", expected: true},
+
+		// Invalid values
+		{address: "192.168.1.10", filter: "not-an-address", expected: false},
+		{address: "unknown", filter: "unknown", expected: true},
+		{address: "unknown", filter: "192.168.1.0/24", expected: false},
+	}
+
+	for _, testCase := range testCases {
+		match := addressMatches(testCase.address, testCase.filter)
+		if match != testCase.expected {
+			t.Errorf("expected match of `%s` against `%s` to be %t, got %t", testCase.address, testCase.filter, testCase.expected, match)
+		}
+	}
+}
@@ -1,6 +1,19 @@
 package firewall
 
+import "strings"
+
 const (
 	TypeName string = "firewall"
 	Module   string = "firewall"
 )
+
+// ProtocolSupportsPorts checks if the specified protocol supports port matching. Protocols are compared
+// case-insensitively (e.g `TCP` & `tcp`).
+func ProtocolSupportsPorts(protocol string) bool {
+	for _, portProtocol := range []string{"tcp", "udp", "tcp/udp"} {
+		if strings.EqualFold(protocol, portProtocol) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,320 @@
+package assignment
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"terraform-provider-opnsense/internal/opnsense"
+	"terraform-provider-opnsense/internal/opnsense/interfaces"
+	"terraform-provider-opnsense/internal/utils"
+)
+
+const (
+	// Controller of the interface assignment API (`/api/interfaces/assignment_settings`) of the OPNsense versions supported by
+	// the provider (25.7.8+). Its fields use the keys of the interface settings in the OPNsense configuration (config.xml),
+	// e.g `if`, `ipaddr`, `subnet`, `track6-interface` & `track6-prefix-id`.
+	assignmentOpnsenseController string = "assignment_settings"
+
+	getAssignmentCommand    opnsense.Command = "getItem"
+	addAssignmentCommand    opnsense.Command = "addItem"
+	setAssignmentCommand    opnsense.Command = "setItem"
+	deleteAssignmentCommand opnsense.Command = "delItem"
+	applyConfigCommand      opnsense.Command = "reconfigure"
+)
+
+// HTTP request bodies
+
+type assignmentHttpBody struct {
+	Interface assignmentRequest `json:"interface"`
+}
+
+type assignmentRequest struct {
+	Device          string                  `json:"if"`
+	Description     string                  `json:"descr"`
+	Enabled         uint8                   `json:"enable"`
+	Ipv4Address     string                  `json:"ipaddr"`
+	Ipv4Subnet      string                  `json:"subnet"`
+	Ipv6Address     string                  `json:"ipaddrv6"`
+	Ipv6Subnet      string                  `json:"subnetv6"`
+	Track6Interface string                  `json:"track6-interface"`
+	Track6PrefixId  opnsense.Pint32AsString `json:"track6-prefix-id"`
+	Mtu             opnsense.Pint32AsString `json:"mtu"`
+	BlockPrivate    uint8                   `json:"blockpriv"`
+	BlockBogons     uint8                   `json:"blockbogons"`
+}
+
+// HTTP response types
+
+type getAssignmentResponse struct {
+	Interface assignmentResponse `json:"interface"`
+}
+
+type assignmentResponse struct {
+	Device          string                  `json:"if"`
+	Description     string                  `json:"descr"`
+	Enabled         uint8                   `json:"enable,string"`
+	Ipv4Address     string                  `json:"ipaddr"`
+	Ipv4Subnet      string                  `json:"subnet"`
+	Ipv6Address     string                  `json:"ipaddrv6"`
+	Ipv6Subnet      string                  `json:"subnetv6"`
+	Track6Interface string                  `json:"track6-interface"`
+	Track6PrefixId  opnsense.Pint32AsString `json:"track6-prefix-id"`
+	Mtu             opnsense.Pint32AsString `json:"mtu"`
+	BlockPrivate    uint8                   `json:"blockpriv,string"`
+	BlockBogons     uint8                   `json:"blockbogons,string"`
+}
+
+// Helper functions
+
+// assignmentToHttpBody converts an interface assignment object to an assignmentHttpBody object for sending to the OPNsense API.
+func assignmentToHttpBody(assignment assignment) (assignmentHttpBody, error) {
+	ipv4Address, ipv4Subnet, err := addressToOpnsense(assignment.Ipv4Type, assignment.Ipv4Address)
+	if err != nil {
+		return assignmentHttpBody{}, fmt.Errorf("IPv4 %s", err)
+	}
+
+	ipv6Address, ipv6Subnet, err := addressToOpnsense(assignment.Ipv6Type, assignment.Ipv6Address)
+	if err != nil {
+		return assignmentHttpBody{}, fmt.Errorf("IPv6 %s", err)
+	}
+
+	// Tracking settings are only stored for track6 interfaces, a negative prefix id is sent as an empty value
+	track6Interface := ""
+	track6PrefixId := opnsense.Pint32AsString(-1)
+	if assignment.Ipv6Type == addressTypeTrack6 {
+		track6Interface = assignment.Track6Interface
+		track6PrefixId = opnsense.Pint32AsString(assignment.Track6PrefixId)
+	}
+
+	return assignmentHttpBody{
+		Interface: assignmentRequest{
+			Device:          assignment.Device,
+			Description:     assignment.Description,
+			Enabled:         utils.BoolToInt(assignment.Enabled),
+			Ipv4Address:     ipv4Address,
+			Ipv4Subnet:      ipv4Subnet,
+			Ipv6Address:     ipv6Address,
+			Ipv6Subnet:      ipv6Subnet,
+			Track6Interface: track6Interface,
+			Track6PrefixId:  track6PrefixId,
+			Mtu:             opnsense.Pint32AsString(assignment.Mtu),
+			BlockPrivate:    utils.BoolToInt(assignment.BlockPrivate),
+			BlockBogons:     utils.BoolToInt(assignment.BlockBogons),
+		},
+	}, nil
+}
+
+// notFoundError returns the error reported when the OPNsense firewall responds with a 404 status code to the specified API path.
+func notFoundError(action string, path string) error {
+	return fmt.Errorf("%s %s error (http): OPNsense responded with status code 404 to `%s`. Verify that your OPNsense version is supported by the provider, otherwise please contact the provider for assistance", action, resourceName, path)
+}
+
+// getAssignment searches the OPNsense firewall for the interface assignment with a matching identifier.
+func getAssignment(client *opnsense.Client, identifier string) (*assignment, error) {
+	path := fmt.Sprintf("%s/%s/%s/%s", interfaces.Module, assignmentOpnsenseController, getAssignmentCommand, identifier)
+
+	httpResp, err := client.DoRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, fmt.Errorf("OPNsense client error: %s", err)
+	}
+	if httpResp.StatusCode == 404 {
+		return nil, notFoundError("Get", path)
+	}
+	if httpResp.StatusCode != 200 {
+		return nil, fmt.Errorf("Get %s error (http): abnormal status code %d in HTTP response. Please contact the provider for assistance", resourceName, httpResp.StatusCode)
+	}
+
+	var response getAssignmentResponse
+	err = json.NewDecoder(httpResp.Body).Decode(&response)
+	if err != nil {
+		var jsonTypeError *json.UnmarshalTypeError
+		if errors.As(err, &jsonTypeError) && jsonTypeError.Value == "array" {
+			return nil, fmt.Errorf("Get %[1]s error: %[1]s with identifier `%[2]s` does not exist.\n\nIf this occurs in a resource block, it is usually because the %[1]s is removed from OPNsense (not using terraform) but is still present in the terraform state. Remove the missing %[1]s from the terraform state to rectify the error.", resourceName, identifier)
+		}
+		return nil, fmt.Errorf("Get %s error (http): %s", resourceName, err)
+	}
+
+	// Extract values from response
+	ipv4Type, ipv4Address := addressFromOpnsense(response.Interface.Ipv4Address, response.Interface.Ipv4Subnet)
+	ipv6Type, ipv6Address := addressFromOpnsense(response.Interface.Ipv6Address, response.Interface.Ipv6Subnet)
+
+	// Prefix id defaults to 0 if not set
+	track6PrefixId := max(int32(response.Interface.Track6PrefixId), 0)
+
+	return &assignment{
+		Device:          response.Interface.Device,
+		Description:     response.Interface.Description,
+		Enabled:         response.Interface.Enabled == 1,
+		Ipv4Type:        ipv4Type,
+		Ipv4Address:     ipv4Address,
+		Ipv6Type:        ipv6Type,
+		Ipv6Address:     ipv6Address,
+		Track6Interface: response.Interface.Track6Interface,
+		Track6PrefixId:  track6PrefixId,
+		Mtu:             int32(response.Interface.Mtu),
+		BlockPrivate:    response.Interface.BlockPrivate == 1,
+		BlockBogons:     response.Interface.BlockBogons == 1,
+	}, nil
+}
+
+// addAssignment assigns a device to a new interface on the OPNsense firewall. Returns the identifier of the new
+// interface (e.g `opt3`), which OPNsense returns in place of the UUID.
+func addAssignment(client *opnsense.Client, assignment assignment) (string, error) {
+	path := fmt.Sprintf("%s/%s/%s", interfaces.Module, assignmentOpnsenseController, addAssignmentCommand)
+
+	// Generate API body from interface assignment object
+	body, err := assignmentToHttpBody(assignment)
+	if err != nil {
+		return "", fmt.Errorf("Add %s error: %s", resourceName, err)
+	}
+
+	reqBody, err := json.Marshal(body)
+	if err != nil {
+		return "", fmt.Errorf("Add %s error: failed to marshal json body - %s", resourceName, err)
+	}
+
+	httpResp, err := client.DoRequest(http.MethodPost, path, reqBody)
+	if err != nil {
+		return "", fmt.Errorf("OPNsense client error: %s", err)
+	}
+
+	if httpResp.StatusCode == 404 {
+		return "", notFoundError("Add", path)
+	}
+
+	if httpResp.StatusCode != 200 {
+		return "", fmt.Errorf("Add %s error (http): abnormal status code %d in HTTP response. Please contact the provider for assistance", resourceName, httpResp.StatusCode)
+	}
+
+	var response opnsense.OpnsenseAddItemResponse
+	err = json.NewDecoder(httpResp.Body).Decode(&response)
+	if err != nil {
+		return "", fmt.Errorf("Add %s error (http): failed to decode http response - %s", resourceName, err)
+	}
+
+	if strings.ToLower(response.Result) == "failed" {
+		return "", fmt.Errorf("Add %[1]s error: failed to add %[1]s to OPNsense - failed validations:\n%s", resourceName, opnsense.ValidationsToString(response.Validations))
+	}
+
+	return response.Uuid, nil
+}
+
+// setAssignment updates an existing interface assignment on the OPNsense firewall with a matching identifier.
+func setAssignment(client *opnsense.Client, assignment assignment, identifier string) error {
+	path := fmt.Sprintf("%s/%s/%s/%s", interfaces.Module, assignmentOpnsenseController, setAssignmentCommand, identifier)
+
+	// Generate API body from interface assignment object
+	body, err := assignmentToHttpBody(assignment)
+	if err != nil {
+		return fmt.Errorf("Set %s error: %s", resourceName, err)
+	}
+
+	reqBody, err := json.Marshal(body)
+	if err != nil {
+		return fmt.Errorf("Set %s error: failed to marshal json body - %s", resourceName, err)
+	}
+
+	httpResp, err := client.DoRequest(http.MethodPost, path, reqBody)
+	if err != nil {
+		return fmt.Errorf("OPNsense client error: %s", err)
+	}
+
+	if httpResp.StatusCode == 404 {
+		return notFoundError("Set", path)
+	}
+
+	if httpResp.StatusCode != 200 {
+		return fmt.Errorf("Set %s error (http): abnormal status code %d in HTTP response. Please contact the provider for assistance", resourceName, httpResp.StatusCode)
+	}
+
+	var response opnsense.OpnsenseAddItemResponse
+	err = json.NewDecoder(httpResp.Body).Decode(&response)
+	if err != nil {
+		return fmt.Errorf("Set %s error (http): failed to decode http response - %s", resourceName, err)
+	}
+
+	if strings.ToLower(response.Result) == "failed" {
+		return fmt.Errorf("Set %[1]s error: failed to update %[1]s on OPNsense - failed validations:\n%s", resourceName, opnsense.ValidationsToString(response.Validations))
+	}
+
+	return nil
+}
+
+// deleteAssignment removes an existing interface assignment from the OPNsense firewall with a matching identifier.
+func deleteAssignment(client *opnsense.Client, identifier string) error {
+	path := fmt.Sprintf("%s/%s/%s/%s", interfaces.Module, assignmentOpnsenseController, deleteAssignmentCommand, identifier)
+
+	// Generate empty body
+	reqBody, err := json.Marshal(nil)
+	if err != nil {
+		return fmt.Errorf("Delete %s error: failed to marshal json body - %s", resourceName, err)
+	}
+
+	httpResp, err := client.DoRequest(http.MethodPost, path, reqBody)
+	if err != nil {
+		return fmt.Errorf("OPNsense client error: %s", err)
+	}
+
+	if httpResp.StatusCode == 404 {
+		return notFoundError("Delete", path)
+	}
+
+	if httpResp.StatusCode != 200 {
+		return fmt.Errorf("Delete %s error (http): abnormal status code %d in HTTP response. Please contact the provider for assistance", resourceName, httpResp.StatusCode)
+	}
+
+	var response opnsense.OpnsenseAddItemResponse
+	err = json.NewDecoder(httpResp.Body).Decode(&response)
+	if err != nil {
+		return fmt.Errorf("Delete %s error (http): failed to decode http response - %s", resourceName, err)
+	}
+
+	if strings.ToLower(response.Result) != "deleted" && strings.ToLower(response.Result) != "not found" {
+		return fmt.Errorf("Delete %[1]s error: failed to delete %[1]s on OPNsense. The interface may still be referenced by other configuration (e.g firewall rules or interface groups), remove these references first", resourceName)
+	}
+	return nil
+}
+
+// applyConfig applies the interface configuration on the OPNsense firewall.
+func applyConfig(client *opnsense.Client) error {
+	path := fmt.Sprintf("%s/%s/%s", interfaces.Module, assignmentOpnsenseController, applyConfigCommand)
+
+	// Generate empty body
+	reqBody, err := json.Marshal(nil)
+	if err != nil {
+		return fmt.Errorf("apply configuration error: failed to marshal json body - %s", err)
+	}
+
+	httpResp, err := client.DoRequest(http.MethodPost, path, reqBody)
+	if err != nil {
+		return fmt.Errorf("OPNsense client error: %s", err)
+	}
+
+	if httpResp.StatusCode != 200 {
+		return fmt.Errorf("Apply configuration error (http): abnormal status code %d in HTTP response. Please contact the provider for assistance", httpResp.StatusCode)
+	}
+
+	var response opnsense.OpnsenseApplyConfigResponse
+	err = json.NewDecoder(httpResp.Body).Decode(&response)
+	if err != nil {
+		return fmt.Errorf("Apply configuration error (http): failed to decode http response - %s", err)
+	}
+
+	if strings.ToLower(response.Status) != "ok" {
+		return fmt.Errorf("Apply configuration error: failed to apply configuration on OPNsense. Please contact the provider maintainers for assistance")
+	}
+	return nil
+}
+
+// getAssignmentContentHash gets the content hash of the interface assignment from OPNsense.
+func getAssignmentContentHash(client *opnsense.Client, identifier string) (string, error) {
+	object, err := getAssignment(client, identifier)
+	if err != nil {
+		return "", err
+	}
+
+	return utils.ContentHash(object)
+}
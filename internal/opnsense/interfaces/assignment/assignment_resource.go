@@ -0,0 +1,499 @@
+package assignment
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"strings"
+
+	"terraform-provider-opnsense/internal/opnsense"
+	"terraform-provider-opnsense/internal/opnsense/interfaces"
+	"terraform-provider-opnsense/internal/opnsense/interfaces/overview"
+	"terraform-provider-opnsense/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                   = &assignmentResource{}
+	_ resource.ResourceWithConfigure      = &assignmentResource{}
+	_ resource.ResourceWithImportState    = &assignmentResource{}
+	_ resource.ResourceWithValidateConfig = &assignmentResource{}
+)
+
+// NewAssignmentResource is a helper function to simplify the provider implementation.
+func NewAssignmentResource() resource.Resource {
+	return &assignmentResource{}
+}
+
+// assignmentResource defines the resource implementation.
+type assignmentResource struct {
+	client *opnsense.Client
+}
+
+// assignmentResourceModel describes the resource data model.
+type assignmentResourceModel struct {
+	Id              types.String `tfsdk:"id"`
+	ContentHash     types.String `tfsdk:"content_hash"`
+	Device          types.String `tfsdk:"device"`
+	Description     types.String `tfsdk:"description"`
+	Enabled         types.Bool   `tfsdk:"enabled"`
+	Ipv4Type        types.String `tfsdk:"ipv4_type"`
+	Ipv4Address     types.String `tfsdk:"ipv4_address"`
+	Ipv6Type        types.String `tfsdk:"ipv6_type"`
+	Ipv6Address     types.String `tfsdk:"ipv6_address"`
+	Track6Interface types.String `tfsdk:"track6_interface"`
+	Track6PrefixId  types.Int32  `tfsdk:"track6_prefix_id"`
+	Mtu             types.Int32  `tfsdk:"mtu"`
+	BlockPrivate    types.Bool   `tfsdk:"block_private"`
+	BlockBogons     types.Bool   `tfsdk:"block_bogons"`
+}
+
+// Metadata returns the resource type name.
+func (r *assignmentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_%s_%s", req.ProviderTypeName, interfaces.TypeName, controller)
+}
+
+// Schema defines the schema for the resource.
+func (r *assignmentResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Interface assignments map a device (e.g a VLAN, WireGuard or OpenVPN device) to a logical interface (e.g `opt3`) with its own addressing. Devices cannot be used in firewall rules or services until they are assigned.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier of the interface (e.g `opt3`). Use this value in attributes that reference an interface, e.g `opnsense_firewall_group.members`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"content_hash": schema.StringAttribute{
+				Computed:    true,
+				Description: fmt.Sprintf("Hash of the %s configuration on OPNsense. Changes whenever the configuration is modified, including changes made outside of Terraform.", resourceName),
+			},
+			"device": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The device name to assign to the interface (e.g `vtnet2`, `vlan01`, `wg0`).",
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The description of the interface.",
+				Default:     stringdefault.StaticString(""),
+			},
+			"enabled": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Enable this interface. Defaults to `true`.",
+				Default:     booldefault.StaticBool(true),
+			},
+			"ipv4_type": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				MarkdownDescription: fmt.Sprintf(
+					"The IPv4 configuration type. Must be one of: %s. Defaults to `%s`.", strings.Join(
+						// Surround each type with backticks (`)
+						utils.SliceMap(getIpv4Types(), func(addressType string) string {
+							return fmt.Sprintf("`%s`", addressType)
+						}),
+						", ",
+					),
+					addressTypeNone,
+				),
+				Validators: []validator.String{
+					stringvalidator.OneOf(getIpv4Types()...),
+				},
+				Default: stringdefault.StaticString(addressTypeNone),
+			},
+			"ipv4_address": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The static IPv4 address of the interface in CIDR notation (e.g `192.168.10.1/24`). Required when `ipv4_type` is `\"static\"`, must be empty otherwise.",
+				Default:             stringdefault.StaticString(""),
+			},
+			"ipv6_type": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				MarkdownDescription: fmt.Sprintf(
+					"The IPv6 configuration type. Must be one of: %s. Defaults to `%s`.", strings.Join(
+						// Surround each type with backticks (`)
+						utils.SliceMap(getIpv6Types(), func(addressType string) string {
+							return fmt.Sprintf("`%s`", addressType)
+						}),
+						", ",
+					),
+					addressTypeNone,
+				),
+				Validators: []validator.String{
+					stringvalidator.OneOf(getIpv6Types()...),
+				},
+				Default: stringdefault.StaticString(addressTypeNone),
+			},
+			"ipv6_address": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The static IPv6 address of the interface in CIDR notation (e.g `2001:db8::1/64`). Required when `ipv6_type` is `\"static\"`, must be empty otherwise.",
+				Default:             stringdefault.StaticString(""),
+			},
+			"track6_interface": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The identifier of the interface to track for a delegated IPv6 prefix (e.g `wan`). Required when `ipv6_type` is `\"track6\"`, must be empty otherwise.",
+				Default:             stringdefault.StaticString(""),
+			},
+			"track6_prefix_id": schema.Int32Attribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The prefix ID used to select the subnet of the delegated prefix when `ipv6_type` is `\"track6\"`. Defaults to `0`.",
+				Validators:          []validator.Int32{int32validator.AtLeast(0)},
+				Default:             int32default.StaticInt32(0),
+			},
+			"mtu": schema.Int32Attribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The MTU of the interface. Must be at least `576`. Defaults to `-1` (leave empty for defaults).",
+				Validators: []validator.Int32{
+					int32validator.Any(
+						int32validator.OneOf(-1),
+						int32validator.AtLeast(576),
+					),
+				},
+				Default: int32default.StaticInt32(-1),
+			},
+			"block_private": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Block traffic from private networks (RFC 1918) and loopback addresses on this interface. Defaults to `false`.",
+				Default:     booldefault.StaticBool(false),
+			},
+			"block_bogons": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Block traffic from reserved and unassigned (bogon) networks on this interface. Defaults to `false`.",
+				Default:     booldefault.StaticBool(false),
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *assignmentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*opnsense.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *opnsense.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// ValidateConfig validates the resource configuration for cross-attribute constraints.
+func (r *assignmentResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config assignmentResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	validateAddress(path.Root("ipv4_address"), "ipv4_type", config.Ipv4Type, config.Ipv4Address, resp)
+	validateAddress(path.Root("ipv6_address"), "ipv6_type", config.Ipv6Type, config.Ipv6Address, resp)
+
+	// Track6 interface is only valid with track6 type
+	if config.Ipv6Type.IsUnknown() || config.Track6Interface.IsUnknown() {
+		return
+	}
+
+	isTrack6 := config.Ipv6Type.ValueString() == addressTypeTrack6
+	hasTrack6Interface := config.Track6Interface.ValueString() != ""
+
+	if isTrack6 && !hasTrack6Interface {
+		resp.Diagnostics.AddAttributeError(path.Root("track6_interface"), "Missing Attribute Value", fmt.Sprintf("The `track6_interface` attribute is required when `ipv6_type` is `\"%s\"`.", addressTypeTrack6))
+	} else if !isTrack6 && hasTrack6Interface {
+		resp.Diagnostics.AddAttributeError(path.Root("track6_interface"), "Invalid Attribute Combination", fmt.Sprintf("The `track6_interface` attribute can only be set when `ipv6_type` is `\"%s\"`.", addressTypeTrack6))
+	}
+}
+
+// validateAddress validates that an address is specified in CIDR notation for static address types, and is empty otherwise.
+func validateAddress(attribute path.Path, typeAttribute string, addressType types.String, address types.String, resp *resource.ValidateConfigResponse) {
+	// Skip validation if the values are not yet known
+	if addressType.IsUnknown() || address.IsUnknown() {
+		return
+	}
+
+	isStatic := addressType.ValueString() == addressTypeStatic
+
+	if !isStatic {
+		if address.ValueString() != "" {
+			resp.Diagnostics.AddAttributeError(attribute, "Invalid Attribute Combination", fmt.Sprintf("The `%s` attribute can only be set when `%s` is `\"%s\"`.", attribute, typeAttribute, addressTypeStatic))
+		}
+		return
+	}
+
+	if address.ValueString() == "" {
+		resp.Diagnostics.AddAttributeError(attribute, "Missing Attribute Value", fmt.Sprintf("The `%s` attribute is required when `%s` is `\"%s\"`.", attribute, typeAttribute, addressTypeStatic))
+		return
+	}
+
+	if _, err := netip.ParsePrefix(address.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(attribute, "Invalid Attribute Value", fmt.Sprintf("The `%s` attribute must be an IP address in CIDR notation, got: `%s`.", attribute, address.ValueString()))
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *assignmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, fmt.Sprintf("Creating %s", resourceName))
+
+	// Read Terraform plan data into the model
+	var plan assignmentResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create interface assignment object
+	assignment, diags := createAssignment(ctx, r.client, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create interface assignment on OPNsense
+	tflog.Debug(ctx, fmt.Sprintf("Creating %s on OPNsense", resourceName), map[string]any{fmt.Sprintf("%s", resourceName): assignment})
+
+	identifier, err := addAssignment(r.client, assignment)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Create %s error", resourceName), fmt.Sprintf("%s", err))
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Apply configuration on OPNsense
+	tflog.Debug(ctx, "Applying configuration on OPNsense")
+
+	err = applyConfig(r.client)
+	if err != nil {
+		resp.Diagnostics.AddWarning(fmt.Sprintf("Create %s error", resourceName), fmt.Sprintf("%s", err))
+	} else {
+		tflog.Debug(ctx, "Successfully applied configuration on OPNsense", map[string]any{"success": true})
+	}
+
+	// Verify the new interface is usable by other resources
+	tflog.Debug(ctx, "Verifying interface", map[string]any{"interface": identifier})
+
+	interfaceExist, err := overview.VerifyInterface(r.client, identifier)
+	if err != nil {
+		resp.Diagnostics.AddWarning(fmt.Sprintf("Create %s error", resourceName), fmt.Sprintf("%s", err))
+	} else if !interfaceExist {
+		resp.Diagnostics.AddWarning(fmt.Sprintf("Create %s error", resourceName), fmt.Sprintf("The interface `%s` was created but is not yet listed on OPNsense. Resources referencing this interface may fail until the configuration is applied.", identifier))
+	} else {
+		tflog.Debug(ctx, "Successfully verified interface", map[string]any{"success": true})
+	}
+
+	// Get content hash from OPNsense
+	contentHash, err := getAssignmentContentHash(r.client, identifier)
+	plan.ContentHash, diags = utils.ContentHashValue(fmt.Sprintf("Create %s error", resourceName), contentHash, err)
+	resp.Diagnostics.Append(diags...)
+
+	// Update plan ID
+	plan.Id = types.StringValue(identifier)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Successfully created %s", resourceName))
+}
+
+// Read resource information.
+func (r *assignmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, fmt.Sprintf("Reading %s", resourceName))
+
+	// Read Terraform prior state data into the model
+	var state assignmentResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get interface assignment
+	tflog.Debug(ctx, fmt.Sprintf("Getting %s information", resourceName))
+	tflog.SetField(ctx, "identifier", state.Id.ValueString())
+
+	assignment, err := getAssignment(r.client, state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Read %s error", resourceName), fmt.Sprintf("%s", err))
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Successfully got %s information", resourceName), map[string]any{"success": true})
+
+	// Overwite items with refreshed state
+	contentHash, err := utils.ContentHash(assignment)
+	contentHashValue, diags := utils.ContentHashValue(fmt.Sprintf("Read %s error", resourceName), contentHash, err)
+	resp.Diagnostics.Append(diags...)
+	state.ContentHash = contentHashValue
+
+	state.Device = types.StringValue(assignment.Device)
+	state.Description = types.StringValue(assignment.Description)
+	state.Enabled = types.BoolValue(assignment.Enabled)
+	state.Ipv4Type = types.StringValue(assignment.Ipv4Type)
+	state.Ipv4Address = types.StringValue(assignment.Ipv4Address)
+	state.Ipv6Type = types.StringValue(assignment.Ipv6Type)
+	state.Ipv6Address = types.StringValue(assignment.Ipv6Address)
+	state.Track6Interface = types.StringValue(assignment.Track6Interface)
+	state.Track6PrefixId = types.Int32Value(assignment.Track6PrefixId)
+	state.Mtu = types.Int32Value(assignment.Mtu)
+	state.BlockPrivate = types.BoolValue(assignment.BlockPrivate)
+	state.BlockBogons = types.BoolValue(assignment.BlockBogons)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Successfully read %s", resourceName))
+}
+
+// Update updates the resource on OPNsense and the Terraform state.
+func (r *assignmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, fmt.Sprintf("Updating %s", resourceName))
+
+	// Read Terraform plan data into the model
+	var plan assignmentResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read current Terraform state data into the model
+	var state assignmentResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create interface assignment object
+	assignment, diags := createAssignment(ctx, r.client, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update interface assignment on OPNsense
+	tflog.Debug(ctx, fmt.Sprintf("Updating %s on OPNsense", resourceName), map[string]any{fmt.Sprintf("%s", resourceName): assignment})
+
+	err := setAssignment(r.client, assignment, state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Update %s error", resourceName), fmt.Sprintf("%s", err))
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Apply configuration on OPNsense
+	tflog.Debug(ctx, "Applying configuration on OPNsense")
+
+	err = applyConfig(r.client)
+	if err != nil {
+		resp.Diagnostics.AddWarning(fmt.Sprintf("Update %s error", resourceName), fmt.Sprintf("%s", err))
+	} else {
+		tflog.Debug(ctx, "Successfully applied configuration on OPNsense", map[string]any{"success": true})
+	}
+
+	// Get content hash from OPNsense
+	contentHash, err := getAssignmentContentHash(r.client, state.Id.ValueString())
+	plan.ContentHash, diags = utils.ContentHashValue(fmt.Sprintf("Update %s error", resourceName), contentHash, err)
+	resp.Diagnostics.Append(diags...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Successfully updated %s", resourceName))
+}
+
+// Delete removes the resource on OPNsense and from the Terraform state.
+func (r *assignmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, fmt.Sprintf("Deleting %s", resourceName))
+
+	// Read Terraform prior state data into the model
+	var state assignmentResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete interface assignment on OPNsense
+	tflog.Debug(ctx, fmt.Sprintf("Deleting %s on OPNsense", resourceName), map[string]any{"identifier": state.Id.ValueString()})
+
+	err := deleteAssignment(r.client, state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Delete %s error", resourceName), fmt.Sprintf("%s", err))
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Apply configuration on OPNsense
+	tflog.Debug(ctx, "Applying configuration on OPNsense")
+
+	err = applyConfig(r.client)
+	if err != nil {
+		resp.Diagnostics.AddWarning(fmt.Sprintf("Delete %s error", resourceName), fmt.Sprintf("%s", err))
+	} else {
+		tflog.Debug(ctx, "Successfully applied configuration on OPNsense", map[string]any{"success": true})
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Successfully deleted %s", resourceName))
+}
+
+// ImportState imports the resource from OPNsense and enables Terraform to begin managing the resource.
+func (r *assignmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Info(ctx, fmt.Sprintf("Importing %s", resourceName))
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Successfully imported %s", resourceName))
+}
@@ -0,0 +1,121 @@
+package assignment_test
+
+import (
+	"regexp"
+	"testing"
+
+	"terraform-provider-opnsense/internal/acctest"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccAssignmentResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccAssignmentResourceConfig,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("opnsense_interfaces_assignment.test_acc_resource", tfjsonpath.New("id"), knownvalue.StringRegexp(regexp.MustCompile(`^opt\d+$`))),
+					statecheck.ExpectKnownValue("opnsense_interfaces_assignment.test_acc_resource", tfjsonpath.New("description"), knownvalue.StringExact("TFACC")),
+					statecheck.ExpectKnownValue("opnsense_interfaces_assignment.test_acc_resource", tfjsonpath.New("enabled"), knownvalue.Bool(true)),
+					statecheck.ExpectKnownValue("opnsense_interfaces_assignment.test_acc_resource", tfjsonpath.New("ipv4_type"), knownvalue.StringExact("static")),
+					statecheck.ExpectKnownValue("opnsense_interfaces_assignment.test_acc_resource", tfjsonpath.New("ipv4_address"), knownvalue.StringExact("192.168.239.1/24")),
+					statecheck.ExpectKnownValue("opnsense_interfaces_assignment.test_acc_resource", tfjsonpath.New("ipv6_type"), knownvalue.StringExact("none")),
+					statecheck.ExpectKnownValue("opnsense_interfaces_assignment.test_acc_resource", tfjsonpath.New("mtu"), knownvalue.Int32Exact(1400)),
+					statecheck.ExpectKnownValue("opnsense_interfaces_assignment.test_acc_resource", tfjsonpath.New("block_bogons"), knownvalue.Bool(true)),
+				},
+			},
+			// ImportState testing
+			{
+				ResourceName:      "opnsense_interfaces_assignment.test_acc_resource",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccAssignmentResourceConfig_modified,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("opnsense_interfaces_assignment.test_acc_resource", tfjsonpath.New("enabled"), knownvalue.Bool(false)),
+					statecheck.ExpectKnownValue("opnsense_interfaces_assignment.test_acc_resource", tfjsonpath.New("ipv4_type"), knownvalue.StringExact("dhcp")),
+					statecheck.ExpectKnownValue("opnsense_interfaces_assignment.test_acc_resource", tfjsonpath.New("ipv4_address"), knownvalue.StringExact("")),
+					statecheck.ExpectKnownValue("opnsense_interfaces_assignment.test_acc_resource", tfjsonpath.New("ipv6_type"), knownvalue.StringExact("slaac")),
+					statecheck.ExpectKnownValue("opnsense_interfaces_assignment.test_acc_resource", tfjsonpath.New("mtu"), knownvalue.Int32Exact(-1)),
+					statecheck.ExpectKnownValue("opnsense_interfaces_assignment.test_acc_resource", tfjsonpath.New("block_bogons"), knownvalue.Bool(false)),
+				},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccAssignmentResource_invalidConfig(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAssignmentResourceConfig_missingAddress,
+				ExpectError: regexp.MustCompile("The `ipv4_address` attribute is required"),
+			},
+			{
+				Config:      testAccAssignmentResourceConfig_missingTrack6Interface,
+				ExpectError: regexp.MustCompile("The `track6_interface` attribute is required"),
+			},
+		},
+	})
+}
+
+// testAccAssignmentResourceConfig defines an interface assignment for a vlan with a static IPv4 address.
+const testAccAssignmentResourceConfig = `
+	resource "opnsense_interfaces_vlan" "test_acc_resource" {
+		parent = "vtnet1"
+		tag    = 3904
+	}
+
+	resource "opnsense_interfaces_assignment" "test_acc_resource" {
+		device       = opnsense_interfaces_vlan.test_acc_resource.device
+		description  = "TFACC"
+		ipv4_type    = "static"
+		ipv4_address = "192.168.239.1/24"
+		mtu          = 1400
+		block_bogons = true
+	}
+`
+
+// testAccAssignmentResourceConfig_modified defines a disabled interface assignment using dynamic addressing.
+const testAccAssignmentResourceConfig_modified = `
+	resource "opnsense_interfaces_vlan" "test_acc_resource" {
+		parent = "vtnet1"
+		tag    = 3904
+	}
+
+	resource "opnsense_interfaces_assignment" "test_acc_resource" {
+		device      = opnsense_interfaces_vlan.test_acc_resource.device
+		description = "TFACC"
+		enabled     = false
+		ipv4_type   = "dhcp"
+		ipv6_type   = "slaac"
+	}
+`
+
+// testAccAssignmentResourceConfig_missingAddress defines an interface assignment with a static type but no address.
+const testAccAssignmentResourceConfig_missingAddress = `
+	resource "opnsense_interfaces_assignment" "test_acc_resource" {
+		device    = "vtnet2"
+		ipv4_type = "static"
+	}
+`
+
+// testAccAssignmentResourceConfig_missingTrack6Interface defines an interface assignment with a track6 type but no tracked interface.
+const testAccAssignmentResourceConfig_missingTrack6Interface = `
+	resource "opnsense_interfaces_assignment" "test_acc_resource" {
+		device    = "vtnet2"
+		ipv6_type = "track6"
+	}
+`
@@ -0,0 +1,134 @@
+package assignment
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"slices"
+	"strings"
+
+	"terraform-provider-opnsense/internal/opnsense"
+	"terraform-provider-opnsense/internal/opnsense/interfaces/overview"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	controller string = "assignment"
+
+	resourceName string = "interface assignment"
+)
+
+type assignment struct {
+	Device          string
+	Description     string
+	Enabled         bool
+	Ipv4Type        string
+	Ipv4Address     string
+	Ipv6Type        string
+	Ipv6Address     string
+	Track6Interface string
+	Track6PrefixId  int32
+	Mtu             int32
+	BlockPrivate    bool
+	BlockBogons     bool
+}
+
+// Assignment values
+
+func getIpv4Types() []string {
+	return []string{addressTypeNone, addressTypeStatic, "dhcp"}
+}
+
+func getIpv6Types() []string {
+	return []string{addressTypeNone, addressTypeStatic, "dhcp6", "slaac", addressTypeTrack6}
+}
+
+// Address type mappings
+const (
+	addressTypeNone   string = "none"
+	addressTypeStatic string = "static"
+	addressTypeTrack6 string = "track6"
+)
+
+// dynamicAddressTypes are the address types OPNsense stores as keyword instead of an address. Static addresses are
+// stored as-is & no address is stored as an empty value.
+var dynamicAddressTypes = []string{"dhcp", "dhcp6", "slaac", addressTypeTrack6}
+
+// Helper functions
+
+// addressToOpnsense converts the address type & address in CIDR notation to the address & subnet bits stored on OPNsense.
+func addressToOpnsense(addressType string, address string) (string, string, error) {
+	switch addressType {
+	case addressTypeNone:
+		return "", "", nil
+	case addressTypeStatic:
+		prefix, err := netip.ParsePrefix(address)
+		if err != nil {
+			return "", "", fmt.Errorf("address `%s` must be an IP address in CIDR notation (e.g `192.168.1.1/24`)", address)
+		}
+		return prefix.Addr().String(), fmt.Sprintf("%d", prefix.Bits()), nil
+	}
+
+	if !slices.Contains(dynamicAddressTypes, addressType) {
+		return "", "", fmt.Errorf("address type `%s` not supported. Please contact the provider maintainers if you believe this should be supported.", addressType)
+	}
+	return addressType, "", nil
+}
+
+// addressFromOpnsense converts the address & subnet bits stored on OPNsense to the address type & address in CIDR notation.
+func addressFromOpnsense(address string, bits string) (string, string) {
+	address = strings.TrimSpace(address)
+
+	if address == "" {
+		return addressTypeNone, ""
+	}
+
+	if slices.Contains(dynamicAddressTypes, address) {
+		return address, ""
+	}
+
+	return addressTypeStatic, fmt.Sprintf("%s/%s", address, bits)
+}
+
+// createAssignment creates an interface assignment object based on the specified plan.
+func createAssignment(ctx context.Context, client *opnsense.Client, plan assignmentResourceModel) (assignment, diag.Diagnostics) {
+	var diagnostics diag.Diagnostics
+
+	// Create interface assignment from plan
+	tflog.Debug(ctx, fmt.Sprintf("Creating %s object from plan", resourceName), map[string]any{"plan": plan})
+
+	// Verify tracked interface
+	if plan.Ipv6Type.ValueString() == addressTypeTrack6 {
+		tflog.Debug(ctx, "Verifying interface", map[string]any{"interface": plan.Track6Interface})
+
+		interfaceExist, err := overview.VerifyInterface(client, plan.Track6Interface.ValueString())
+		if err != nil {
+			diagnostics.AddError(fmt.Sprintf("Create %s object error", resourceName), fmt.Sprintf("%s", err))
+		} else if !interfaceExist {
+			diagnostics.AddError(fmt.Sprintf("Create %s object error", resourceName), "The specified track6 interface does not exist. Please verify that the specified interface exist on your OPNsense firewall")
+		}
+
+		tflog.Debug(ctx, "Successfully verified interface", map[string]any{"success": true})
+	}
+
+	assignment := assignment{
+		Device:          plan.Device.ValueString(),
+		Description:     plan.Description.ValueString(),
+		Enabled:         plan.Enabled.ValueBool(),
+		Ipv4Type:        plan.Ipv4Type.ValueString(),
+		Ipv4Address:     plan.Ipv4Address.ValueString(),
+		Ipv6Type:        plan.Ipv6Type.ValueString(),
+		Ipv6Address:     plan.Ipv6Address.ValueString(),
+		Track6Interface: plan.Track6Interface.ValueString(),
+		Track6PrefixId:  plan.Track6PrefixId.ValueInt32(),
+		Mtu:             plan.Mtu.ValueInt32(),
+		BlockPrivate:    plan.BlockPrivate.ValueBool(),
+		BlockBogons:     plan.BlockBogons.ValueBool(),
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Successfully created %s object from plan", resourceName), map[string]any{"success": true})
+
+	return assignment, diagnostics
+}
@@ -0,0 +1,99 @@
+package assignment
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestAddressOpnsense_roundTrip(t *testing.T) {
+	testCases := []struct {
+		addressType     string
+		address         string
+		expectedAddress string
+		expectedBits    string
+	}{
+		{addressType: "none", address: "", expectedAddress: "", expectedBits: ""},
+		{addressType: "static", address: "192.168.1.1/24", expectedAddress: "192.168.1.1", expectedBits: "24"},
+		{addressType: "static", address: "2001:db8::1/64", expectedAddress: "2001:db8::1", expectedBits: "64"},
+		{addressType: "dhcp", address: "", expectedAddress: "dhcp", expectedBits: ""},
+		{addressType: "dhcp6", address: "", expectedAddress: "dhcp6", expectedBits: ""},
+		{addressType: "slaac", address: "", expectedAddress: "slaac", expectedBits: ""},
+		{addressType: "track6", address: "", expectedAddress: "track6", expectedBits: ""},
+	}
+
+	for _, testCase := range testCases {
+		address, bits, err := addressToOpnsense(testCase.addressType, testCase.address)
+		if err != nil {
+			t.Fatalf("unexpected error converting `%s` address `%s`: %s", testCase.addressType, testCase.address, err)
+		}
+
+		if address != testCase.expectedAddress || bits != testCase.expectedBits {
+			t.Errorf("expected `%s` address `%s` to be stored as `%s` & `%s`, got `%s` & `%s`", testCase.addressType, testCase.address, testCase.expectedAddress, testCase.expectedBits, address, bits)
+		}
+
+		addressType, cidr := addressFromOpnsense(address, bits)
+		if addressType != testCase.addressType || cidr != testCase.address {
+			t.Errorf("expected `%s` & `%s` to be read as `%s` address `%s`, got `%s` address `%s`", address, bits, testCase.addressType, testCase.address, addressType, cidr)
+		}
+	}
+}
+
+func TestAddressToOpnsense_invalid(t *testing.T) {
+	testCases := []struct {
+		addressType string
+		address     string
+	}{
+		{addressType: "static", address: "192.168.1.1"},
+		{addressType: "static", address: "not_an_address"},
+		{addressType: "pppoe", address: ""},
+	}
+
+	for _, testCase := range testCases {
+		if _, _, err := addressToOpnsense(testCase.addressType, testCase.address); err == nil {
+			t.Errorf("expected an error converting `%s` address `%s`", testCase.addressType, testCase.address)
+		}
+	}
+}
+
+func TestAssignmentToHttpBody_track6PrefixId(t *testing.T) {
+	testCases := []struct {
+		ipv6Type         string
+		track6PrefixId   int32
+		expectedPrefixId string
+	}{
+		{ipv6Type: "track6", track6PrefixId: 0, expectedPrefixId: `0`},
+		{ipv6Type: "track6", track6PrefixId: 5, expectedPrefixId: `5`},
+		{ipv6Type: "slaac", track6PrefixId: 5, expectedPrefixId: `""`},
+	}
+
+	for _, testCase := range testCases {
+		body, err := assignmentToHttpBody(assignment{Ipv4Type: "none", Ipv6Type: testCase.ipv6Type, Track6Interface: "wan", Track6PrefixId: testCase.track6PrefixId})
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		prefixId, err := json.Marshal(body.Interface.Track6PrefixId)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if string(prefixId) != testCase.expectedPrefixId {
+			t.Errorf("expected track6 prefix id of `%s` interface to be sent as %s, got %s", testCase.ipv6Type, testCase.expectedPrefixId, prefixId)
+		}
+
+		// The sent value must be read back as the same prefix id
+		var response assignmentResponse
+		err = json.Unmarshal([]byte(`{"track6-prefix-id": `+string(prefixId)+`}`), &response)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		expected := int32(0)
+		if testCase.ipv6Type == "track6" {
+			expected = testCase.track6PrefixId
+		}
+		if max(int32(response.Track6PrefixId), 0) != expected {
+			t.Errorf("expected track6 prefix id %d to be read back, got %d", expected, response.Track6PrefixId)
+		}
+	}
+}
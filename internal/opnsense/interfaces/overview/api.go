@@ -4,6 +4,9 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"slices"
+	"strconv"
+	"strings"
 	"terraform-provider-opnsense/internal/opnsense"
 	"terraform-provider-opnsense/internal/opnsense/interfaces"
 )
@@ -31,7 +34,53 @@ type interfacesInfoResponse struct {
 }
 
 type interfaceResponse struct {
-	Identifier string `json:"identifier"`
+	Identifier  string                     `json:"identifier"`
+	Description string                     `json:"description"`
+	Device      string                     `json:"device"`
+	Status      string                     `json:"status"`
+	Enabled     bool                       `json:"enabled"`
+	LinkType    string                     `json:"link_type"`
+	MacAddress  string                     `json:"macaddr"`
+	Mtu         flexibleString             `json:"mtu"`
+	Ipv4        []interfaceAddressResponse `json:"ipv4"`
+	Ipv6        []interfaceAddressResponse `json:"ipv6"`
+	Gateways    []string                   `json:"gateways"`
+	VlanTag     flexibleString             `json:"vlan_tag"`
+}
+
+type interfaceAddressResponse struct {
+	Address    string         `json:"ipaddr"`
+	SubnetBits flexibleString `json:"subnetbits"`
+	LinkLocal  bool           `json:"link-local"`
+}
+
+// flexibleString describes a value in OPNsense HTTP responses that can either be a string or a number.
+type flexibleString string
+
+func (f *flexibleString) UnmarshalJSON(data []byte) error {
+	var value any
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+
+	switch v := value.(type) {
+	case nil:
+		*f = ""
+	case string:
+		*f = flexibleString(v)
+	default:
+		*f = flexibleString(strings.TrimSpace(string(data)))
+	}
+	return nil
+}
+
+// int64 returns the value as a number, or 0 if it is not a number.
+func (f flexibleString) int64() int64 {
+	value, err := strconv.ParseInt(string(f), 10, 64)
+	if err != nil {
+		return 0
+	}
+	return value
 }
 
 // Helper functions
@@ -73,3 +122,47 @@ func checkInterfaceExists(client *opnsense.Client, identifier string) (bool, err
 
 	return false, nil
 }
+
+// getInterfaces gets the details of all interfaces assigned on the OPNsense firewall, sorted by identifier.
+func getInterfaces(client *opnsense.Client) ([]interfaceDetails, error) {
+	path := fmt.Sprintf("%s/%s/%s", interfaces.Module, controller, interfacesInfoCommand)
+
+	body := interfacesInfoRequestBody{
+		RowCount: -1,
+	}
+
+	reqBody, err := json.Marshal(body)
+	if err != nil {
+		return nil, fmt.Errorf("Get %s error: failed to marshal json body - %s", resourceName, err)
+	}
+
+	httpResp, err := client.DoRequest(http.MethodPost, path, reqBody)
+	if err != nil {
+		return nil, fmt.Errorf("OPNsense client error: %s", err)
+	}
+
+	if httpResp.StatusCode != 200 {
+		return nil, fmt.Errorf("Get %s error (http): abnormal status code %d in HTTP response. Please contact the provider for assistance", resourceName, httpResp.StatusCode)
+	}
+
+	var interfacesInfoResponse interfacesInfoResponse
+	err = json.NewDecoder(httpResp.Body).Decode(&interfacesInfoResponse)
+	if err != nil {
+		return nil, fmt.Errorf("Get %s error (http): %s", resourceName, err)
+	}
+
+	ifaces := []interfaceDetails{}
+	for _, row := range interfacesInfoResponse.Rows {
+		// Skip devices that are not assigned to an interface
+		if row.Identifier == "" {
+			continue
+		}
+		ifaces = append(ifaces, interfaceResponseToDetails(row))
+	}
+
+	slices.SortFunc(ifaces, func(a, b interfaceDetails) int {
+		return strings.Compare(a.Identifier, b.Identifier)
+	})
+
+	return ifaces, nil
+}
@@ -0,0 +1,71 @@
+package overview
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestFlexibleString_UnmarshalJSON(t *testing.T) {
+	testCases := []struct {
+		json          string
+		expected      flexibleString
+		expectedInt64 int64
+		expectedError bool
+	}{
+		{json: `"1500"`, expected: "1500", expectedInt64: 1500},
+		{json: `1500`, expected: "1500", expectedInt64: 1500},
+		{json: `""`, expected: "", expectedInt64: 0},
+		{json: `null`, expected: "", expectedInt64: 0},
+		{json: `"auto"`, expected: "auto", expectedInt64: 0},
+		{json: `1.5`, expected: "1.5", expectedInt64: 0},
+		{json: `true`, expected: "true", expectedInt64: 0},
+		{json: `{`, expectedError: true},
+	}
+
+	for _, testCase := range testCases {
+		var value flexibleString
+		err := json.Unmarshal([]byte(testCase.json), &value)
+		if (err != nil) != testCase.expectedError {
+			t.Fatalf("expected error for `%s` to be %t, got: %v", testCase.json, testCase.expectedError, err)
+		}
+		if testCase.expectedError {
+			continue
+		}
+
+		if value != testCase.expected {
+			t.Errorf("expected `%s` to be unmarshalled to `%s`, got `%s`", testCase.json, testCase.expected, value)
+		}
+
+		if value.int64() != testCase.expectedInt64 {
+			t.Errorf("expected `%s` to be converted to %d, got %d", testCase.json, testCase.expectedInt64, value.int64())
+		}
+	}
+}
+
+func TestInterfaceResponse_mixedNumbers(t *testing.T) {
+	testCases := []struct {
+		json            string
+		expectedMtu     int64
+		expectedVlanTag int64
+	}{
+		{json: `{"mtu": "1500", "vlan_tag": "10"}`, expectedMtu: 1500, expectedVlanTag: 10},
+		{json: `{"mtu": 1500, "vlan_tag": 10}`, expectedMtu: 1500, expectedVlanTag: 10},
+		{json: `{"mtu": "9000", "vlan_tag": 20}`, expectedMtu: 9000, expectedVlanTag: 20},
+		{json: `{"mtu": 1500, "vlan_tag": ""}`, expectedMtu: 1500, expectedVlanTag: 0},
+		{json: `{"mtu": 1500, "vlan_tag": null}`, expectedMtu: 1500, expectedVlanTag: 0},
+		{json: `{}`, expectedMtu: 0, expectedVlanTag: 0},
+	}
+
+	for _, testCase := range testCases {
+		var row interfaceResponse
+		err := json.Unmarshal([]byte(testCase.json), &row)
+		if err != nil {
+			t.Fatalf("unexpected error unmarshalling `%s`: %s", testCase.json, err)
+		}
+
+		details := interfaceResponseToDetails(row)
+		if details.Mtu != testCase.expectedMtu || details.VlanTag != testCase.expectedVlanTag {
+			t.Errorf("expected mtu %d & vlan tag %d for `%s`, got mtu %d & vlan tag %d", testCase.expectedMtu, testCase.expectedVlanTag, testCase.json, details.Mtu, details.VlanTag)
+		}
+	}
+}
@@ -0,0 +1,103 @@
+package overview
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-opnsense/internal/opnsense"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &interfaceDataSource{}
+	_ datasource.DataSourceWithConfigure = &interfaceDataSource{}
+)
+
+// NewInterfaceDataSource is a helper function to simplify the provider implementation.
+func NewInterfaceDataSource() datasource.DataSource {
+	return &interfaceDataSource{}
+}
+
+// interfaceDataSource defines the data source implementation.
+type interfaceDataSource struct {
+	client *opnsense.Client
+}
+
+// Metadata returns the data source type name.
+func (d *interfaceDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_%s", req.ProviderTypeName, resourceName)
+}
+
+// Schema defines the schema for the datasource.
+func (d *interfaceDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Retrieves the details of an assigned interface from the interface overview, e.g to reference the subnet of an interface in firewall rules instead of a hardcoded network.",
+
+		Attributes: getInterfaceAttributes(schema.StringAttribute{
+			Required:            true,
+			MarkdownDescription: "Identifier of the interface (e.g `lan`, `opt1`).",
+		}),
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *interfaceDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*opnsense.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *opnsense.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *interfaceDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, fmt.Sprintf("Reading %s", resourceName))
+
+	// Read Terraform configuration data into the model
+	var data interfaceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get interface
+	tflog.Debug(ctx, fmt.Sprintf("Getting %s information", resourceName), map[string]any{"identifier": data.Identifier.ValueString()})
+
+	iface, err := getInterface(d.client, data.Identifier.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Read %s error", resourceName), fmt.Sprintf("%s", err))
+	} else if iface == nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Read %s error", resourceName), fmt.Sprintf("Interface `%s` does not exist. Please verify that the specified interface exists on your OPNsense firewall", data.Identifier.ValueString()))
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Successfully got %s information", resourceName), map[string]any{"success": true})
+
+	// Map response to model
+	data = interfaceDetailsToModel(*iface)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Successfully read %s", resourceName), map[string]any{"success": true})
+}
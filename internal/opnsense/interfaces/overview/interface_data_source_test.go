@@ -0,0 +1,53 @@
+package overview_test
+
+import (
+	"regexp"
+	"testing"
+
+	"terraform-provider-opnsense/internal/acctest"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccInterfaceDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccInterfaceDataSourceConfig,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("data.opnsense_interface.test_acc_data_source", tfjsonpath.New("identifier"), knownvalue.StringExact("lan")),
+					statecheck.ExpectKnownValue("data.opnsense_interface.test_acc_data_source", tfjsonpath.New("enabled"), knownvalue.Bool(true)),
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.opnsense_interface.test_acc_data_source", "device"),
+					resource.TestMatchResourceAttr("data.opnsense_interface.test_acc_data_source", "ipv4_subnet", regexp.MustCompile(`^[0-9.]+/[0-9]+$`)),
+				),
+			},
+			// Read testing (missing interface)
+			{
+				Config:      testAccInterfaceDataSourceConfig_missing,
+				ExpectError: regexp.MustCompile("does not exist"),
+			},
+		},
+	})
+}
+
+// testAccInterfaceDataSourceConfig reads the LAN interface, which has a static IPv4 address on a default installation.
+const testAccInterfaceDataSourceConfig = `
+	data "opnsense_interface" "test_acc_data_source" {
+		identifier = "lan"
+	}
+`
+
+// testAccInterfaceDataSourceConfig_missing reads an interface that does not exist.
+const testAccInterfaceDataSourceConfig_missing = `
+	data "opnsense_interface" "test_acc_data_source" {
+		identifier = "test_acc_missing"
+	}
+`
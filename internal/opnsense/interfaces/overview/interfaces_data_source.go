@@ -0,0 +1,232 @@
+package overview
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-opnsense/internal/opnsense"
+	"terraform-provider-opnsense/internal/opnsense/interfaces"
+	"terraform-provider-opnsense/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &interfacesDataSource{}
+	_ datasource.DataSourceWithConfigure = &interfacesDataSource{}
+)
+
+// NewInterfacesDataSource is a helper function to simplify the provider implementation.
+func NewInterfacesDataSource() datasource.DataSource {
+	return &interfacesDataSource{}
+}
+
+// interfacesDataSource defines the data source implementation.
+type interfacesDataSource struct {
+	client *opnsense.Client
+}
+
+// interfacesDataSourceModel describes the data source data model.
+type interfacesDataSourceModel struct {
+	Interfaces []interfaceModel `tfsdk:"interfaces"`
+}
+
+// interfaceModel describes a single interface in the data source data model.
+type interfaceModel struct {
+	Identifier    types.String   `tfsdk:"identifier"`
+	Description   types.String   `tfsdk:"description"`
+	Device        types.String   `tfsdk:"device"`
+	Status        types.String   `tfsdk:"status"`
+	Enabled       types.Bool     `tfsdk:"enabled"`
+	LinkType      types.String   `tfsdk:"link_type"`
+	MacAddress    types.String   `tfsdk:"mac_address"`
+	Mtu           types.Int64    `tfsdk:"mtu"`
+	Ipv4Address   types.String   `tfsdk:"ipv4_address"`
+	Ipv4Addresses []types.String `tfsdk:"ipv4_addresses"`
+	Ipv4Subnet    types.String   `tfsdk:"ipv4_subnet"`
+	Ipv6Address   types.String   `tfsdk:"ipv6_address"`
+	Ipv6Addresses []types.String `tfsdk:"ipv6_addresses"`
+	Ipv6Subnet    types.String   `tfsdk:"ipv6_subnet"`
+	Gateways      []types.String `tfsdk:"gateways"`
+	VlanTag       types.Int64    `tfsdk:"vlan_tag"`
+}
+
+// Metadata returns the data source type name.
+func (d *interfacesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_%s", req.ProviderTypeName, interfaces.TypeName)
+}
+
+// Schema defines the schema for the datasource.
+func (d *interfacesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Retrieves the details of all assigned interfaces from the interface overview. Devices which are not assigned to an interface are not returned. Use `opnsense_interface` to retrieve a single interface.",
+
+		Attributes: map[string]schema.Attribute{
+			"interfaces": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The assigned interfaces, sorted by identifier.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: getInterfaceAttributes(schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "Identifier of the interface (e.g `lan`, `opt1`).",
+					}),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *interfacesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*opnsense.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *opnsense.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *interfacesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, fmt.Sprintf("Reading %s", interfaces.TypeName))
+
+	// Read Terraform configuration data into the model
+	var data interfacesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get interfaces
+	tflog.Debug(ctx, fmt.Sprintf("Getting %s information", interfaces.TypeName))
+
+	ifaces, err := getInterfaces(d.client)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Read %s error", interfaces.TypeName), fmt.Sprintf("%s", err))
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Successfully got %s information", interfaces.TypeName), map[string]any{"count": len(ifaces)})
+
+	// Map response to model
+	data.Interfaces = []interfaceModel{}
+	for _, iface := range ifaces {
+		data.Interfaces = append(data.Interfaces, interfaceDetailsToModel(iface))
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Successfully read %s", interfaces.TypeName), map[string]any{"success": true})
+}
+
+// getInterfaceAttributes returns the schema attributes of an interface, using the specified attribute for the identifier.
+func getInterfaceAttributes(identifier schema.StringAttribute) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"identifier": identifier,
+		"description": schema.StringAttribute{
+			Computed:    true,
+			Description: "The description of the interface.",
+		},
+		"device": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The device name of the interface (e.g `vtnet0`, `igb1_vlan10`).",
+		},
+		"status": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The link status of the interface (e.g `up`, `down`, `no carrier`).",
+		},
+		"enabled": schema.BoolAttribute{
+			Computed:    true,
+			Description: "Whether the interface is enabled.",
+		},
+		"link_type": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The IPv4 configuration type of the interface (e.g `static`, `dhcp`, `none`).",
+		},
+		"mac_address": schema.StringAttribute{
+			Computed:    true,
+			Description: "The MAC address of the interface.",
+		},
+		"mtu": schema.Int64Attribute{
+			Computed:    true,
+			Description: "The MTU of the interface.",
+		},
+		"ipv4_address": schema.StringAttribute{
+			Computed:    true,
+			Description: "The primary IPv4 address of the interface, without prefix length. Empty if the interface has no IPv4 address.",
+		},
+		"ipv4_addresses": schema.ListAttribute{
+			Computed:            true,
+			ElementType:         types.StringType,
+			MarkdownDescription: "All IPv4 addresses of the interface in CIDR notation (e.g `192.168.1.1/24`), including virtual IPs.",
+		},
+		"ipv4_subnet": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The network of the primary IPv4 address in CIDR notation (e.g `192.168.1.0/24`). Empty if the interface has no IPv4 address.",
+		},
+		"ipv6_address": schema.StringAttribute{
+			Computed:    true,
+			Description: "The primary global IPv6 address of the interface, without prefix length. Empty if the interface has no global IPv6 address.",
+		},
+		"ipv6_addresses": schema.ListAttribute{
+			Computed:            true,
+			ElementType:         types.StringType,
+			MarkdownDescription: "All global IPv6 addresses of the interface in CIDR notation (e.g `2001:db8::1/64`). Link-local addresses are not included.",
+		},
+		"ipv6_subnet": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The network of the primary global IPv6 address in CIDR notation (e.g `2001:db8::/64`). Empty if the interface has no global IPv6 address.",
+		},
+		"gateways": schema.ListAttribute{
+			Computed:    true,
+			ElementType: types.StringType,
+			Description: "The gateway addresses of the interface.",
+		},
+		"vlan_tag": schema.Int64Attribute{
+			Computed:            true,
+			MarkdownDescription: "The VLAN tag of the interface. `0` if the interface is not a VLAN.",
+		},
+	}
+}
+
+// interfaceDetailsToModel converts the interface details to the data source data model.
+func interfaceDetailsToModel(iface interfaceDetails) interfaceModel {
+	return interfaceModel{
+		Identifier:    types.StringValue(iface.Identifier),
+		Description:   types.StringValue(iface.Description),
+		Device:        types.StringValue(iface.Device),
+		Status:        types.StringValue(iface.Status),
+		Enabled:       types.BoolValue(iface.Enabled),
+		LinkType:      types.StringValue(iface.LinkType),
+		MacAddress:    types.StringValue(iface.MacAddress),
+		Mtu:           types.Int64Value(iface.Mtu),
+		Ipv4Address:   types.StringValue(getPrimaryAddress(iface.Ipv4Addresses)),
+		Ipv4Addresses: utils.StringListGoToTerraform(iface.Ipv4Addresses),
+		Ipv4Subnet:    types.StringValue(iface.Ipv4Subnet),
+		Ipv6Address:   types.StringValue(getPrimaryAddress(iface.Ipv6Addresses)),
+		Ipv6Addresses: utils.StringListGoToTerraform(iface.Ipv6Addresses),
+		Ipv6Subnet:    types.StringValue(iface.Ipv6Subnet),
+		Gateways:      utils.StringListGoToTerraform(iface.Gateways),
+		VlanTag:       types.Int64Value(iface.VlanTag),
+	}
+}
@@ -0,0 +1,34 @@
+package overview_test
+
+import (
+	"regexp"
+	"testing"
+
+	"terraform-provider-opnsense/internal/acctest"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccInterfacesDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccInterfacesDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("data.opnsense_interfaces.test_acc_data_source", "interfaces.#", regexp.MustCompile(`^[1-9][0-9]*$`)),
+					resource.TestCheckTypeSetElemNestedAttrs("data.opnsense_interfaces.test_acc_data_source", "interfaces.*", map[string]string{
+						"identifier": "lan",
+					}),
+				),
+			},
+		},
+	})
+}
+
+// testAccInterfacesDataSourceConfig reads all assigned interfaces.
+const testAccInterfacesDataSourceConfig = `
+	data "opnsense_interfaces" "test_acc_data_source" {}
+`
@@ -2,6 +2,8 @@ package overview
 
 import (
 	"fmt"
+	"net/netip"
+	"strings"
 	"terraform-provider-opnsense/internal/opnsense"
 	"terraform-provider-opnsense/internal/utils"
 )
@@ -12,6 +14,23 @@ const (
 	resourceName string = "interface"
 )
 
+type interfaceDetails struct {
+	Identifier    string
+	Description   string
+	Device        string
+	Status        string
+	Enabled       bool
+	LinkType      string
+	MacAddress    string
+	Mtu           int64
+	Ipv4Addresses []string
+	Ipv4Subnet    string
+	Ipv6Addresses []string
+	Ipv6Subnet    string
+	Gateways      []string
+	VlanTag       int64
+}
+
 // VerifyInterfaces checks if the specified list of interfaces exist on the OPNsense firewall.
 func VerifyInterfaces(client *opnsense.Client, interfacesList *utils.Set) (bool, error) {
 	for _, iface := range interfacesList.Elements() {
@@ -40,3 +59,93 @@ func VerifyInterface(client *opnsense.Client, iface string) (bool, error) {
 
 	return true, nil
 }
+
+// Helper functions
+
+// interfaceResponseToDetails converts an interface in the OPNsense HTTP response to the interface details.
+func interfaceResponseToDetails(row interfaceResponse) interfaceDetails {
+	details := interfaceDetails{
+		Identifier:    row.Identifier,
+		Description:   row.Description,
+		Device:        row.Device,
+		Status:        row.Status,
+		Enabled:       row.Enabled,
+		LinkType:      row.LinkType,
+		MacAddress:    row.MacAddress,
+		Mtu:           row.Mtu.int64(),
+		Ipv4Addresses: []string{},
+		Ipv6Addresses: []string{},
+		Gateways:      []string{},
+		VlanTag:       row.VlanTag.int64(),
+	}
+
+	for _, addr := range row.Ipv4 {
+		details.Ipv4Addresses, details.Ipv4Subnet = appendInterfaceAddress(details.Ipv4Addresses, details.Ipv4Subnet, addr)
+	}
+
+	for _, addr := range row.Ipv6 {
+		// Link-local addresses are present on every IPv6 interface, so only report global addresses
+		if addr.LinkLocal {
+			continue
+		}
+		details.Ipv6Addresses, details.Ipv6Subnet = appendInterfaceAddress(details.Ipv6Addresses, details.Ipv6Subnet, addr)
+	}
+
+	if row.Gateways != nil {
+		details.Gateways = row.Gateways
+	}
+
+	return details
+}
+
+// appendInterfaceAddress appends the address in CIDR notation (e.g `192.168.1.1/24`) to the list of addresses. The subnet
+// is set to the network of the address (e.g `192.168.1.0/24`) if it is not set yet, so that it describes the primary address.
+func appendInterfaceAddress(addresses []string, subnet string, addr interfaceAddressResponse) ([]string, string) {
+	cidr := addr.Address
+	if !strings.Contains(cidr, "/") {
+		cidr = fmt.Sprintf("%s/%s", addr.Address, addr.SubnetBits)
+	}
+
+	prefix, err := netip.ParsePrefix(cidr)
+	if err != nil {
+		return addresses, subnet
+	}
+
+	addresses = append(addresses, prefix.String())
+	if subnet == "" {
+		subnet = prefix.Masked().String()
+	}
+
+	return addresses, subnet
+}
+
+// getInterface gets the details of the interface with the matching identifier from the OPNsense firewall. Returns nil if
+// the interface does not exist.
+func getInterface(client *opnsense.Client, identifier string) (*interfaceDetails, error) {
+	ifaces, err := getInterfaces(client)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, iface := range ifaces {
+		if iface.Identifier == identifier {
+			return &iface, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// getPrimaryAddress returns the first address without prefix length, or an empty string if there are no addresses.
+func getPrimaryAddress(addresses []string) string {
+	if len(addresses) == 0 {
+		return ""
+	}
+
+	prefix, err := netip.ParsePrefix(addresses[0])
+	if err != nil {
+		return ""
+	}
+
+	return prefix.Addr().String()
+}
@@ -0,0 +1,107 @@
+package overview
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestAppendInterfaceAddress(t *testing.T) {
+	testCases := []struct {
+		name              string
+		addresses         []string
+		subnet            string
+		addr              interfaceAddressResponse
+		expectedAddresses []string
+		expectedSubnet    string
+	}{
+		{
+			name:              "IPv4 address with subnet bits",
+			addr:              interfaceAddressResponse{Address: "192.168.1.1", SubnetBits: "24"},
+			expectedAddresses: []string{"192.168.1.1/24"},
+			expectedSubnet:    "192.168.1.0/24",
+		},
+		{
+			name:              "IPv4 address with prefix",
+			addr:              interfaceAddressResponse{Address: "192.168.1.1/24", SubnetBits: "32"},
+			expectedAddresses: []string{"192.168.1.1/24"},
+			expectedSubnet:    "192.168.1.0/24",
+		},
+		{
+			name:              "IPv6 address with subnet bits",
+			addr:              interfaceAddressResponse{Address: "2001:db8::1", SubnetBits: "64"},
+			expectedAddresses: []string{"2001:db8::1/64"},
+			expectedSubnet:    "2001:db8::/64",
+		},
+		{
+			name:              "IPv6 address with prefix",
+			addr:              interfaceAddressResponse{Address: "2001:db8:0:1::1/64"},
+			expectedAddresses: []string{"2001:db8:0:1::1/64"},
+			expectedSubnet:    "2001:db8:0:1::/64",
+		},
+		{
+			name:              "subnet of primary address is kept",
+			addresses:         []string{"192.168.1.1/24"},
+			subnet:            "192.168.1.0/24",
+			addr:              interfaceAddressResponse{Address: "10.0.0.1", SubnetBits: "8"},
+			expectedAddresses: []string{"192.168.1.1/24", "10.0.0.1/8"},
+			expectedSubnet:    "192.168.1.0/24",
+		},
+		{
+			name:              "address without subnet bits is skipped",
+			addr:              interfaceAddressResponse{Address: "192.168.1.1"},
+			expectedAddresses: []string{},
+			expectedSubnet:    "",
+		},
+		{
+			name:              "invalid address is skipped",
+			addr:              interfaceAddressResponse{Address: "not_an_address", SubnetBits: "24"},
+			expectedAddresses: []string{},
+			expectedSubnet:    "",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			addresses := testCase.addresses
+			if addresses == nil {
+				addresses = []string{}
+			}
+
+			addresses, subnet := appendInterfaceAddress(addresses, testCase.subnet, testCase.addr)
+			if !slices.Equal(addresses, testCase.expectedAddresses) {
+				t.Errorf("expected addresses %v, got %v", testCase.expectedAddresses, addresses)
+			}
+
+			if subnet != testCase.expectedSubnet {
+				t.Errorf("expected subnet `%s`, got `%s`", testCase.expectedSubnet, subnet)
+			}
+		})
+	}
+}
+
+func TestInterfaceResponseToDetails_linkLocal(t *testing.T) {
+	row := interfaceResponse{
+		Ipv4: []interfaceAddressResponse{
+			{Address: "192.168.1.1", SubnetBits: "24"},
+		},
+		Ipv6: []interfaceAddressResponse{
+			{Address: "fe80::1%em0", SubnetBits: "64", LinkLocal: true},
+			{Address: "2001:db8::1", SubnetBits: "64"},
+			{Address: "fe80::2/64", LinkLocal: true},
+		},
+	}
+
+	details := interfaceResponseToDetails(row)
+
+	if expected := []string{"192.168.1.1/24"}; !slices.Equal(details.Ipv4Addresses, expected) {
+		t.Errorf("expected IPv4 addresses %v, got %v", expected, details.Ipv4Addresses)
+	}
+
+	if expected := []string{"2001:db8::1/64"}; !slices.Equal(details.Ipv6Addresses, expected) {
+		t.Errorf("expected link-local addresses to be skipped, expected IPv6 addresses %v, got %v", expected, details.Ipv6Addresses)
+	}
+
+	if expected := "2001:db8::/64"; details.Ipv6Subnet != expected {
+		t.Errorf("expected IPv6 subnet `%s`, got `%s`", expected, details.Ipv6Subnet)
+	}
+}
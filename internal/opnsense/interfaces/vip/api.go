@@ -0,0 +1,384 @@
+package vip
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/netip"
+	"strings"
+
+	"terraform-provider-opnsense/internal/opnsense"
+	"terraform-provider-opnsense/internal/opnsense/interfaces"
+	"terraform-provider-opnsense/internal/utils"
+)
+
+const (
+	vipOpnsenseController string = "vip_settings"
+
+	searchVipCommand     opnsense.Command = "searchItem"
+	getVipCommand        opnsense.Command = "getItem"
+	addVipCommand        opnsense.Command = "addItem"
+	setVipCommand        opnsense.Command = "setItem"
+	deleteVipCommand     opnsense.Command = "delItem"
+	getUnusedVhidCommand opnsense.Command = "getUnusedVhid"
+	applyConfigCommand   opnsense.Command = "reconfigure"
+)
+
+// HTTP request bodies
+
+type vipHttpBody struct {
+	Vip vipRequest `json:"vip"`
+}
+
+type vipRequest struct {
+	Interface       string                  `json:"interface"`
+	Mode            string                  `json:"mode"`
+	Network         string                  `json:"network"`
+	Subnet          string                  `json:"subnet"`
+	SubnetBits      int32                   `json:"subnet_bits"`
+	Vhid            opnsense.Pint32AsString `json:"vhid"`
+	AdvertisingBase int32                   `json:"advbase"`
+	AdvertisingSkew int32                   `json:"advskew"`
+	Password        string                  `json:"password"`
+	Description     string                  `json:"descr"`
+}
+
+type searchVipRequestBody struct {
+	Current      int32    `json:"current"`
+	RowCount     int32    `json:"rowCount"`
+	SearchPhrase string   `json:"searchPhrase"`
+	Sort         struct{} `json:"sort"`
+}
+
+// HTTP response types
+
+type searchVipResponse struct {
+	Rows     []searchVipType `json:"rows"`
+	RowCount int32           `json:"rowCount"`
+	Total    int32           `json:"total"`
+	Current  int32           `json:"current"`
+}
+
+type searchVipType struct {
+	Uuid    string `json:"uuid"`
+	Address string `json:"address"`
+	Subnet  string `json:"subnet"`
+}
+
+type getVipResponse struct {
+	Vip vipResponse `json:"vip"`
+}
+
+type vipResponse struct {
+	Interface       vipOptions              `json:"interface"`
+	Mode            vipOptions              `json:"mode"`
+	Subnet          string                  `json:"subnet"`
+	SubnetBits      opnsense.Pint32AsString `json:"subnet_bits"`
+	Vhid            opnsense.Pint32AsString `json:"vhid"`
+	AdvertisingBase opnsense.Pint32AsString `json:"advbase"`
+	AdvertisingSkew opnsense.Pint32AsString `json:"advskew"`
+	Description     string                  `json:"descr"`
+}
+
+type getUnusedVhidResponse struct {
+	Vhid opnsense.Pint32AsString `json:"vhid"`
+}
+
+// vipOptions describes an option field in OPNsense HTTP responses.
+type vipOptions map[string]struct {
+	Value    string `json:"value"`
+	Selected uint8  `json:"selected"`
+}
+
+// selected returns the first selected option, or an empty string if none is selected.
+func (o vipOptions) selected() string {
+	for name, value := range o {
+		if value.Selected == 1 {
+			return name
+		}
+	}
+	return ""
+}
+
+// Helper functions
+
+// vipToHttpBody converts a virtual IP object to a vipHttpBody object for sending to the OPNsense API. The password is
+// passed separately, as it is never read back from OPNsense.
+func vipToHttpBody(vip vip, password string) (vipHttpBody, error) {
+	prefix, err := netip.ParsePrefix(vip.Subnet)
+	if err != nil {
+		return vipHttpBody{}, fmt.Errorf("subnet `%s` must be an IP address in CIDR notation (e.g `192.168.1.10/24`)", vip.Subnet)
+	}
+
+	// VHID is only stored for modes supporting it
+	vhid := opnsense.Pint32AsString(-1)
+	if supportsVhid(vip.Mode) {
+		vhid = opnsense.Pint32AsString(vip.Vhid)
+	}
+
+	return vipHttpBody{
+		Vip: vipRequest{
+			Interface:       vip.Interface,
+			Mode:            vip.Mode,
+			Network:         prefix.String(),
+			Subnet:          prefix.Addr().String(),
+			SubnetBits:      int32(prefix.Bits()),
+			Vhid:            vhid,
+			AdvertisingBase: vip.AdvertisingBase,
+			AdvertisingSkew: vip.AdvertisingSkew,
+			Password:        password,
+			Description:     vip.Description,
+		},
+	}, nil
+}
+
+// searchVip searches the OPNsense firewall for the virtual IP with a matching address, returning its uuid if it exists.
+func searchVip(client *opnsense.Client, address string) (string, error) {
+	path := fmt.Sprintf("%s/%s/%s", interfaces.Module, vipOpnsenseController, searchVipCommand)
+
+	body := searchVipRequestBody{
+		SearchPhrase: address,
+		RowCount:     -1,
+	}
+
+	reqBody, err := json.Marshal(body)
+	if err != nil {
+		return "", fmt.Errorf("Search %s error: failed to marshal json body - %s", resourceName, err)
+	}
+
+	httpResp, err := client.DoRequest(http.MethodPost, path, reqBody)
+	if err != nil {
+		return "", fmt.Errorf("OPNsense client error: %s", err)
+	}
+
+	if httpResp.StatusCode != 200 {
+		return "", fmt.Errorf("Search %s error (http): abnormal status code %d in HTTP response. Please contact the provider for assistance", resourceName, httpResp.StatusCode)
+	}
+
+	var resp searchVipResponse
+	err = json.NewDecoder(httpResp.Body).Decode(&resp)
+	if err != nil {
+		return "", fmt.Errorf("Search %s error (http): %s", resourceName, err)
+	}
+
+	// The address column includes the subnet bits, e.g `192.168.1.10/24`
+	for _, vip := range resp.Rows {
+		rowAddress, _, _ := strings.Cut(vip.Address, "/")
+		if rowAddress == address || vip.Subnet == address {
+			return vip.Uuid, nil
+		}
+	}
+
+	return "", fmt.Errorf("Search %[1]s error: %[1]s with address `%s` does not exist", resourceName, address)
+}
+
+// getVip searches the OPNsense firewall for the virtual IP with a matching UUID.
+func getVip(client *opnsense.Client, uuid string) (*vip, error) {
+	path := fmt.Sprintf("%s/%s/%s/%s", interfaces.Module, vipOpnsenseController, getVipCommand, uuid)
+
+	httpResp, err := client.DoRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, fmt.Errorf("OPNsense client error: %s", err)
+	}
+	if httpResp.StatusCode != 200 {
+		return nil, fmt.Errorf("Get %s error (http): abnormal status code %d in HTTP response. Please contact the provider for assistance", resourceName, httpResp.StatusCode)
+	}
+
+	var response getVipResponse
+	err = json.NewDecoder(httpResp.Body).Decode(&response)
+	if err != nil {
+		var jsonTypeError *json.UnmarshalTypeError
+		if errors.As(err, &jsonTypeError) && jsonTypeError.Value == "array" {
+			return nil, fmt.Errorf("Get %[1]s error: %[1]s with uuid `%[2]s` does not exist.\n\nIf this occurs in a resource block, it is usually because the %[1]s is removed from OPNsense (not using terraform) but is still present in the terraform state. Remove the missing %[1]s from the terraform state to rectify the error.", resourceName, uuid)
+		}
+		return nil, fmt.Errorf("Get %s error (http): %s", resourceName, err)
+	}
+
+	// Advertising frequency defaults to a base of 1 & skew of 0 if not set
+	advertisingBase := int32(response.Vip.AdvertisingBase)
+	if advertisingBase < 0 {
+		advertisingBase = 1
+	}
+
+	return &vip{
+		Interface:       response.Vip.Interface.selected(),
+		Mode:            response.Vip.Mode.selected(),
+		Subnet:          fmt.Sprintf("%s/%d", response.Vip.Subnet, response.Vip.SubnetBits),
+		Vhid:            int32(response.Vip.Vhid),
+		AdvertisingBase: advertisingBase,
+		AdvertisingSkew: max(int32(response.Vip.AdvertisingSkew), 0),
+		Description:     response.Vip.Description,
+	}, nil
+}
+
+// getUnusedVhid gets the lowest VHID not yet used by a virtual IP on the OPNsense firewall.
+func getUnusedVhid(client *opnsense.Client) (int32, error) {
+	path := fmt.Sprintf("%s/%s/%s", interfaces.Module, vipOpnsenseController, getUnusedVhidCommand)
+
+	httpResp, err := client.DoRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return -1, fmt.Errorf("OPNsense client error: %s", err)
+	}
+	if httpResp.StatusCode != 200 {
+		return -1, fmt.Errorf("Get unused VHID error (http): abnormal status code %d in HTTP response. Please contact the provider for assistance", httpResp.StatusCode)
+	}
+
+	var response getUnusedVhidResponse
+	err = json.NewDecoder(httpResp.Body).Decode(&response)
+	if err != nil {
+		return -1, fmt.Errorf("Get unused VHID error (http): failed to decode http response - %s", err)
+	}
+
+	if response.Vhid < 0 {
+		return -1, fmt.Errorf("Get unused VHID error: all VHIDs are in use on OPNsense. Set the `vhid` attribute to share an existing VHID")
+	}
+
+	return int32(response.Vhid), nil
+}
+
+// addVip creates a virtual IP on the OPNsense firewall. Returns the UUID on successful creation.
+func addVip(client *opnsense.Client, vip vip, password string) (string, error) {
+	path := fmt.Sprintf("%s/%s/%s", interfaces.Module, vipOpnsenseController, addVipCommand)
+
+	// Generate API body from virtual IP object
+	body, err := vipToHttpBody(vip, password)
+	if err != nil {
+		return "", fmt.Errorf("Add %s error: %s", resourceName, err)
+	}
+
+	reqBody, err := json.Marshal(body)
+	if err != nil {
+		return "", fmt.Errorf("Add %s error: failed to marshal json body - %s", resourceName, err)
+	}
+
+	httpResp, err := client.DoRequest(http.MethodPost, path, reqBody)
+	if err != nil {
+		return "", fmt.Errorf("OPNsense client error: %s", err)
+	}
+
+	if httpResp.StatusCode != 200 {
+		return "", fmt.Errorf("Add %s error (http): abnormal status code %d in HTTP response. Please contact the provider for assistance", resourceName, httpResp.StatusCode)
+	}
+
+	var response opnsense.OpnsenseAddItemResponse
+	err = json.NewDecoder(httpResp.Body).Decode(&response)
+	if err != nil {
+		return "", fmt.Errorf("Add %s error (http): failed to decode http response - %s", resourceName, err)
+	}
+
+	if strings.ToLower(response.Result) == "failed" {
+		return "", fmt.Errorf("Add %[1]s error: failed to add %[1]s to OPNsense - failed validations:\n%s", resourceName, opnsense.ValidationsToString(response.Validations))
+	}
+
+	return response.Uuid, nil
+}
+
+// setVip updates an existing virtual IP on the OPNsense firewall with a matching UUID.
+func setVip(client *opnsense.Client, vip vip, password string, uuid string) error {
+	path := fmt.Sprintf("%s/%s/%s/%s", interfaces.Module, vipOpnsenseController, setVipCommand, uuid)
+
+	// Generate API body from virtual IP object
+	body, err := vipToHttpBody(vip, password)
+	if err != nil {
+		return fmt.Errorf("Set %s error: %s", resourceName, err)
+	}
+
+	reqBody, err := json.Marshal(body)
+	if err != nil {
+		return fmt.Errorf("Set %s error: failed to marshal json body - %s", resourceName, err)
+	}
+
+	httpResp, err := client.DoRequest(http.MethodPost, path, reqBody)
+	if err != nil {
+		return fmt.Errorf("OPNsense client error: %s", err)
+	}
+
+	if httpResp.StatusCode != 200 {
+		return fmt.Errorf("Set %s error (http): abnormal status code %d in HTTP response. Please contact the provider for assistance", resourceName, httpResp.StatusCode)
+	}
+
+	var response opnsense.OpnsenseAddItemResponse
+	err = json.NewDecoder(httpResp.Body).Decode(&response)
+	if err != nil {
+		return fmt.Errorf("Set %s error (http): failed to decode http response - %s", resourceName, err)
+	}
+
+	if strings.ToLower(response.Result) == "failed" {
+		return fmt.Errorf("Set %[1]s error: failed to update %[1]s on OPNsense - failed validations:\n%s", resourceName, opnsense.ValidationsToString(response.Validations))
+	}
+
+	return nil
+}
+
+// deleteVip removes an existing virtual IP from the OPNsense firewall with a matching UUID.
+func deleteVip(client *opnsense.Client, uuid string) error {
+	path := fmt.Sprintf("%s/%s/%s/%s", interfaces.Module, vipOpnsenseController, deleteVipCommand, uuid)
+
+	// Generate empty body
+	reqBody, err := json.Marshal(nil)
+	if err != nil {
+		return fmt.Errorf("Delete %s error: failed to marshal json body - %s", resourceName, err)
+	}
+
+	httpResp, err := client.DoRequest(http.MethodPost, path, reqBody)
+	if err != nil {
+		return fmt.Errorf("OPNsense client error: %s", err)
+	}
+
+	if httpResp.StatusCode != 200 {
+		return fmt.Errorf("Delete %s error (http): abnormal status code %d in HTTP response. Please contact the provider for assistance", resourceName, httpResp.StatusCode)
+	}
+
+	var response opnsense.OpnsenseAddItemResponse
+	err = json.NewDecoder(httpResp.Body).Decode(&response)
+	if err != nil {
+		return fmt.Errorf("Delete %s error (http): failed to decode http response - %s", resourceName, err)
+	}
+
+	if strings.ToLower(response.Result) != "deleted" && strings.ToLower(response.Result) != "not found" {
+		return fmt.Errorf("Delete %[1]s error: failed to delete %[1]s on OPNsense. The %[1]s may still be in use (e.g by a NAT rule or an IP alias bound to its VHID), remove these references first", resourceName)
+	}
+	return nil
+}
+
+// applyConfig applies the virtual IP configuration on the OPNsense firewall.
+func applyConfig(client *opnsense.Client) error {
+	path := fmt.Sprintf("%s/%s/%s", interfaces.Module, vipOpnsenseController, applyConfigCommand)
+
+	// Generate empty body
+	reqBody, err := json.Marshal(nil)
+	if err != nil {
+		return fmt.Errorf("apply configuration error: failed to marshal json body - %s", err)
+	}
+
+	httpResp, err := client.DoRequest(http.MethodPost, path, reqBody)
+	if err != nil {
+		return fmt.Errorf("OPNsense client error: %s", err)
+	}
+
+	if httpResp.StatusCode != 200 {
+		return fmt.Errorf("Apply configuration error (http): abnormal status code %d in HTTP response. Please contact the provider for assistance", httpResp.StatusCode)
+	}
+
+	var response opnsense.OpnsenseApplyConfigResponse
+	err = json.NewDecoder(httpResp.Body).Decode(&response)
+	if err != nil {
+		return fmt.Errorf("Apply configuration error (http): failed to decode http response - %s", err)
+	}
+
+	if strings.ToLower(response.Status) != "ok" {
+		return fmt.Errorf("Apply configuration error: failed to apply configuration on OPNsense. Please contact the provider maintainers for assistance")
+	}
+	return nil
+}
+
+// getVipContentHash gets the content hash of the virtual IP from OPNsense.
+func getVipContentHash(client *opnsense.Client, uuid string) (string, error) {
+	object, err := getVip(client, uuid)
+	if err != nil {
+		return "", err
+	}
+
+	return utils.ContentHash(object)
+}
@@ -0,0 +1,104 @@
+package vip
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+
+	"terraform-provider-opnsense/internal/opnsense"
+	"terraform-provider-opnsense/internal/opnsense/interfaces/overview"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	controller string = "virtual_ip"
+
+	resourceName string = "virtual IP"
+)
+
+// vhidMutex serialises the assignment of unused VHIDs, so that virtual IPs applied in parallel do not get the same VHID.
+var vhidMutex sync.Mutex
+
+type vip struct {
+	Interface       string
+	Mode            string
+	Subnet          string
+	Vhid            int32
+	AdvertisingBase int32
+	AdvertisingSkew int32
+	Description     string
+}
+
+// Virtual IP values
+
+const (
+	modeIpAlias string = "ipalias"
+	modeCarp    string = "carp"
+)
+
+func getModes() []string {
+	return []string{modeIpAlias, modeCarp, "proxyarp", "other"}
+}
+
+// getVhidModes returns the modes that support a VHID. IP aliases use the VHID to bind to the CARP address of the same
+// VHID.
+func getVhidModes() []string {
+	return []string{modeIpAlias, modeCarp}
+}
+
+// Helper functions
+
+// supportsVhid returns whether the mode supports a VHID.
+func supportsVhid(mode string) bool {
+	return slices.Contains(getVhidModes(), mode)
+}
+
+// createVip creates a virtual IP object based on the specified plan.
+func createVip(ctx context.Context, client *opnsense.Client, plan vipResourceModel) (vip, diag.Diagnostics) {
+	var diagnostics diag.Diagnostics
+
+	// Create virtual IP from plan
+	tflog.Debug(ctx, fmt.Sprintf("Creating %s object from plan", resourceName), map[string]any{"plan": plan})
+
+	// Verify interface
+	tflog.Debug(ctx, "Verifying interface", map[string]any{"interface": plan.Interface})
+
+	interfaceExist, err := overview.VerifyInterface(client, plan.Interface.ValueString())
+	if err != nil {
+		diagnostics.AddError(fmt.Sprintf("Create %s object error", resourceName), fmt.Sprintf("%s", err))
+	} else if !interfaceExist {
+		diagnostics.AddError(fmt.Sprintf("Create %s object error", resourceName), "The specified interface does not exist. Please verify that the specified interface exist on your OPNsense firewall")
+	}
+
+	tflog.Debug(ctx, "Successfully verified interface", map[string]any{"success": true})
+
+	// Get a free VHID for CARP addresses without a VHID
+	vhid := plan.Vhid.ValueInt32()
+	if plan.Vhid.IsUnknown() {
+		tflog.Debug(ctx, "Getting unused VHID")
+
+		vhid, err = getUnusedVhid(client)
+		if err != nil {
+			diagnostics.AddError(fmt.Sprintf("Create %s object error", resourceName), fmt.Sprintf("%s", err))
+		}
+
+		tflog.Debug(ctx, "Successfully got unused VHID", map[string]any{"vhid": vhid})
+	}
+
+	vip := vip{
+		Interface:       plan.Interface.ValueString(),
+		Mode:            plan.Mode.ValueString(),
+		Subnet:          plan.Subnet.ValueString(),
+		Vhid:            vhid,
+		AdvertisingBase: plan.AdvertisingBase.ValueInt32(),
+		AdvertisingSkew: plan.AdvertisingSkew.ValueInt32(),
+		Description:     plan.Description.ValueString(),
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Successfully created %s object from plan", resourceName), map[string]any{"success": true})
+
+	return vip, diagnostics
+}
@@ -0,0 +1,188 @@
+package vip
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"terraform-provider-opnsense/internal/opnsense"
+	"terraform-provider-opnsense/internal/opnsense/interfaces"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &vipDataSource{}
+	_ datasource.DataSourceWithConfigure = &vipDataSource{}
+)
+
+// NewVipDataSource is a helper function to simplify the provider implementation.
+func NewVipDataSource() datasource.DataSource {
+	return &vipDataSource{}
+}
+
+// vipDataSource defines the data source implementation.
+type vipDataSource struct {
+	client *opnsense.Client
+}
+
+// vipDataSourceModel describes the data source data model.
+type vipDataSourceModel struct {
+	Id              types.String `tfsdk:"id"`
+	Interface       types.String `tfsdk:"interface"`
+	Mode            types.String `tfsdk:"mode"`
+	Subnet          types.String `tfsdk:"subnet"`
+	Address         types.String `tfsdk:"address"`
+	Vhid            types.Int32  `tfsdk:"vhid"`
+	AdvertisingBase types.Int32  `tfsdk:"advertising_base"`
+	AdvertisingSkew types.Int32  `tfsdk:"advertising_skew"`
+	Description     types.String `tfsdk:"description"`
+}
+
+// Metadata returns the data source type name.
+func (d *vipDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_%s_%s", req.ProviderTypeName, interfaces.TypeName, controller)
+}
+
+// Schema defines the schema for the datasource.
+func (d *vipDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: fmt.Sprintf("Retrieves information about a %s.", resourceName),
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: fmt.Sprintf("Identifier of the %s.", resourceName),
+			},
+			"interface": schema.StringAttribute{
+				Computed:    true,
+				Description: "The interface the virtual IP is added to.",
+			},
+			"mode": schema.StringAttribute{
+				Computed:    true,
+				Description: "The type of the virtual IP.",
+			},
+			"subnet": schema.StringAttribute{
+				Computed:    true,
+				Description: "The address of the virtual IP in CIDR notation.",
+			},
+			"address": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The address of the virtual IP without prefix length (e.g `192.168.1.10`).",
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.Expressions{
+						path.MatchRoot("id"),
+					}...),
+				},
+			},
+			"vhid": schema.Int32Attribute{
+				Computed:            true,
+				MarkdownDescription: "The virtual host ID (VHID) of the virtual IP, `-1` when no VHID is used.",
+			},
+			"advertising_base": schema.Int32Attribute{
+				Computed:    true,
+				Description: "The base interval in seconds between CARP advertisements.",
+			},
+			"advertising_skew": schema.Int32Attribute{
+				Computed:    true,
+				Description: "The skew added to the CARP advertising base.",
+			},
+			"description": schema.StringAttribute{
+				Computed:    true,
+				Description: "The description of the virtual IP.",
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *vipDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*opnsense.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *opnsense.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *vipDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, fmt.Sprintf("Reading %s", resourceName))
+
+	// Read Terraform configuration data into the model
+	var data vipDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get virtual IP UUID
+	if data.Id.IsNull() {
+		tflog.Debug(ctx, "Getting virtual IP UUID", map[string]any{"address": data.Address.ValueString()})
+
+		uuid, err := searchVip(d.client, data.Address.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Read %s error", resourceName), fmt.Sprintf("%s", err))
+		}
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		data.Id = types.StringValue(uuid)
+
+		tflog.Debug(ctx, "Successfully got virtual IP UUID", map[string]any{"success": true})
+	}
+
+	// Get virtual IP
+	tflog.Debug(ctx, fmt.Sprintf("Getting %s information", resourceName))
+	tflog.SetField(ctx, "uuid", data.Id.ValueString())
+
+	vip, err := getVip(d.client, data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Read %s error", resourceName), fmt.Sprintf("%s", err))
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Successfully got %s information", resourceName), map[string]any{"success": true})
+
+	// Map response to model
+	address, _, _ := strings.Cut(vip.Subnet, "/")
+
+	data.Interface = types.StringValue(vip.Interface)
+	data.Mode = types.StringValue(vip.Mode)
+	data.Subnet = types.StringValue(vip.Subnet)
+	data.Address = types.StringValue(address)
+	data.Vhid = types.Int32Value(vip.Vhid)
+	data.AdvertisingBase = types.Int32Value(vip.AdvertisingBase)
+	data.AdvertisingSkew = types.Int32Value(vip.AdvertisingSkew)
+	data.Description = types.StringValue(vip.Description)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Successfully read %s", resourceName))
+}
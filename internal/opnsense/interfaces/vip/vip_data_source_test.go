@@ -0,0 +1,51 @@
+package vip_test
+
+import (
+	"testing"
+
+	"terraform-provider-opnsense/internal/acctest"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccVipDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing (via id & address)
+			{
+				Config: testAccVipDataSourceConfig,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("data.opnsense_interfaces_virtual_ip.test_acc_data_source_id", tfjsonpath.New("interface"), knownvalue.StringExact("lan")),
+					statecheck.ExpectKnownValue("data.opnsense_interfaces_virtual_ip.test_acc_data_source_id", tfjsonpath.New("mode"), knownvalue.StringExact("ipalias")),
+					statecheck.ExpectKnownValue("data.opnsense_interfaces_virtual_ip.test_acc_data_source_id", tfjsonpath.New("subnet"), knownvalue.StringExact("192.168.1.243/32")),
+					statecheck.ExpectKnownValue("data.opnsense_interfaces_virtual_ip.test_acc_data_source_id", tfjsonpath.New("vhid"), knownvalue.Int32Exact(-1)),
+					statecheck.ExpectKnownValue("data.opnsense_interfaces_virtual_ip.test_acc_data_source_id", tfjsonpath.New("description"), knownvalue.StringExact("virtual ip for terraform data source testing")),
+					statecheck.ExpectKnownValue("data.opnsense_interfaces_virtual_ip.test_acc_data_source_address", tfjsonpath.New("subnet"), knownvalue.StringExact("192.168.1.243/32")),
+				},
+			},
+		},
+	})
+}
+
+// testAccVipDataSourceConfig creates a virtual IP resource and reads it as a data source via its id and address.
+const testAccVipDataSourceConfig = `
+	resource "opnsense_interfaces_virtual_ip" "test_acc_data_source" {
+		interface   = "lan"
+		mode        = "ipalias"
+		subnet      = "192.168.1.243/32"
+		description = "virtual ip for terraform data source testing"
+	}
+
+	data "opnsense_interfaces_virtual_ip" "test_acc_data_source_id" {
+		id = opnsense_interfaces_virtual_ip.test_acc_data_source.id
+	}
+
+	data "opnsense_interfaces_virtual_ip" "test_acc_data_source_address" {
+		address = opnsense_interfaces_virtual_ip.test_acc_data_source.address
+	}
+`
@@ -0,0 +1,513 @@
+package vip
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"strings"
+
+	"terraform-provider-opnsense/internal/opnsense"
+	"terraform-provider-opnsense/internal/opnsense/interfaces"
+	"terraform-provider-opnsense/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                   = &vipResource{}
+	_ resource.ResourceWithConfigure      = &vipResource{}
+	_ resource.ResourceWithImportState    = &vipResource{}
+	_ resource.ResourceWithValidateConfig = &vipResource{}
+	_ resource.ResourceWithModifyPlan     = &vipResource{}
+)
+
+// NewVipResource is a helper function to simplify the provider implementation.
+func NewVipResource() resource.Resource {
+	return &vipResource{}
+}
+
+// vipResource defines the resource implementation.
+type vipResource struct {
+	client *opnsense.Client
+}
+
+// vipResourceModel describes the resource data model.
+type vipResourceModel struct {
+	Id              types.String `tfsdk:"id"`
+	ContentHash     types.String `tfsdk:"content_hash"`
+	Interface       types.String `tfsdk:"interface"`
+	Mode            types.String `tfsdk:"mode"`
+	Subnet          types.String `tfsdk:"subnet"`
+	Address         types.String `tfsdk:"address"`
+	Vhid            types.Int32  `tfsdk:"vhid"`
+	AdvertisingBase types.Int32  `tfsdk:"advertising_base"`
+	AdvertisingSkew types.Int32  `tfsdk:"advertising_skew"`
+	Password        types.String `tfsdk:"password"`
+	PasswordVersion types.Int64  `tfsdk:"password_version"`
+	Description     types.String `tfsdk:"description"`
+}
+
+// Metadata returns the resource type name.
+func (r *vipResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_%s_%s", req.ProviderTypeName, interfaces.TypeName, controller)
+}
+
+// Schema defines the schema for the resource.
+func (r *vipResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Virtual IPs add addresses to an interface in addition to its primary address. CARP addresses are shared between the members of a high availability cluster, IP aliases & proxy ARP addresses are commonly used as external address of NAT rules.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: fmt.Sprintf("Identifier of the %s.", resourceName),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"content_hash": schema.StringAttribute{
+				Computed:    true,
+				Description: fmt.Sprintf("Hash of the %s configuration on OPNsense. Changes whenever the configuration is modified, including changes made outside of Terraform.", resourceName),
+			},
+			"interface": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The interface the virtual IP is added to (e.g `lan`, `opt1`).",
+			},
+			"mode": schema.StringAttribute{
+				Required: true,
+				MarkdownDescription: fmt.Sprintf(
+					"The type of the virtual IP. Must be one of: %s", strings.Join(
+						// Surround each mode with backticks (`)
+						utils.SliceMap(getModes(), func(mode string) string {
+							return fmt.Sprintf("`%s`", mode)
+						}),
+						", ",
+					),
+				),
+				Validators: []validator.String{
+					// Mode must be one of the listed values
+					stringvalidator.OneOf(getModes()...),
+				},
+			},
+			"subnet": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The address of the virtual IP in CIDR notation (e.g `192.168.1.10/24`). Use the prefix length of the interface network for CARP addresses & IP aliases.",
+			},
+			"address": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The address of the virtual IP without prefix length (e.g `192.168.1.10`). Use this value to reference the virtual IP in other resources, e.g `opnsense_firewall_nat_one_to_one.external`.",
+			},
+			"vhid": schema.Int32Attribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The virtual host ID (VHID) shared by the members of the CARP group. Must be between `1` and `255`. Only valid for `carp` & `ipalias` modes; an IP alias with a VHID is bound to the CARP address of the same VHID. When not set for a CARP address, an unused VHID is assigned by OPNsense. Computed as `-1` when no VHID is used.",
+				Validators:          []validator.Int32{int32validator.Between(1, 255)},
+			},
+			"advertising_base": schema.Int32Attribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The base interval in seconds between CARP advertisements. Must be between `1` and `254`. Defaults to `1`.",
+				Validators:          []validator.Int32{int32validator.Between(1, 254)},
+				Default:             int32default.StaticInt32(1),
+			},
+			"advertising_skew": schema.Int32Attribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The skew added to the CARP advertising base. The member with the lowest skew becomes the primary. Must be between `0` and `254`. Defaults to `0`.",
+				Validators:          []validator.Int32{int32validator.Between(0, 254)},
+				Default:             int32default.StaticInt32(0),
+			},
+			"password": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+				MarkdownDescription: "The password shared by the members of the CARP group. Required for `carp` mode, must not be set otherwise. This value is write-only and never stored in the state (requires Terraform 1.11+).",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"password_version": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Version of the CARP password. As write-only attributes are not stored in the state, changing the password alone is not detected; change this value to update the password on OPNsense.",
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The description of the virtual IP.",
+				Default:     stringdefault.StaticString(""),
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *vipResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*opnsense.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *opnsense.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// ValidateConfig validates the resource configuration for cross-attribute constraints.
+func (r *vipResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config vipResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Subnet must be an address in CIDR notation
+	if !config.Subnet.IsNull() && !config.Subnet.IsUnknown() {
+		if _, err := netip.ParsePrefix(config.Subnet.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("subnet"), "Invalid Attribute Value", fmt.Sprintf("The `subnet` attribute must be an IP address in CIDR notation, got: `%s`.", config.Subnet.ValueString()))
+		}
+	}
+
+	// Skip mode dependent validation if the mode is not yet known
+	if config.Mode.IsNull() || config.Mode.IsUnknown() {
+		return
+	}
+	mode := config.Mode.ValueString()
+
+	if !config.Vhid.IsNull() && !supportsVhid(mode) {
+		resp.Diagnostics.AddAttributeError(path.Root("vhid"), "Invalid Attribute Combination", fmt.Sprintf("The `vhid` attribute can only be set when `mode` is one of: `%s`.", strings.Join(getVhidModes(), "`, `")))
+	}
+
+	if config.Password.IsUnknown() {
+		return
+	}
+
+	if mode == modeCarp && config.Password.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("password"), "Missing Attribute Value", fmt.Sprintf("The `password` attribute is required when `mode` is `\"%s\"`.", modeCarp))
+	} else if mode != modeCarp && !config.Password.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("password"), "Invalid Attribute Combination", fmt.Sprintf("The `password` attribute can only be set when `mode` is `\"%s\"`.", modeCarp))
+	}
+}
+
+// ModifyPlan calculates the planned address & VHID of the virtual IP.
+func (r *vipResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to calculate when the resource is destroyed
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan vipResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var config vipResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Address is the subnet without prefix length
+	plan.Address = types.StringUnknown()
+	if !plan.Subnet.IsUnknown() {
+		if prefix, err := netip.ParsePrefix(plan.Subnet.ValueString()); err == nil {
+			plan.Address = types.StringValue(prefix.Addr().String())
+		}
+	}
+
+	// VHID is assigned by OPNsense when not configured for CARP addresses
+	if config.Vhid.IsNull() && !plan.Mode.IsUnknown() {
+		switch plan.Mode.ValueString() {
+		case modeCarp:
+			plan.Vhid = types.Int32Unknown()
+
+			// Keep the assigned VHID while the virtual IP remains a CARP address
+			if !req.State.Raw.IsNull() {
+				var state vipResourceModel
+				resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				if state.Mode.ValueString() == modeCarp && state.Vhid.ValueInt32() > 0 {
+					plan.Vhid = state.Vhid
+				}
+			}
+		default:
+			plan.Vhid = types.Int32Value(-1)
+		}
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *vipResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, fmt.Sprintf("Creating %s", resourceName))
+
+	// Read Terraform plan data into the model
+	var plan vipResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read Terraform configuration data into the model, write-only attributes are only available in the configuration
+	var config vipResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Hold the VHID lock until the virtual IP is saved, so that an unused VHID is not assigned twice by parallel changes
+	vhidMutex.Lock()
+
+	// Create virtual IP object
+	vip, diags := createVip(ctx, r.client, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		vhidMutex.Unlock()
+		return
+	}
+
+	// Create virtual IP on OPNsense
+	tflog.Debug(ctx, fmt.Sprintf("Creating %s on OPNsense", resourceName), map[string]any{fmt.Sprintf("%s", resourceName): vip})
+
+	uuid, err := addVip(r.client, vip, config.Password.ValueString())
+	vhidMutex.Unlock()
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Create %s error", resourceName), fmt.Sprintf("%s", err))
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Apply configuration on OPNsense
+	tflog.Debug(ctx, "Applying configuration on OPNsense")
+
+	err = applyConfig(r.client)
+	if err != nil {
+		resp.Diagnostics.AddWarning(fmt.Sprintf("Create %s error", resourceName), fmt.Sprintf("%s", err))
+	} else {
+		tflog.Debug(ctx, "Successfully applied configuration on OPNsense", map[string]any{"success": true})
+	}
+
+	// Get content hash from OPNsense
+	contentHash, err := getVipContentHash(r.client, uuid)
+	plan.ContentHash, diags = utils.ContentHashValue(fmt.Sprintf("Create %s error", resourceName), contentHash, err)
+	resp.Diagnostics.Append(diags...)
+
+	// Update plan ID & assigned VHID
+	plan.Id = types.StringValue(uuid)
+	plan.Vhid = types.Int32Value(vip.Vhid)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Successfully created %s", resourceName))
+}
+
+// Read resource information.
+func (r *vipResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, fmt.Sprintf("Reading %s", resourceName))
+
+	// Read Terraform prior state data into the model
+	var state vipResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get virtual IP
+	tflog.Debug(ctx, fmt.Sprintf("Getting %s information", resourceName))
+	tflog.SetField(ctx, "uuid", state.Id.ValueString())
+
+	vip, err := getVip(r.client, state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Read %s error", resourceName), fmt.Sprintf("%s", err))
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Successfully got %s information", resourceName), map[string]any{"success": true})
+
+	// Overwite items with refreshed state
+	contentHash, err := utils.ContentHash(vip)
+	contentHashValue, diags := utils.ContentHashValue(fmt.Sprintf("Read %s error", resourceName), contentHash, err)
+	resp.Diagnostics.Append(diags...)
+	state.ContentHash = contentHashValue
+
+	address, _, _ := strings.Cut(vip.Subnet, "/")
+
+	state.Interface = types.StringValue(vip.Interface)
+	state.Mode = types.StringValue(vip.Mode)
+	state.Subnet = types.StringValue(vip.Subnet)
+	state.Address = types.StringValue(address)
+	state.Vhid = types.Int32Value(vip.Vhid)
+	state.AdvertisingBase = types.Int32Value(vip.AdvertisingBase)
+	state.AdvertisingSkew = types.Int32Value(vip.AdvertisingSkew)
+	state.Description = types.StringValue(vip.Description)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Successfully read %s", resourceName))
+}
+
+// Update updates the resource on OPNsense and the Terraform state.
+func (r *vipResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, fmt.Sprintf("Updating %s", resourceName))
+
+	// Read Terraform plan data into the model
+	var plan vipResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read current Terraform state data into the model
+	var state vipResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read Terraform configuration data into the model, write-only attributes are only available in the configuration
+	var config vipResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Hold the VHID lock until the virtual IP is saved, so that an unused VHID is not assigned twice by parallel changes
+	vhidMutex.Lock()
+
+	// Create virtual IP object
+	vip, diags := createVip(ctx, r.client, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		vhidMutex.Unlock()
+		return
+	}
+
+	// Update virtual IP on OPNsense
+	tflog.Debug(ctx, fmt.Sprintf("Updating %s on OPNsense", resourceName), map[string]any{fmt.Sprintf("%s", resourceName): vip})
+
+	err := setVip(r.client, vip, config.Password.ValueString(), state.Id.ValueString())
+	vhidMutex.Unlock()
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Update %s error", resourceName), fmt.Sprintf("%s", err))
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Apply configuration on OPNsense
+	tflog.Debug(ctx, "Applying configuration on OPNsense")
+
+	err = applyConfig(r.client)
+	if err != nil {
+		resp.Diagnostics.AddWarning(fmt.Sprintf("Update %s error", resourceName), fmt.Sprintf("%s", err))
+	} else {
+		tflog.Debug(ctx, "Successfully applied configuration on OPNsense", map[string]any{"success": true})
+	}
+
+	// Get content hash from OPNsense
+	contentHash, err := getVipContentHash(r.client, state.Id.ValueString())
+	plan.ContentHash, diags = utils.ContentHashValue(fmt.Sprintf("Update %s error", resourceName), contentHash, err)
+	resp.Diagnostics.Append(diags...)
+
+	// Update assigned VHID
+	plan.Vhid = types.Int32Value(vip.Vhid)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Successfully updated %s", resourceName))
+}
+
+// Delete removes the resource on OPNsense and from the Terraform state.
+func (r *vipResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, fmt.Sprintf("Deleting %s", resourceName))
+
+	// Read Terraform prior state data into the model
+	var state vipResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete virtual IP on OPNsense
+	tflog.Debug(ctx, fmt.Sprintf("Deleting %s on OPNsense", resourceName), map[string]any{"uuid": state.Id.ValueString()})
+
+	err := deleteVip(r.client, state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Delete %s error", resourceName), fmt.Sprintf("%s", err))
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Apply configuration on OPNsense
+	tflog.Debug(ctx, "Applying configuration on OPNsense")
+
+	err = applyConfig(r.client)
+	if err != nil {
+		resp.Diagnostics.AddWarning(fmt.Sprintf("Delete %s error", resourceName), fmt.Sprintf("%s", err))
+	} else {
+		tflog.Debug(ctx, "Successfully applied configuration on OPNsense", map[string]any{"success": true})
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Successfully deleted %s", resourceName))
+}
+
+// ImportState imports the resource from OPNsense and enables Terraform to begin managing the resource.
+func (r *vipResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Info(ctx, fmt.Sprintf("Importing %s", resourceName))
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Successfully imported %s", resourceName))
+}
@@ -0,0 +1,113 @@
+package vip_test
+
+import (
+	"regexp"
+	"testing"
+
+	"terraform-provider-opnsense/internal/acctest"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccVipResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccVipResourceConfig,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("opnsense_interfaces_virtual_ip.test_acc_resource", tfjsonpath.New("interface"), knownvalue.StringExact("lan")),
+					statecheck.ExpectKnownValue("opnsense_interfaces_virtual_ip.test_acc_resource", tfjsonpath.New("mode"), knownvalue.StringExact("carp")),
+					statecheck.ExpectKnownValue("opnsense_interfaces_virtual_ip.test_acc_resource", tfjsonpath.New("subnet"), knownvalue.StringExact("192.168.1.241/24")),
+					statecheck.ExpectKnownValue("opnsense_interfaces_virtual_ip.test_acc_resource", tfjsonpath.New("address"), knownvalue.StringExact("192.168.1.241")),
+					statecheck.ExpectKnownValue("opnsense_interfaces_virtual_ip.test_acc_resource", tfjsonpath.New("vhid"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue("opnsense_interfaces_virtual_ip.test_acc_resource", tfjsonpath.New("advertising_skew"), knownvalue.Int32Exact(100)),
+					statecheck.ExpectKnownValue("opnsense_interfaces_virtual_ip.test_acc_resource", tfjsonpath.New("password"), knownvalue.Null()),
+					statecheck.ExpectKnownValue("opnsense_interfaces_virtual_ip.test_acc_resource", tfjsonpath.New("description"), knownvalue.StringExact("virtual ip for terraform resource testing")),
+				},
+			},
+			// ImportState testing
+			{
+				ResourceName:            "opnsense_interfaces_virtual_ip.test_acc_resource",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password_version"},
+			},
+			// Update and Read testing
+			{
+				Config: testAccVipResourceConfig_modified,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("opnsense_interfaces_virtual_ip.test_acc_resource", tfjsonpath.New("mode"), knownvalue.StringExact("ipalias")),
+					statecheck.ExpectKnownValue("opnsense_interfaces_virtual_ip.test_acc_resource", tfjsonpath.New("subnet"), knownvalue.StringExact("192.168.1.242/32")),
+					statecheck.ExpectKnownValue("opnsense_interfaces_virtual_ip.test_acc_resource", tfjsonpath.New("vhid"), knownvalue.Int32Exact(-1)),
+					statecheck.ExpectKnownValue("opnsense_interfaces_virtual_ip.test_acc_resource", tfjsonpath.New("advertising_skew"), knownvalue.Int32Exact(0)),
+					statecheck.ExpectKnownValue("opnsense_interfaces_virtual_ip.test_acc_resource", tfjsonpath.New("description"), knownvalue.StringExact("")),
+				},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccVipResource_invalidConfig(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccVipResourceConfig_missingPassword,
+				ExpectError: regexp.MustCompile("The `password` attribute is required"),
+			},
+			{
+				Config:      testAccVipResourceConfig_invalidVhid,
+				ExpectError: regexp.MustCompile("The `vhid` attribute can only be set"),
+			},
+		},
+	})
+}
+
+// testAccVipResourceConfig defines a CARP virtual IP resource with an assigned VHID.
+const testAccVipResourceConfig = `
+	resource "opnsense_interfaces_virtual_ip" "test_acc_resource" {
+		interface        = "lan"
+		mode             = "carp"
+		subnet           = "192.168.1.241/24"
+		advertising_skew = 100
+		password         = "carp-password"
+		password_version = 1
+		description      = "virtual ip for terraform resource testing"
+	}
+`
+
+// testAccVipResourceConfig_modified defines an IP alias virtual IP resource with default values.
+const testAccVipResourceConfig_modified = `
+	resource "opnsense_interfaces_virtual_ip" "test_acc_resource" {
+		interface = "lan"
+		mode      = "ipalias"
+		subnet    = "192.168.1.242/32"
+	}
+`
+
+// testAccVipResourceConfig_missingPassword defines a CARP virtual IP resource without password.
+const testAccVipResourceConfig_missingPassword = `
+	resource "opnsense_interfaces_virtual_ip" "test_acc_resource" {
+		interface = "lan"
+		mode      = "carp"
+		subnet    = "192.168.1.241/24"
+	}
+`
+
+// testAccVipResourceConfig_invalidVhid defines a proxy ARP virtual IP resource with a VHID.
+const testAccVipResourceConfig_invalidVhid = `
+	resource "opnsense_interfaces_virtual_ip" "test_acc_resource" {
+		interface = "lan"
+		mode      = "proxyarp"
+		subnet    = "192.168.1.241/32"
+		vhid      = 10
+	}
+`
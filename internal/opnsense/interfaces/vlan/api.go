@@ -0,0 +1,320 @@
+package vlan
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"terraform-provider-opnsense/internal/opnsense"
+	"terraform-provider-opnsense/internal/opnsense/interfaces"
+	"terraform-provider-opnsense/internal/utils"
+)
+
+const (
+	vlanOpnsenseController string = "vlan_settings"
+
+	searchVlanCommand  opnsense.Command = "searchItem"
+	getVlanCommand     opnsense.Command = "getItem"
+	addVlanCommand     opnsense.Command = "addItem"
+	setVlanCommand     opnsense.Command = "setItem"
+	deleteVlanCommand  opnsense.Command = "delItem"
+	applyConfigCommand opnsense.Command = "reconfigure"
+)
+
+// HTTP request bodies
+
+type vlanHttpBody struct {
+	Vlan vlanRequest `json:"vlan"`
+}
+
+type vlanRequest struct {
+	Parent      string `json:"if"`
+	Tag         int32  `json:"tag"`
+	Priority    int32  `json:"pcp"`
+	Description string `json:"descr"`
+	Device      string `json:"vlanif"`
+}
+
+type searchVlanRequestBody struct {
+	Current      int32    `json:"current"`
+	RowCount     int32    `json:"rowCount"`
+	SearchPhrase string   `json:"searchPhrase"`
+	Sort         struct{} `json:"sort"`
+}
+
+// HTTP response types
+
+type searchVlanResponse struct {
+	Rows     []searchVlanType `json:"rows"`
+	RowCount int32            `json:"rowCount"`
+	Total    int32            `json:"total"`
+	Current  int32            `json:"current"`
+}
+
+type searchVlanType struct {
+	Uuid   string `json:"uuid"`
+	Device string `json:"vlanif"`
+}
+
+type getVlanResponse struct {
+	Vlan vlanResponse `json:"vlan"`
+}
+
+type vlanResponse struct {
+	Parent      vlanOptions `json:"if"`
+	Tag         int32       `json:"tag,string"`
+	Priority    vlanOptions `json:"pcp"`
+	Description string      `json:"descr"`
+	Device      string      `json:"vlanif"`
+}
+
+// vlanOptions describes an option field in OPNsense HTTP responses.
+type vlanOptions map[string]struct {
+	Value    string `json:"value"`
+	Selected uint8  `json:"selected"`
+}
+
+// selected returns the first selected option, or an empty string if none is selected.
+func (o vlanOptions) selected() string {
+	for name, value := range o {
+		if value.Selected == 1 {
+			return name
+		}
+	}
+	return ""
+}
+
+// Helper functions
+
+// vlanToHttpBody converts a vlan object to a vlanHttpBody object for sending to the OPNsense API.
+func vlanToHttpBody(vlan vlan) vlanHttpBody {
+	return vlanHttpBody{
+		Vlan: vlanRequest{
+			Parent:      vlan.Parent,
+			Tag:         vlan.Tag,
+			Priority:    vlan.Priority,
+			Description: vlan.Description,
+			Device:      vlan.Device,
+		},
+	}
+}
+
+// searchVlan searches the OPNsense firewall for the vlan with a matching device name, returning its uuid if it exists.
+func searchVlan(client *opnsense.Client, device string) (string, error) {
+	path := fmt.Sprintf("%s/%s/%s", interfaces.Module, vlanOpnsenseController, searchVlanCommand)
+
+	body := searchVlanRequestBody{
+		SearchPhrase: device,
+		RowCount:     -1,
+	}
+
+	reqBody, err := json.Marshal(body)
+	if err != nil {
+		return "", fmt.Errorf("Search %s error: failed to marshal json body - %s", resourceName, err)
+	}
+
+	httpResp, err := client.DoRequest(http.MethodPost, path, reqBody)
+	if err != nil {
+		return "", fmt.Errorf("OPNsense client error: %s", err)
+	}
+
+	if httpResp.StatusCode != 200 {
+		return "", fmt.Errorf("Search %s error (http): abnormal status code %d in HTTP response. Please contact the provider for assistance", resourceName, httpResp.StatusCode)
+	}
+
+	var resp searchVlanResponse
+	err = json.NewDecoder(httpResp.Body).Decode(&resp)
+	if err != nil {
+		return "", fmt.Errorf("Search %s error (http): %s", resourceName, err)
+	}
+
+	for _, vlan := range resp.Rows {
+		if vlan.Device == device {
+			return vlan.Uuid, nil
+		}
+	}
+
+	return "", fmt.Errorf("Search %[1]s error: %[1]s with device `%s` does not exist", resourceName, device)
+}
+
+// getVlan searches the OPNsense firewall for the vlan with a matching UUID.
+func getVlan(client *opnsense.Client, uuid string) (*vlan, error) {
+	path := fmt.Sprintf("%s/%s/%s/%s", interfaces.Module, vlanOpnsenseController, getVlanCommand, uuid)
+
+	httpResp, err := client.DoRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, fmt.Errorf("OPNsense client error: %s", err)
+	}
+	if httpResp.StatusCode != 200 {
+		return nil, fmt.Errorf("Get %s error (http): abnormal status code %d in HTTP response. Please contact the provider for assistance", resourceName, httpResp.StatusCode)
+	}
+
+	var response getVlanResponse
+	err = json.NewDecoder(httpResp.Body).Decode(&response)
+	if err != nil {
+		var jsonTypeError *json.UnmarshalTypeError
+		if errors.As(err, &jsonTypeError) && jsonTypeError.Value == "array" {
+			return nil, fmt.Errorf("Get %[1]s error: %[1]s with uuid `%[2]s` does not exist.\n\nIf this occurs in a resource block, it is usually because the %[1]s is removed from OPNsense (not using terraform) but is still present in the terraform state. Remove the missing %[1]s from the terraform state to rectify the error.", resourceName, uuid)
+		}
+		return nil, fmt.Errorf("Get %s error (http): %s", resourceName, err)
+	}
+
+	// Priority defaults to best effort if none is selected
+	var priority int32
+	if selected := response.Vlan.Priority.selected(); selected != "" {
+		value, err := strconv.ParseInt(selected, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("Get %s error: unexpected priority `%s` in HTTP response - %s", resourceName, selected, err)
+		}
+		priority = int32(value)
+	}
+
+	return &vlan{
+		Parent:      response.Vlan.Parent.selected(),
+		Tag:         response.Vlan.Tag,
+		Priority:    priority,
+		Description: response.Vlan.Description,
+		Device:      response.Vlan.Device,
+	}, nil
+}
+
+// addVlan creates a vlan on the OPNsense firewall. Returns the UUID on successful creation.
+func addVlan(client *opnsense.Client, vlan vlan) (string, error) {
+	path := fmt.Sprintf("%s/%s/%s", interfaces.Module, vlanOpnsenseController, addVlanCommand)
+
+	// Generate API body from vlan object
+	body := vlanToHttpBody(vlan)
+	reqBody, err := json.Marshal(body)
+	if err != nil {
+		return "", fmt.Errorf("Add %s error: failed to marshal json body - %s", resourceName, err)
+	}
+
+	httpResp, err := client.DoRequest(http.MethodPost, path, reqBody)
+	if err != nil {
+		return "", fmt.Errorf("OPNsense client error: %s", err)
+	}
+
+	if httpResp.StatusCode != 200 {
+		return "", fmt.Errorf("Add %s error (http): abnormal status code %d in HTTP response. Please contact the provider for assistance", resourceName, httpResp.StatusCode)
+	}
+
+	var response opnsense.OpnsenseAddItemResponse
+	err = json.NewDecoder(httpResp.Body).Decode(&response)
+	if err != nil {
+		return "", fmt.Errorf("Add %s error (http): failed to decode http response - %s", resourceName, err)
+	}
+
+	if strings.ToLower(response.Result) == "failed" {
+		return "", fmt.Errorf("Add %[1]s error: failed to add %[1]s to OPNsense - failed validations:\n%s", resourceName, opnsense.ValidationsToString(response.Validations))
+	}
+
+	return response.Uuid, nil
+}
+
+// setVlan updates an existing vlan on the OPNsense firewall with a matching UUID.
+func setVlan(client *opnsense.Client, vlan vlan, uuid string) error {
+	path := fmt.Sprintf("%s/%s/%s/%s", interfaces.Module, vlanOpnsenseController, setVlanCommand, uuid)
+
+	// Generate API body from vlan object
+	body := vlanToHttpBody(vlan)
+	reqBody, err := json.Marshal(body)
+	if err != nil {
+		return fmt.Errorf("Set %s error: failed to marshal json body - %s", resourceName, err)
+	}
+
+	httpResp, err := client.DoRequest(http.MethodPost, path, reqBody)
+	if err != nil {
+		return fmt.Errorf("OPNsense client error: %s", err)
+	}
+
+	if httpResp.StatusCode != 200 {
+		return fmt.Errorf("Set %s error (http): abnormal status code %d in HTTP response. Please contact the provider for assistance", resourceName, httpResp.StatusCode)
+	}
+
+	var response opnsense.OpnsenseAddItemResponse
+	err = json.NewDecoder(httpResp.Body).Decode(&response)
+	if err != nil {
+		return fmt.Errorf("Set %s error (http): failed to decode http response - %s", resourceName, err)
+	}
+
+	if strings.ToLower(response.Result) == "failed" {
+		return fmt.Errorf("Set %[1]s error: failed to update %[1]s on OPNsense - failed validations:\n%s", resourceName, opnsense.ValidationsToString(response.Validations))
+	}
+
+	return nil
+}
+
+// deleteVlan removes an existing vlan from the OPNsense firewall with a matching UUID.
+func deleteVlan(client *opnsense.Client, uuid string) error {
+	path := fmt.Sprintf("%s/%s/%s/%s", interfaces.Module, vlanOpnsenseController, deleteVlanCommand, uuid)
+
+	// Generate empty body
+	reqBody, err := json.Marshal(nil)
+	if err != nil {
+		return fmt.Errorf("Delete %s error: failed to marshal json body - %s", resourceName, err)
+	}
+
+	httpResp, err := client.DoRequest(http.MethodPost, path, reqBody)
+	if err != nil {
+		return fmt.Errorf("OPNsense client error: %s", err)
+	}
+
+	if httpResp.StatusCode != 200 {
+		return fmt.Errorf("Delete %s error (http): abnormal status code %d in HTTP response. Please contact the provider for assistance", resourceName, httpResp.StatusCode)
+	}
+
+	var response opnsense.OpnsenseAddItemResponse
+	err = json.NewDecoder(httpResp.Body).Decode(&response)
+	if err != nil {
+		return fmt.Errorf("Delete %s error (http): failed to decode http response - %s", resourceName, err)
+	}
+
+	if strings.ToLower(response.Result) != "deleted" && strings.ToLower(response.Result) != "not found" {
+		return fmt.Errorf("Delete %[1]s error: failed to delete %[1]s on OPNsense. The %[1]s may still be assigned to an interface, remove the interface assignment first", resourceName)
+	}
+	return nil
+}
+
+// applyConfig applies the vlan configuration on the OPNsense firewall.
+func applyConfig(client *opnsense.Client) error {
+	path := fmt.Sprintf("%s/%s/%s", interfaces.Module, vlanOpnsenseController, applyConfigCommand)
+
+	// Generate empty body
+	reqBody, err := json.Marshal(nil)
+	if err != nil {
+		return fmt.Errorf("apply configuration error: failed to marshal json body - %s", err)
+	}
+
+	httpResp, err := client.DoRequest(http.MethodPost, path, reqBody)
+	if err != nil {
+		return fmt.Errorf("OPNsense client error: %s", err)
+	}
+
+	if httpResp.StatusCode != 200 {
+		return fmt.Errorf("Apply configuration error (http): abnormal status code %d in HTTP response. Please contact the provider for assistance", httpResp.StatusCode)
+	}
+
+	var response opnsense.OpnsenseApplyConfigResponse
+	err = json.NewDecoder(httpResp.Body).Decode(&response)
+	if err != nil {
+		return fmt.Errorf("Apply configuration error (http): failed to decode http response - %s", err)
+	}
+
+	if strings.ToLower(response.Status) != "ok" {
+		return fmt.Errorf("Apply configuration error: failed to apply configuration on OPNsense. Please contact the provider maintainers for assistance")
+	}
+	return nil
+}
+
+// getVlanContentHash gets the content hash of the vlan from OPNsense.
+func getVlanContentHash(client *opnsense.Client, uuid string) (string, error) {
+	object, err := getVlan(client, uuid)
+	if err != nil {
+		return "", err
+	}
+
+	return utils.ContentHash(object)
+}
@@ -0,0 +1,49 @@
+package vlan
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	controller string = "vlan"
+
+	resourceName string = "vlan"
+)
+
+type vlan struct {
+	Parent      string
+	Tag         int32
+	Priority    int32
+	Description string
+	Device      string
+}
+
+// Helper functions
+
+// createVlan creates a vlan object based on the specified plan.
+func createVlan(ctx context.Context, plan vlanResourceModel) (vlan, diag.Diagnostics) {
+	var diagnostics diag.Diagnostics
+
+	// Create vlan from plan
+	tflog.Debug(ctx, fmt.Sprintf("Creating %s object from plan", resourceName), map[string]any{"plan": plan})
+
+	vlan := vlan{
+		Parent:      plan.Parent.ValueString(),
+		Tag:         plan.Tag.ValueInt32(),
+		Priority:    plan.Priority.ValueInt32(),
+		Description: plan.Description.ValueString(),
+	}
+
+	// OPNsense generates the device name if it is not set
+	if !plan.Device.IsNull() && !plan.Device.IsUnknown() {
+		vlan.Device = plan.Device.ValueString()
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Successfully created %s object from plan", resourceName), map[string]any{"success": true})
+
+	return vlan, diagnostics
+}
@@ -0,0 +1,167 @@
+package vlan
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-opnsense/internal/opnsense"
+	"terraform-provider-opnsense/internal/opnsense/interfaces"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &vlanDataSource{}
+	_ datasource.DataSourceWithConfigure = &vlanDataSource{}
+)
+
+// NewVlanDataSource is a helper function to simplify the provider implementation.
+func NewVlanDataSource() datasource.DataSource {
+	return &vlanDataSource{}
+}
+
+// vlanDataSource defines the data source implementation.
+type vlanDataSource struct {
+	client *opnsense.Client
+}
+
+// vlanDataSourceModel describes the data source data model.
+type vlanDataSourceModel struct {
+	Id          types.String `tfsdk:"id"`
+	Parent      types.String `tfsdk:"parent"`
+	Tag         types.Int32  `tfsdk:"tag"`
+	Priority    types.Int32  `tfsdk:"priority"`
+	Description types.String `tfsdk:"description"`
+	Device      types.String `tfsdk:"device"`
+}
+
+// Metadata returns the data source type name.
+func (d *vlanDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_%s_%s", req.ProviderTypeName, interfaces.TypeName, controller)
+}
+
+// Schema defines the schema for the datasource.
+func (d *vlanDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: fmt.Sprintf("Retrieves information about a %s.", resourceName),
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: fmt.Sprintf("Identifier of the %s.", resourceName),
+			},
+			"parent": schema.StringAttribute{
+				Computed:    true,
+				Description: "The device name of the parent interface the VLAN is created on.",
+			},
+			"tag": schema.Int32Attribute{
+				Computed:    true,
+				Description: "The VLAN tag.",
+			},
+			"priority": schema.Int32Attribute{
+				Computed:    true,
+				Description: "The 802.1Q VLAN priority (PCP).",
+			},
+			"description": schema.StringAttribute{
+				Computed:    true,
+				Description: "The description of the VLAN.",
+			},
+			"device": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The device name of the VLAN (e.g `vlan01`).",
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.Expressions{
+						path.MatchRoot("id"),
+					}...),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *vlanDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*opnsense.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *opnsense.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *vlanDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, fmt.Sprintf("Reading %s", resourceName))
+
+	// Read Terraform configuration data into the model
+	var data vlanDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get vlan UUID
+	if data.Id.IsNull() {
+		tflog.Debug(ctx, "Getting vlan UUID", map[string]any{"device": data.Device.ValueString()})
+
+		uuid, err := searchVlan(d.client, data.Device.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Read %s error", resourceName), fmt.Sprintf("%s", err))
+		}
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		data.Id = types.StringValue(uuid)
+
+		tflog.Debug(ctx, "Successfully got vlan UUID", map[string]any{"success": true})
+	}
+
+	// Get vlan
+	tflog.Debug(ctx, fmt.Sprintf("Getting %s information", resourceName))
+	tflog.SetField(ctx, "uuid", data.Id.ValueString())
+
+	vlan, err := getVlan(d.client, data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Read %s error", resourceName), fmt.Sprintf("%s", err))
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Successfully got %s information", resourceName), map[string]any{"success": true})
+
+	// Map response to model
+	data.Parent = types.StringValue(vlan.Parent)
+	data.Tag = types.Int32Value(vlan.Tag)
+	data.Priority = types.Int32Value(vlan.Priority)
+	data.Description = types.StringValue(vlan.Description)
+	data.Device = types.StringValue(vlan.Device)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Successfully read %s", resourceName), map[string]any{"success": true})
+}
@@ -0,0 +1,50 @@
+package vlan_test
+
+import (
+	"testing"
+
+	"terraform-provider-opnsense/internal/acctest"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccVlanDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing (via id & device)
+			{
+				Config: testAccVlanDataSourceConfig,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("data.opnsense_interfaces_vlan.test_acc_data_source_id", tfjsonpath.New("parent"), knownvalue.StringExact("vtnet1")),
+					statecheck.ExpectKnownValue("data.opnsense_interfaces_vlan.test_acc_data_source_id", tfjsonpath.New("tag"), knownvalue.Int32Exact(3903)),
+					statecheck.ExpectKnownValue("data.opnsense_interfaces_vlan.test_acc_data_source_id", tfjsonpath.New("priority"), knownvalue.Int32Exact(3)),
+					statecheck.ExpectKnownValue("data.opnsense_interfaces_vlan.test_acc_data_source_id", tfjsonpath.New("description"), knownvalue.StringExact("vlan for terraform data source testing")),
+					statecheck.ExpectKnownValue("data.opnsense_interfaces_vlan.test_acc_data_source_device", tfjsonpath.New("tag"), knownvalue.Int32Exact(3903)),
+				},
+			},
+		},
+	})
+}
+
+// testAccVlanDataSourceConfig creates a vlan resource and reads it as a data source via its id and device name.
+const testAccVlanDataSourceConfig = `
+	resource "opnsense_interfaces_vlan" "test_acc_data_source" {
+		parent      = "vtnet1"
+		tag         = 3903
+		priority    = 3
+		description = "vlan for terraform data source testing"
+	}
+
+	data "opnsense_interfaces_vlan" "test_acc_data_source_id" {
+		id = opnsense_interfaces_vlan.test_acc_data_source.id
+	}
+
+	data "opnsense_interfaces_vlan" "test_acc_data_source_device" {
+		device = opnsense_interfaces_vlan.test_acc_data_source.device
+	}
+`
@@ -0,0 +1,103 @@
+package vlan_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"terraform-provider-opnsense/internal/acctest"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccVlanResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccVlanResourceConfig,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("opnsense_interfaces_vlan.test_acc_resource", tfjsonpath.New("parent"), knownvalue.StringExact("vtnet1")),
+					statecheck.ExpectKnownValue("opnsense_interfaces_vlan.test_acc_resource", tfjsonpath.New("tag"), knownvalue.Int32Exact(3901)),
+					statecheck.ExpectKnownValue("opnsense_interfaces_vlan.test_acc_resource", tfjsonpath.New("priority"), knownvalue.Int32Exact(5)),
+					statecheck.ExpectKnownValue("opnsense_interfaces_vlan.test_acc_resource", tfjsonpath.New("description"), knownvalue.StringExact("vlan for terraform resource testing")),
+					statecheck.ExpectKnownValue("opnsense_interfaces_vlan.test_acc_resource", tfjsonpath.New("device"), knownvalue.StringRegexp(regexp.MustCompile(`^vlan`))),
+				},
+			},
+			// ImportState testing
+			{
+				ResourceName:      "opnsense_interfaces_vlan.test_acc_resource",
+				ImportState:       true,
+				ImportStateIdFunc: testAccVlanImportStateIdFunc("opnsense_interfaces_vlan.test_acc_resource"),
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccVlanResourceConfig_modified,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("opnsense_interfaces_vlan.test_acc_resource", tfjsonpath.New("tag"), knownvalue.Int32Exact(3902)),
+					statecheck.ExpectKnownValue("opnsense_interfaces_vlan.test_acc_resource", tfjsonpath.New("priority"), knownvalue.Int32Exact(0)),
+					statecheck.ExpectKnownValue("opnsense_interfaces_vlan.test_acc_resource", tfjsonpath.New("description"), knownvalue.StringExact("")),
+				},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccVlanResource_invalidConfig(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccVlanResourceConfig_invalidTag,
+				ExpectError: regexp.MustCompile("Attribute tag value must be between 1 and 4094"),
+			},
+		},
+	})
+}
+
+// testAccVlanImportStateIdFunc returns the device name of the vlan resource, which is used as import identifier.
+func testAccVlanImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		return rs.Primary.Attributes["device"], nil
+	}
+}
+
+// testAccVlanResourceConfig defines a vlan resource.
+const testAccVlanResourceConfig = `
+	resource "opnsense_interfaces_vlan" "test_acc_resource" {
+		parent      = "vtnet1"
+		tag         = 3901
+		priority    = 5
+		description = "vlan for terraform resource testing"
+	}
+`
+
+// testAccVlanResourceConfig_modified defines a vlan resource with a modified tag and default values.
+const testAccVlanResourceConfig_modified = `
+	resource "opnsense_interfaces_vlan" "test_acc_resource" {
+		parent = "vtnet1"
+		tag    = 3902
+	}
+`
+
+// testAccVlanResourceConfig_invalidTag defines a vlan resource with a tag out of range.
+const testAccVlanResourceConfig_invalidTag = `
+	resource "opnsense_interfaces_vlan" "test_acc_resource" {
+		parent = "vtnet1"
+		tag    = 4095
+	}
+`
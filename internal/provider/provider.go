@@ -22,9 +22,16 @@ import (
 	"terraform-provider-opnsense/internal/opnsense/firewall/group"
 	"terraform-provider-opnsense/internal/opnsense/firewall/nat/nptv6"
 	"terraform-provider-opnsense/internal/opnsense/firewall/nat/onetoone"
+	"terraform-provider-opnsense/internal/opnsense/firewall/nat/outbound"
+	"terraform-provider-opnsense/internal/opnsense/firewall/nat/portforward"
 	"terraform-provider-opnsense/internal/opnsense/firewall/shaper/pipes"
 	"terraform-provider-opnsense/internal/opnsense/firewall/shaper/queues"
 	"terraform-provider-opnsense/internal/opnsense/firewall/shaper/rules"
+	"terraform-provider-opnsense/internal/opnsense/firewall/states"
+	"terraform-provider-opnsense/internal/opnsense/interfaces/assignment"
+	"terraform-provider-opnsense/internal/opnsense/interfaces/overview"
+	"terraform-provider-opnsense/internal/opnsense/interfaces/vip"
+	"terraform-provider-opnsense/internal/opnsense/interfaces/vlan"
 )
 
 // Ensure OpnsenseProvider satisfies various provider interfaces.
@@ -254,16 +261,26 @@ func (p *opnsenseProvider) Resources(ctx context.Context) []func() resource.Reso
 	return []func() resource.Resource{
 		alias.NewAliasResource,
 		alias.NewGeoIpResource,
+		alias.NewAliasEntryResource,
+		alias.NewAliasBundleResource,
 		category.NewCategoryResource,
 		filter.NewAutomationFilterResource,
+		filter.NewAutomationFilterOrderResource,
+		filter.NewAutomationFilterRulesetResource,
 		group.NewGroupResource,
+		group.NewGroupMemberResource,
 		nptv6.NewNatNptv6Resource,
 		onetoone.NewNatOneToOneResource,
+		outbound.NewNatOutboundSettingsResource,
+		portforward.NewNatPortForwardResource,
 		pipes.NewShaperPipesResource,
 		queues.NewShaperQueuesResource,
 		rules.NewShaperRulesResource,
 		sourcenat.NewAutomationSourceNatResource,
 		templates.NewCaptivePortalTemplatesResource,
+		vlan.NewVlanResource,
+		assignment.NewAssignmentResource,
+		vip.NewVipResource,
 	}
 }
 
@@ -272,15 +289,23 @@ func (p *opnsenseProvider) DataSources(ctx context.Context) []func() datasource.
 	return []func() datasource.DataSource{
 		alias.NewAliasDataSource,
 		alias.NewGeoIpDataSource,
+		alias.NewAliasTableDataSource,
+		alias.NewAliasBundleDataSource,
 		category.NewCategoryDataSource,
 		filter.NewAutomationFilterDataSource,
 		group.NewGroupDataSource,
 		nptv6.NewOneToOneNatDataSource,
 		onetoone.NewOneToOneNatDataSource,
+		portforward.NewNatPortForwardDataSource,
 		pipes.NewShaperPipesDataSource,
 		queues.NewShaperQueuesDataSource,
 		rules.NewShaperRulesDataSource,
 		sourcenat.NewAutomationSourceNatDataSource,
+		states.NewStatesDataSource,
+		overview.NewInterfacesDataSource,
+		overview.NewInterfaceDataSource,
+		vlan.NewVlanDataSource,
+		vip.NewVipDataSource,
 	}
 }
 
@@ -0,0 +1,36 @@
+package utils
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ContentHash computes a SHA-256 hash of the JSON representation of the specified object.
+func ContentHash(object any) (string, error) {
+	content, err := json.Marshal(object)
+	if err != nil {
+		return "", err
+	}
+
+	hash := sha256.Sum256(content)
+
+	return hex.EncodeToString(hash[:]), nil
+}
+
+// ContentHashValue converts the specified content hash to a Terraform value. If the content hash could not be
+// determined, a warning with the specified summary is returned & the value is null.
+func ContentHashValue(summary string, contentHash string, err error) (types.String, diag.Diagnostics) {
+	var diagnostics diag.Diagnostics
+
+	if err != nil {
+		diagnostics.AddWarning(summary, fmt.Sprintf("Unable to get content hash: %s", err))
+		return types.StringNull(), diagnostics
+	}
+
+	return types.StringValue(contentHash), diagnostics
+}
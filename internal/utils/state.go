@@ -0,0 +1,70 @@
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// DecodeRawState unmarshals the raw JSON state of a state upgrade request into the specified prior state model.
+func DecodeRawState(req resource.UpgradeStateRequest, priorState any) error {
+	if req.RawState == nil || req.RawState.JSON == nil {
+		return errors.New("prior state is missing from the upgrade request")
+	}
+
+	return json.Unmarshal(req.RawState.JSON, priorState)
+}
+
+// StringOrDefault converts a string pointer to a terraform `types.String`, falling back to the specified default if the pointer is nil.
+func StringOrDefault(value *string, defaultValue string) types.String {
+	if value == nil {
+		return types.StringValue(defaultValue)
+	}
+	return types.StringValue(*value)
+}
+
+// StringOrNull converts a string pointer to a terraform `types.String`, returning a null value if the pointer is nil.
+func StringOrNull(value *string) types.String {
+	return types.StringPointerValue(value)
+}
+
+// BoolOrDefault converts a bool pointer to a terraform `types.Bool`, falling back to the specified default if the pointer is nil.
+func BoolOrDefault(value *bool, defaultValue bool) types.Bool {
+	if value == nil {
+		return types.BoolValue(defaultValue)
+	}
+	return types.BoolValue(*value)
+}
+
+// Int32OrDefault converts an int32 pointer to a terraform `types.Int32`, falling back to the specified default if the pointer is nil.
+func Int32OrDefault(value *int32, defaultValue int32) types.Int32 {
+	if value == nil {
+		return types.Int32Value(defaultValue)
+	}
+	return types.Int32Value(*value)
+}
+
+// Int64OrDefault converts an int64 pointer to a terraform `types.Int64`, falling back to the specified default if the pointer is nil.
+func Int64OrDefault(value *int64, defaultValue int64) types.Int64 {
+	if value == nil {
+		return types.Int64Value(defaultValue)
+	}
+	return types.Int64Value(*value)
+}
+
+// StringSetOrDefault converts a slice of strings to a terraform set, falling back to the specified default elements if the slice is nil.
+func StringSetOrDefault(ctx context.Context, values []string, defaultValues []string) (types.Set, diag.Diagnostics) {
+	if values == nil {
+		values = defaultValues
+	}
+
+	set := NewSet()
+	set.AddSlice(values)
+
+	return basetypes.NewSetValueFrom(ctx, types.StringType, set.Elements())
+}
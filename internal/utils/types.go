@@ -2,6 +2,7 @@ package utils
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"sort"
 	"strings"
@@ -205,6 +206,11 @@ func (s *Set) Elements() []string {
 	return elements
 }
 
+// MarshalJSON encodes the set as a sorted JSON array
+func (s *Set) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.Elements())
+}
+
 // BoolToInt converts a `true` value to `1` and a `false` value to `0`.
 func BoolToInt(b bool) uint8 {
 	if b {
@@ -236,11 +242,19 @@ func SetTerraformToGo(ctx context.Context, terraformSet types.Set) (*Set, diag.D
 	var diagnostics diag.Diagnostics
 	result := NewSet()
 
-	elements := make([]types.String, 0, len(terraformSet.Elements()))
-	diags := terraformSet.ElementsAs(ctx, &elements, false)
-	diagnostics.Append(diags...)
+	// Elements are converted individually to support custom string types
+	for _, element := range terraformSet.Elements() {
+		stringValuable, ok := element.(basetypes.StringValuable)
+		if !ok {
+			diagnostics.AddError("Set conversion error", fmt.Sprintf("Expected set element of string type, got: %T", element))
+			continue
+		}
 
-	result.AddSlice(StringListTerraformToGo(elements))
+		stringValue, diags := stringValuable.ToStringValue(ctx)
+		diagnostics.Append(diags...)
+
+		result.Add(stringValue.ValueString())
+	}
 
 	return result, diagnostics
 }